@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+// CaptureAuthorizationHandler defines the dependencies of the HTTP handler for the use case
+type CaptureAuthorizationHandler struct {
+	uc        usecase.CaptureAuthorizationUseCase
+	log       logger.Logger
+	validator *validator.Validate
+}
+
+// NewCaptureAuthorizationHandler creates new CaptureAuthorizationHandler with its dependencies
+func NewCaptureAuthorizationHandler(
+	uc usecase.CaptureAuthorizationUseCase,
+	log logger.Logger,
+	v *validator.Validate,
+) CaptureAuthorizationHandler {
+	return CaptureAuthorizationHandler{
+		uc:        uc,
+		log:       log,
+		validator: v,
+	}
+}
+
+// Handle handles http request. An empty body captures the whole authorized amount
+func (c CaptureAuthorizationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CaptureAuthorizationInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && err != io.EOF {
+		c.log.Error(r.Context(), "failed to marshal message", err)
+		response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
+		return
+	}
+	defer r.Body.Close()
+
+	input.AuthorizationID = mux.Vars(r)["authorization_id"]
+
+	if err := c.validator.Struct(input); err != nil {
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		c.log.Error(r.Context(), "invalid input", err)
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), input)
+	if err != nil {
+		c.log.Error(r.Context(), "failed to capturing authorization", err)
+		switch err {
+		case domain.ErrAuthorizationNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		case domain.ErrAuthorizationNotPending,
+			domain.ErrAuthorizationExpired,
+			domain.ErrAuthorizationCaptureExceedsAmount:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	c.log.Info(r.Context(), "success to capturing authorization", logger.AccountID(output.AccountID))
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type stubCaptureAuthorizationUseCase struct {
+	result usecase.CaptureAuthorizationOutput
+	err    error
+}
+
+func (s stubCaptureAuthorizationUseCase) Execute(_ context.Context, _ usecase.CaptureAuthorizationInput) (usecase.CaptureAuthorizationOutput, error) {
+	return s.result, s.err
+}
+
+func TestCaptureAuthorizationHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.CaptureAuthorizationUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Capture authorization successfully",
+			fields: fields{
+				uc: stubCaptureAuthorizationUseCase{
+					result: usecase.CaptureAuthorizationOutput{
+						ID:             "3c096a40-ccba-4b58-93ed-57379ab04680",
+						AccountID:      "92c82203-cdba-4932-9860-bce2e6140267",
+						Amount:         1074,
+						CapturedAmount: 1074,
+						Status:         domain.AuthorizationCaptured,
+						Transaction: usecase.CaptureAuthorizationTransactionOutput{
+							ID: "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+							Operation: usecase.CaptureAuthorizationOperationOutput{
+								ID:          domain.CompraAVista,
+								Description: "COMPRA A VISTA",
+								Type:        domain.Debit,
+							},
+							Amount:    -1074,
+							Balance:   -1074,
+							CreatedAt: "2020-10-16T17:50:39Z",
+						},
+					},
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_id":"92c82203-cdba-4932-9860-bce2e6140267","amount":1074,"captured_amount":1074,"status":"CAPTURED","transaction":{"id":"aef3836b-5ea4-4890-80ad-e13337ccf47f","operation":{"id":"1","description":"COMPRA A VISTA","type":"DEBIT"},"amount":-1074,"balance":-1074,"created_at":"2020-10-16T17:50:39Z"}}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error invalid amount",
+			fields: fields{
+				uc:        stubCaptureAuthorizationUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"amount": -10}`),
+			wantBody:       `{"errors":["amount must be 0 or greater"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error authorization not found",
+			fields: fields{
+				uc:        stubCaptureAuthorizationUseCase{err: domain.ErrAuthorizationNotFound},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["authorization not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error capture exceeds authorized amount",
+			fields: fields{
+				uc:        stubCaptureAuthorizationUseCase{err: domain.ErrAuthorizationCaptureExceedsAmount},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"amount": 5000}`),
+			wantBody:       `{"errors":["capture amount exceeds the authorized amount"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error authorization expired",
+			fields: fields{
+				uc:        stubCaptureAuthorizationUseCase{err: domain.ErrAuthorizationExpired},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["authorization expired"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when capture authorization",
+			fields: fields{
+				uc:        stubCaptureAuthorizationUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/authorizations/3c096a40-ccba-4b58-93ed-57379ab04680/capture",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"authorization_id": "3c096a40-ccba-4b58-93ed-57379ab04680"})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCaptureAuthorizationHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
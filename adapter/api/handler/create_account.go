@@ -2,11 +2,11 @@ package handler
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/GSabadini/go-transactions/adapter/api/response"
 	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
 	"github.com/GSabadini/go-transactions/infrastructure/validation"
 	"github.com/GSabadini/go-transactions/usecase"
 	"github.com/go-playground/validator/v10"
@@ -15,14 +15,14 @@ import (
 // CreateAccountHandler defines the dependencies of the HTTP handler for the use case
 type CreateAccountHandler struct {
 	uc        usecase.CreateAccountUseCase
-	log       *log.Logger
+	log       logger.Logger
 	validator *validator.Validate
 }
 
 // NewCreateAccountHandler creates new CreateAccountHandler with its dependencies
 func NewCreateAccountHandler(
 	uc usecase.CreateAccountUseCase,
-	log *log.Logger,
+	log logger.Logger,
 	v *validator.Validate,
 ) CreateAccountHandler {
 	return CreateAccountHandler{
@@ -36,24 +36,24 @@ func NewCreateAccountHandler(
 func (c CreateAccountHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	var input usecase.CreateAccountInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-		c.log.Println("failed to marshal message:", err)
+		c.log.Error(r.Context(), "failed to marshal message", err)
 		response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
 		return
 	}
 	defer r.Body.Close()
 
 	if err := c.validator.Struct(input); err != nil {
-		errs := validation.ErrMessages(err)
-		c.log.Println("invalid input:", errs)
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		c.log.Error(r.Context(), "invalid input", err)
 		response.NewError(errs, http.StatusBadRequest).Send(w)
 		return
 	}
 
 	output, err := c.uc.Execute(r.Context(), input)
 	if err != nil {
-		c.log.Println("failed to creating account:", err)
+		c.log.Error(r.Context(), "failed to creating account", err)
 		switch err {
-		case domain.ErrAccountAlreadyExists:
+		case domain.ErrAccountAlreadyExists, domain.ErrDocumentInvalid:
 			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
 			return
 		default:
@@ -62,6 +62,6 @@ func (c CreateAccountHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	c.log.Println("success to creating account")
+	c.log.Info(r.Context(), "success to creating account", logger.AccountID(output.ID))
 	response.NewSuccess(output, http.StatusCreated).Send(w)
 }
@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"github.com/GSabadini/go-transactions/domain"
-	"log"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -32,7 +31,7 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 
 	type fields struct {
 		uc        usecase.CreateAccountUseCase
-		log       *log.Logger
+		log       logger.Logger
 		validator *validator.Validate
 	}
 
@@ -40,6 +39,7 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 		name           string
 		fields         fields
 		rawPayload     []byte
+		acceptLanguage string
 		wantBody       string
 		wantStatusCode int
 	}{
@@ -51,7 +51,8 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 						ID:                   "cfd3c0e0-cfa7-4220-8e62-069657874aba",
 						AvailableCreditLimit: 100,
 						Document: usecase.CreateAccountDocumentOutput{
-							Number: "12345678900",
+							Number: "12345678909",
+							Type:   domain.CPF,
 						},
 						CreatedAt: "2020-10-16T17:50:39Z",
 					},
@@ -60,8 +61,8 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 				log:       logFake,
 				validator: v,
 			},
-			rawPayload:     []byte(`{"document": {"number": "12345678900"}, "available_credit_limit": 100}`),
-			wantBody:       `{"id":"cfd3c0e0-cfa7-4220-8e62-069657874aba","available_credit_limit":100,"document":{"number":"12345678900"},"created_at":"2020-10-16T17:50:39Z"}`,
+			rawPayload:     []byte(`{"document": {"number": "123.456.789-09"}, "available_credit_limit": 100}`),
+			wantBody:       `{"id":"cfd3c0e0-cfa7-4220-8e62-069657874aba","available_credit_limit":100,"document":{"number":"12345678909","type":"CPF"},"created_at":"2020-10-16T17:50:39Z"}`,
 			wantStatusCode: http.StatusCreated,
 		},
 		{
@@ -74,7 +75,7 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 				log:       logFake,
 				validator: v,
 			},
-			rawPayload:     []byte(`{"document": {"number": "12345678900"}, "available_credit_limit": 100}`),
+			rawPayload:     []byte(`{"document": {"number": "12345678909"}, "available_credit_limit": 100}`),
 			wantBody:       `{"errors":["account already exists"]}`,
 			wantStatusCode: http.StatusUnprocessableEntity,
 		},
@@ -102,7 +103,7 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 				log:       logFake,
 				validator: v,
 			},
-			rawPayload:     []byte(`{"document": {"number": "123456"}}`),
+			rawPayload:     []byte(`{"document": {"number": "12345678909"}}`),
 			wantBody:       `{"errors":["available_credit_limit is a required field"]}`,
 			wantStatusCode: http.StatusBadRequest,
 		},
@@ -116,12 +117,12 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 				log:       logFake,
 				validator: v,
 			},
-			rawPayload:     []byte(`{"document": {"number": "123456"}, "available_credit_limit": -100}`),
+			rawPayload:     []byte(`{"document": {"number": "12345678909"}, "available_credit_limit": -100}`),
 			wantBody:       `{"errors":["available_credit_limit must be greater than 0"]}`,
 			wantStatusCode: http.StatusBadRequest,
 		},
 		{
-			name: "Error exceeded the number of characters field",
+			name: "Error invalid document",
 			fields: fields{
 				uc: stubCreateAccountUseCase{
 					result: usecase.CreateAccountOutput{},
@@ -130,8 +131,23 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 				log:       logFake,
 				validator: v,
 			},
-			rawPayload:     []byte(`{"document": {"number": "1234567899876545646455432103215648721212156451546456451205554564564564564"}, "available_credit_limit": 100}`),
-			wantBody:       `{"errors":["number must be a maximum of 30 characters in length"]}`,
+			rawPayload:     []byte(`{"document": {"number": "abc"}, "available_credit_limit": 100}`),
+			wantBody:       `{"errors":["number must be a valid CPF or CNPJ"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error invalid document check digits in Portuguese",
+			fields: fields{
+				uc: stubCreateAccountUseCase{
+					result: usecase.CreateAccountOutput{},
+					err:    nil,
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"document": {"number": "11.222.333/0001-80"}, "available_credit_limit": 100}`),
+			acceptLanguage: "pt-BR,pt;q=0.9,en;q=0.8",
+			wantBody:       `{"errors":["number deve ser um CPF ou CNPJ válido"]}`,
 			wantStatusCode: http.StatusBadRequest,
 		},
 		{
@@ -144,7 +160,7 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 				log:       logFake,
 				validator: v,
 			},
-			rawPayload:     []byte(`{"document": {"number": "12345678900"}, "available_credit_limit": 100}`),
+			rawPayload:     []byte(`{"document": {"number": "12345678909"}, "available_credit_limit": 100}`),
 			wantBody:       `{"errors":["db_error"]}`,
 			wantStatusCode: http.StatusInternalServerError,
 		},
@@ -159,6 +175,7 @@ func TestCreateAccountHandler_Handle(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
 
 			var (
 				w       = httptest.NewRecorder()
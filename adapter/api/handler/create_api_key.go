@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+)
+
+// CreateAPIKeyHandler defines the dependencies of the HTTP handler for the use case
+type CreateAPIKeyHandler struct {
+	uc        usecase.CreateAPIKeyUseCase
+	log       logger.Logger
+	validator *validator.Validate
+}
+
+// NewCreateAPIKeyHandler creates new CreateAPIKeyHandler with its dependencies
+func NewCreateAPIKeyHandler(
+	uc usecase.CreateAPIKeyUseCase,
+	log logger.Logger,
+	v *validator.Validate,
+) CreateAPIKeyHandler {
+	return CreateAPIKeyHandler{
+		uc:        uc,
+		log:       log,
+		validator: v,
+	}
+}
+
+// Handle handles http request
+func (c CreateAPIKeyHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateAPIKeyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		c.log.Error(r.Context(), "failed to marshal message", err)
+		response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := c.validator.Struct(input); err != nil {
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		c.log.Error(r.Context(), "invalid input", err)
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), input)
+	if err != nil {
+		c.log.Error(r.Context(), "failed to creating api key", err)
+		switch err {
+		case domain.ErrScopeInvalid:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	c.log.Info(r.Context(), "success to creating api key", "api_key_id", output.ID)
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+)
+
+type stubCreateAPIKeyUseCase struct {
+	result usecase.CreateAPIKeyOutput
+	err    error
+}
+
+func (s stubCreateAPIKeyUseCase) Execute(_ context.Context, _ usecase.CreateAPIKeyInput) (usecase.CreateAPIKeyOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateAPIKeyHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.CreateAPIKeyUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Create API key successfully",
+			fields: fields{
+				uc: stubCreateAPIKeyUseCase{
+					result: usecase.CreateAPIKeyOutput{
+						ID:        "1",
+						Name:      "ci",
+						Key:       "gtk_key",
+						Scopes:    []string{domain.ScopeTransactionsWrite},
+						CreatedAt: "2026-10-18T12:00:00Z",
+					},
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"name": "ci", "scopes": ["transactions:write"]}`),
+			wantBody:       `{"id":"1","name":"ci","key":"gtk_key","scopes":["transactions:write"],"created_at":"2026-10-18T12:00:00Z"}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error invalid fields",
+			fields: fields{
+				uc:        stubCreateAPIKeyUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["name is a required field","scopes is a required field"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error scope invalid",
+			fields: fields{
+				uc:        stubCreateAPIKeyUseCase{err: domain.ErrScopeInvalid},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"name": "ci", "scopes": ["accounts:delete"]}`),
+			wantBody:       `{"errors":["scope invalid"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when create API key",
+			fields: fields{
+				uc:        stubCreateAPIKeyUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"name": "ci", "scopes": ["transactions:write"]}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/admin/api-keys",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateAPIKeyHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+)
+
+type stubCreateAuthorizationUseCase struct {
+	result usecase.CreateAuthorizationOutput
+	err    error
+}
+
+func (s stubCreateAuthorizationUseCase) Execute(_ context.Context, _ usecase.CreateAuthorizationInput) (usecase.CreateAuthorizationOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateAuthorizationHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.CreateAuthorizationUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Create authorization successfully",
+			fields: fields{
+				uc: stubCreateAuthorizationUseCase{
+					result: usecase.CreateAuthorizationOutput{
+						ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+						AccountID: "92c82203-cdba-4932-9860-bce2e6140267",
+						Operation: usecase.CreateAuthorizationOperationOutput{
+							ID:          domain.CompraAVista,
+							Description: "COMPRA A VISTA",
+							Type:        domain.Debit,
+						},
+						Amount:    1074,
+						Status:    domain.AuthorizationPending,
+						ExpiresAt: "2020-10-23T17:50:39Z",
+						CreatedAt: "2020-10-16T17:50:39Z",
+					},
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "operation_id": "1", "amount": 1074}`),
+			wantBody:       `{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_id":"92c82203-cdba-4932-9860-bce2e6140267","operation":{"id":"1","description":"COMPRA A VISTA","type":"DEBIT"},"amount":1074,"captured_amount":0,"status":"PENDING","expires_at":"2020-10-23T17:50:39Z","created_at":"2020-10-16T17:50:39Z"}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error invalid fields",
+			fields: fields{
+				uc:        stubCreateAuthorizationUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["account_id is a required field","operation_id is a required field","amount is a required field"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error account not found",
+			fields: fields{
+				uc:        stubCreateAuthorizationUseCase{err: domain.ErrAccountNotFound},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "operation_id": "1", "amount": 1074}`),
+			wantBody:       `{"errors":["account not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error insufficient credit limit",
+			fields: fields{
+				uc:        stubCreateAuthorizationUseCase{err: domain.ErrAccountInsufficientCreditLimit},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "operation_id": "1", "amount": 1074}`),
+			wantBody:       `{"errors":["credit limit insufficient"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when create authorization",
+			fields: fields{
+				uc:        stubCreateAuthorizationUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "operation_id": "1", "amount": 1074}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/authorizations",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateAuthorizationHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
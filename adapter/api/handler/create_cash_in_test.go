@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+)
+
+type stubCreateCashInUseCase struct {
+	result usecase.CreateCashInOutput
+	err    error
+}
+
+func (s stubCreateCashInUseCase) Execute(_ context.Context, _ usecase.CreateCashInInput) (usecase.CreateCashInOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateCashInHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.CreateCashInUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Create cash-in successfully",
+			fields: fields{
+				uc: stubCreateCashInUseCase{
+					result: usecase.CreateCashInOutput{
+						ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+						AccountID: "92c82203-cdba-4932-9860-bce2e6140267",
+						Source: usecase.CreateCashInSourceOutput{
+							Type:      domain.CashInSourcePix,
+							Reference: "E00038166202010161750abcdefghijk",
+						},
+						Amount:        1074,
+						TransactionID: "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+						CreatedAt:     "2020-10-16T17:50:39Z",
+					},
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "amount": 1074, "source": {"type": "PIX", "reference": "E00038166202010161750abcdefghijk"}}`),
+			wantBody:       `{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_id":"92c82203-cdba-4932-9860-bce2e6140267","source":{"type":"PIX","reference":"E00038166202010161750abcdefghijk"},"amount":1074,"transaction_id":"aef3836b-5ea4-4890-80ad-e13337ccf47f","created_at":"2020-10-16T17:50:39Z"}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error invalid fields",
+			fields: fields{
+				uc:        stubCreateCashInUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["account_id is a required field","amount is a required field","type is a required field","reference is a required field"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error account not found",
+			fields: fields{
+				uc:        stubCreateCashInUseCase{err: domain.ErrAccountNotFound},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "amount": 1074, "source": {"type": "PIX", "reference": "E00038166202010161750abcdefghijk"}}`),
+			wantBody:       `{"errors":["account not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error invalid source type",
+			fields: fields{
+				uc:        stubCreateCashInUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "amount": 1074, "source": {"type": "TED", "reference": "123"}}`),
+			wantBody:       `{"errors":["type must be one of [BOLETO PIX]"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error invalid source reference",
+			fields: fields{
+				uc:        stubCreateCashInUseCase{err: domain.ErrCashInSourceInvalid},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "amount": 1074, "source": {"type": "PIX", "reference": "123"}}`),
+			wantBody:       `{"errors":["cash-in source invalid"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error cash-in already processed",
+			fields: fields{
+				uc:        stubCreateCashInUseCase{err: domain.ErrCashInAlreadyProcessed},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "amount": 1074, "source": {"type": "PIX", "reference": "E00038166202010161750abcdefghijk"}}`),
+			wantBody:       `{"errors":["cash-in source already processed"]}`,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Repository error when create cash-in",
+			fields: fields{
+				uc:        stubCreateCashInUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267", "amount": 1074, "source": {"type": "PIX", "reference": "E00038166202010161750abcdefghijk"}}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/cashin",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateCashInHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+)
+
+type stubCreateOperationUseCase struct {
+	result usecase.CreateOperationOutput
+	err    error
+}
+
+func (s stubCreateOperationUseCase) Execute(_ context.Context, _ usecase.CreateOperationInput) (usecase.CreateOperationOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateOperationHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.CreateOperationUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Create operation successfully",
+			fields: fields{
+				uc: stubCreateOperationUseCase{
+					result: usecase.CreateOperationOutput{
+						ID:          "9",
+						Description: "ANUIDADE",
+						Type:        domain.Debit,
+						Enabled:     true,
+					},
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"id": "9", "description": "ANUIDADE", "type": "DEBIT"}`),
+			wantBody:       `{"id":"9","description":"ANUIDADE","type":"DEBIT","enabled":true}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error invalid fields",
+			fields: fields{
+				uc:        stubCreateOperationUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"type": "REFUND"}`),
+			wantBody:       `{"errors":["id is a required field","description is a required field","type must be one of [DEBIT CREDIT]"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error operation already exists",
+			fields: fields{
+				uc:        stubCreateOperationUseCase{err: domain.ErrOperationAlreadyExists},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"id": "9", "description": "ANUIDADE", "type": "DEBIT"}`),
+			wantBody:       `{"errors":["operation already exists"]}`,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Repository error when create operation",
+			fields: fields{
+				uc:        stubCreateOperationUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"id": "9", "description": "ANUIDADE", "type": "DEBIT"}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/admin/operations",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateOperationHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
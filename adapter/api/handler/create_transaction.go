@@ -2,11 +2,11 @@ package handler
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/GSabadini/go-transactions/adapter/api/response"
 	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
 	"github.com/GSabadini/go-transactions/infrastructure/validation"
 	"github.com/GSabadini/go-transactions/usecase"
 
@@ -16,14 +16,14 @@ import (
 // CreateTransactionHandler defines the dependencies of the HTTP handler for the use case
 type CreateTransactionHandler struct {
 	uc        usecase.CreateTransactionUseCase
-	log       *log.Logger
+	log       logger.Logger
 	validator *validator.Validate
 }
 
 // NewCreateTransactionHandler creates new CreateTransactionHandler with its dependencies
 func NewCreateTransactionHandler(
 	uc usecase.CreateTransactionUseCase,
-	log *log.Logger,
+	log logger.Logger,
 	v *validator.Validate,
 ) CreateTransactionHandler {
 	return CreateTransactionHandler{
@@ -37,35 +37,41 @@ func NewCreateTransactionHandler(
 func (c CreateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	var input usecase.CreateTransactionInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-		c.log.Println("failed to marshal message:", err)
+		c.log.Error(r.Context(), "failed to marshal message", err)
 		response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
 		return
 	}
 	defer r.Body.Close()
 
 	if err := c.validator.Struct(input); err != nil {
-		errs := validation.ErrMessages(err)
-		c.log.Println("invalid input:", errs)
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		c.log.Error(r.Context(), "invalid input", err, logger.AccountID(input.AccountID))
 		response.NewError(errs, http.StatusBadRequest).Send(w)
 		return
 	}
 
 	output, err := c.uc.Execute(r.Context(), input)
 	if err != nil {
-		c.log.Println("failed to creating transaction:", err)
+		c.log.Error(r.Context(), "failed to creating transaction", err, logger.AccountID(input.AccountID))
 		switch err {
-		case domain.ErrOperationInvalid:
+		case domain.ErrAccountNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		case domain.ErrOperationInvalid, domain.ErrOperationDisabled:
 			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
 			return
 		case domain.ErrAccountInsufficientCreditLimit:
 			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
 			return
+		case domain.ErrInstallmentsInvalid:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
 		default:
 			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
 			return
 		}
 	}
 
-	c.log.Println("success to creating transaction")
+	c.log.Info(r.Context(), "success to creating transaction", logger.AccountID(input.AccountID))
 	response.NewSuccess(output, http.StatusCreated).Send(w)
 }
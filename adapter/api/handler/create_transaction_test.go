@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"context"
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -32,7 +33,7 @@ func TestCreateTransactionHandler_Handle(t *testing.T) {
 
 	type fields struct {
 		uc        usecase.CreateTransactionUseCase
-		log       *log.Logger
+		log       logger.Logger
 		validator *validator.Validate
 	}
 	tests := []struct {
@@ -66,6 +67,20 @@ func TestCreateTransactionHandler_Handle(t *testing.T) {
 			wantBody:       `{"id":"aef3836b-5ea4-4890-80ad-e13337ccf47f","account_id":"92c82203-cdba-4932-9860-bce2e6140267","operation":{"id":"1","description":"COMPRA A VISTA","type":"DEBIT"},"amount":-1074,"balance":0,"created_at":"2020-10-16T17:50:39Z"}`,
 			wantStatusCode: http.StatusCreated,
 		},
+		{
+			name: "Error account not found",
+			fields: fields{
+				uc: stubCreateTransactionUseCase{
+					result: usecase.CreateTransactionOutput{},
+					err:    domain.ErrAccountNotFound,
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267","operation_id": "1","amount": 1074}`),
+			wantBody:       `{"errors":["account not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
 		{
 			name: "Error operation type invalid",
 			fields: fields{
@@ -122,6 +137,34 @@ func TestCreateTransactionHandler_Handle(t *testing.T) {
 			wantBody:       `{"errors":["db_error"]}`,
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "Error installments invalid",
+			fields: fields{
+				uc: stubCreateTransactionUseCase{
+					result: usecase.CreateTransactionOutput{},
+					err:    domain.ErrInstallmentsInvalid,
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267","operation_id": "2","amount": 1074}`),
+			wantBody:       `{"errors":["installments invalid"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error installments out of range",
+			fields: fields{
+				uc: stubCreateTransactionUseCase{
+					result: usecase.CreateTransactionOutput{},
+					err:    nil,
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267","operation_id": "2","amount": 1074,"installments": 30}`),
+			wantBody:       `{"errors":["installments must be 24 or less"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "Error credit limit insufficient",
 			fields: fields{
@@ -176,3 +219,59 @@ func TestCreateTransactionHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTransactionHandler_Handle_Log(t *testing.T) {
+	tests := []struct {
+		name      string
+		uc        usecase.CreateTransactionUseCase
+		wantEntry logger.Entry
+	}{
+		{
+			name: "Log success with the account",
+			uc:   stubCreateTransactionUseCase{},
+			wantEntry: logger.Entry{
+				Level:   slog.LevelInfo,
+				Message: "success to creating transaction",
+				Attrs: map[string]any{
+					"correlation_id": "f9882930-1914-47d7-8b58-18bff092e081",
+					"account_id":     "92c82203-cdba-4932-9860-bce2e6140267",
+				},
+			},
+		},
+		{
+			name: "Log failure with the account and the error kind",
+			uc:   stubCreateTransactionUseCase{err: domain.ErrAccountInsufficientCreditLimit},
+			wantEntry: logger.Entry{
+				Level:   slog.LevelError,
+				Message: "failed to creating transaction",
+				Attrs: map[string]any{
+					"correlation_id": "f9882930-1914-47d7-8b58-18bff092e081",
+					"account_id":     "92c82203-cdba-4932-9860-bce2e6140267",
+					"error":          "credit limit insufficient",
+					"error_kind":     logger.KindRuleViolation,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				logFake = logger.NewLogFake()
+				ctx     = logger.WithCorrelationID(context.Background(), "f9882930-1914-47d7-8b58-18bff092e081")
+				req     = httptest.NewRequest(
+					http.MethodPost,
+					"/transactions",
+					strings.NewReader(`{"account_id": "92c82203-cdba-4932-9860-bce2e6140267","operation_id": "1","amount": 1074}`),
+				).WithContext(ctx)
+			)
+
+			NewCreateTransactionHandler(tt.uc, logFake, validation.NewValidator()).Handle(httptest.NewRecorder(), req)
+
+			entries := logFake.Entries()
+			if len(entries) != 1 || !reflect.DeepEqual(entries[0], tt.wantEntry) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, entries, tt.wantEntry)
+			}
+		})
+	}
+}
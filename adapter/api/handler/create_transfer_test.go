@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+)
+
+type stubCreateTransferUseCase struct {
+	result usecase.CreateTransferOutput
+	err    error
+}
+
+func (s stubCreateTransferUseCase) Execute(_ context.Context, _ usecase.CreateTransferInput) (usecase.CreateTransferOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateTransferHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.CreateTransferUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Create transfer successfully",
+			fields: fields{
+				uc: stubCreateTransferUseCase{
+					result: usecase.CreateTransferOutput{
+						ID:                  "3c096a40-ccba-4b58-93ed-57379ab04680",
+						PayerID:             "92c82203-cdba-4932-9860-bce2e6140267",
+						PayeeID:             "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						Amount:              1074,
+						DebitTransactionID:  "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+						CreditTransactionID: "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+						CreatedAt:           "2020-10-16T17:50:39Z",
+					},
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"payer_id": "92c82203-cdba-4932-9860-bce2e6140267", "payee_id": "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "amount": 1074}`),
+			wantBody:       `{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","payer_id":"92c82203-cdba-4932-9860-bce2e6140267","payee_id":"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8","amount":1074,"debit_transaction_id":"aef3836b-5ea4-4890-80ad-e13337ccf47f","credit_transaction_id":"3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1","created_at":"2020-10-16T17:50:39Z"}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error invalid fields",
+			fields: fields{
+				uc:        stubCreateTransferUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["payer_id is a required field","payee_id is a required field","amount is a required field"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error account not found",
+			fields: fields{
+				uc:        stubCreateTransferUseCase{err: domain.ErrAccountNotFound},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"payer_id": "92c82203-cdba-4932-9860-bce2e6140267", "payee_id": "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "amount": 1074}`),
+			wantBody:       `{"errors":["account not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error insufficient credit limit",
+			fields: fields{
+				uc:        stubCreateTransferUseCase{err: domain.ErrAccountInsufficientCreditLimit},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"payer_id": "92c82203-cdba-4932-9860-bce2e6140267", "payee_id": "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "amount": 1074}`),
+			wantBody:       `{"errors":["credit limit insufficient"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error transfer to the same account",
+			fields: fields{
+				uc:        stubCreateTransferUseCase{err: domain.ErrTransferSameAccount},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"payer_id": "92c82203-cdba-4932-9860-bce2e6140267", "payee_id": "92c82203-cdba-4932-9860-bce2e6140267", "amount": 1074}`),
+			wantBody:       `{"errors":["transfer to the same account"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when create transfer",
+			fields: fields{
+				uc:        stubCreateTransferUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"payer_id": "92c82203-cdba-4932-9860-bce2e6140267", "payee_id": "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "amount": 1074}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/peer-to-peer",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateTransferHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+)
+
+// CreateWebhookHandler defines the dependencies of the HTTP handler for the use case
+type CreateWebhookHandler struct {
+	uc        usecase.CreateWebhookUseCase
+	log       logger.Logger
+	validator *validator.Validate
+}
+
+// NewCreateWebhookHandler creates new CreateWebhookHandler with its dependencies
+func NewCreateWebhookHandler(
+	uc usecase.CreateWebhookUseCase,
+	log logger.Logger,
+	v *validator.Validate,
+) CreateWebhookHandler {
+	return CreateWebhookHandler{
+		uc:        uc,
+		log:       log,
+		validator: v,
+	}
+}
+
+// Handle handles http request
+func (c CreateWebhookHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateWebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		c.log.Error(r.Context(), "failed to marshal message", err)
+		response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := c.validator.Struct(input); err != nil {
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		c.log.Error(r.Context(), "invalid input", err, logger.AccountID(input.AccountID))
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), input)
+	if err != nil {
+		c.log.Error(r.Context(), "failed to creating webhook", err, logger.AccountID(input.AccountID))
+		switch err {
+		case domain.ErrAccountNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		case domain.ErrWebhookEventInvalid, domain.ErrWebhookURLInvalid:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	c.log.Info(r.Context(), "success to creating webhook", logger.AccountID(input.AccountID))
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
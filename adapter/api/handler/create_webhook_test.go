@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+)
+
+type stubCreateWebhookUseCase struct {
+	result usecase.CreateWebhookOutput
+	err    error
+}
+
+func (s stubCreateWebhookUseCase) Execute(_ context.Context, _ usecase.CreateWebhookInput) (usecase.CreateWebhookOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateWebhookHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.CreateWebhookUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Create webhook successfully",
+			fields: fields{
+				uc: stubCreateWebhookUseCase{
+					result: usecase.CreateWebhookOutput{
+						ID:        "1",
+						AccountID: "1",
+						URL:       "https://partner.example.com/hooks",
+						Secret:    "0123456789abcdef",
+						Events:    []string{domain.EventTransactionCreated},
+						Active:    true,
+						CreatedAt: "2026-10-18T12:00:00Z",
+					},
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "1", "url": "https://partner.example.com/hooks", "events": ["TransactionCreated"]}`),
+			wantBody:       `{"id":"1","account_id":"1","url":"https://partner.example.com/hooks","secret":"0123456789abcdef","events":["TransactionCreated"],"active":true,"created_at":"2026-10-18T12:00:00Z"}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error invalid fields",
+			fields: fields{
+				uc:        stubCreateWebhookUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"url": "partner", "secret": "short"}`),
+			wantBody:       `{"errors":["url must be a valid URL","events is a required field","secret must be at least 16 characters in length"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error account not found",
+			fields: fields{
+				uc:        stubCreateWebhookUseCase{err: domain.ErrAccountNotFound},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"account_id": "1", "url": "https://partner.example.com/hooks", "events": ["TransactionCreated"]}`),
+			wantBody:       `{"errors":["account not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error event not supported",
+			fields: fields{
+				uc:        stubCreateWebhookUseCase{err: domain.ErrWebhookEventInvalid},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"url": "https://partner.example.com/hooks", "events": ["AccountCreated"]}`),
+			wantBody:       `{"errors":["webhook event invalid"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when create webhook",
+			fields: fields{
+				uc:        stubCreateWebhookUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"url": "https://partner.example.com/hooks", "events": ["TransactionCreated"]}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/webhooks",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateWebhookHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
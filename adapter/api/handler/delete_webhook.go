@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+// DeleteWebhookHandler defines the dependencies of the HTTP handler for the use case
+type DeleteWebhookHandler struct {
+	uc  usecase.DeleteWebhookUseCase
+	log logger.Logger
+}
+
+// NewDeleteWebhookHandler creates new DeleteWebhookHandler with its dependencies
+func NewDeleteWebhookHandler(uc usecase.DeleteWebhookUseCase, log logger.Logger) DeleteWebhookHandler {
+	return DeleteWebhookHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request
+func (d DeleteWebhookHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["webhook_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid webhook id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	if err := d.uc.Execute(r.Context(), usecase.DeleteWebhookInput{ID: ID}); err != nil {
+		d.log.Error(r.Context(), "failed to deleting webhook", err)
+		switch err {
+		case domain.ErrWebhookNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	d.log.Info(r.Context(), "success to deleting webhook")
+	w.WriteHeader(http.StatusNoContent)
+}
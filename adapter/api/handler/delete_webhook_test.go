@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubDeleteWebhookUseCase struct {
+	err error
+}
+
+func (s stubDeleteWebhookUseCase) Execute(_ context.Context, _ usecase.DeleteWebhookInput) error {
+	return s.err
+}
+
+func TestDeleteWebhookHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+
+	type fields struct {
+		uc  usecase.DeleteWebhookUseCase
+		log logger.Logger
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Delete webhook successfully",
+			fields: fields{
+				uc:  stubDeleteWebhookUseCase{},
+				log: logFake,
+			},
+			wantBody:       ``,
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Error webhook not found",
+			fields: fields{
+				uc:  stubDeleteWebhookUseCase{err: domain.ErrWebhookNotFound},
+				log: logFake,
+			},
+			wantBody:       `{"errors":["webhook not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Repository error when delete webhook",
+			fields: fields{
+				uc:  stubDeleteWebhookUseCase{err: errors.New("db_error")},
+				log: logFake,
+			},
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, "/webhooks/1", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"webhook_id": "1"})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewDeleteWebhookHandler(tt.fields.uc, tt.fields.log)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+// DisableOperationHandler defines the dependencies of the HTTP handler for the use case
+type DisableOperationHandler struct {
+	uc  usecase.DisableOperationUseCase
+	log logger.Logger
+}
+
+// NewDisableOperationHandler creates new DisableOperationHandler with its dependencies
+func NewDisableOperationHandler(uc usecase.DisableOperationUseCase, log logger.Logger) DisableOperationHandler {
+	return DisableOperationHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request
+func (d DisableOperationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["operation_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid operation id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := d.uc.Execute(r.Context(), usecase.DisableOperationInput{ID: ID})
+	if err != nil {
+		d.log.Error(r.Context(), "failed to disabling operation", err)
+		switch err {
+		case domain.ErrOperationNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		case domain.ErrOperationInternal:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	d.log.Info(r.Context(), "success to disabling operation")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
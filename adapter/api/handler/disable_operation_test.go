@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubDisableOperationUseCase struct {
+	result usecase.DisableOperationOutput
+	err    error
+}
+
+func (s stubDisableOperationUseCase) Execute(_ context.Context, _ usecase.DisableOperationInput) (usecase.DisableOperationOutput, error) {
+	return s.result, s.err
+}
+
+func TestDisableOperationHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+
+	type fields struct {
+		uc  usecase.DisableOperationUseCase
+		log logger.Logger
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Disable operation successfully",
+			fields: fields{
+				uc: stubDisableOperationUseCase{
+					result: usecase.DisableOperationOutput{
+						ID:          domain.Saque,
+						Description: "SAQUE",
+						Type:        domain.Debit,
+						Enabled:     false,
+					},
+				},
+				log: logFake,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"id":"3","description":"SAQUE","type":"DEBIT","enabled":false}`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error operation not found",
+			fields: fields{
+				uc:  stubDisableOperationUseCase{err: domain.ErrOperationNotFound},
+				log: logFake,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"errors":["operation not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error operation used by internal flows",
+			fields: fields{
+				uc:  stubDisableOperationUseCase{err: domain.ErrOperationInternal},
+				log: logFake,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"errors":["operation used by internal flows"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when disable operation",
+			fields: fields{
+				uc:  stubDisableOperationUseCase{err: errors.New("db_error")},
+				log: logFake,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/admin/operations/3/disable",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"operation_id": "3"})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewDisableOperationHandler(tt.fields.uc, tt.fields.log)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -1,11 +1,11 @@
 package handler
 
 import (
-	"log"
 	"net/http"
 
 	"github.com/GSabadini/go-transactions/adapter/api/response"
 	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
 	"github.com/GSabadini/go-transactions/usecase"
 	"github.com/gorilla/mux"
 )
@@ -13,11 +13,11 @@ import (
 // FindAccountByIDHandler defines the dependencies of the HTTP handler for the use case
 type FindAccountByIDHandler struct {
 	uc  usecase.FindAccountByIDUseCase
-	log *log.Logger
+	log logger.Logger
 }
 
 // NewFindAccountByIDHandler creates new FindAccountByIDHandler with its dependencies
-func NewFindAccountByIDHandler(uc usecase.FindAccountByIDUseCase, log *log.Logger) FindAccountByIDHandler {
+func NewFindAccountByIDHandler(uc usecase.FindAccountByIDUseCase, log logger.Logger) FindAccountByIDHandler {
 	return FindAccountByIDHandler{
 		uc:  uc,
 		log: log,
@@ -35,7 +35,7 @@ func (f FindAccountByIDHandler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	output, err := f.uc.Execute(r.Context(), usecase.FindAccountByIDInput{ID: ID})
 	if err != nil {
-		f.log.Println("failed to find account:", err)
+		f.log.Error(r.Context(), "failed to find account", err, logger.AccountID(ID))
 		switch err {
 		case domain.ErrAccountNotFound:
 			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
@@ -46,6 +46,6 @@ func (f FindAccountByIDHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	f.log.Println("success to find account")
+	f.log.Info(r.Context(), "success to find account", logger.AccountID(ID))
 	response.NewSuccess(output, http.StatusOK).Send(w)
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -31,7 +30,7 @@ func TestFindAccountByIDHandler_Handle(t *testing.T) {
 
 	type fields struct {
 		uc  usecase.FindAccountByIDUseCase
-		log *log.Logger
+		log logger.Logger
 	}
 	type args struct {
 		ID string
@@ -62,7 +61,7 @@ func TestFindAccountByIDHandler_Handle(t *testing.T) {
 			args: args{
 				ID: "cfd3c0e0-cfa7-4220-8e62-069657874aba",
 			},
-			wantBody:       `{"id":"cfd3c0e0-cfa7-4220-8e62-069657874aba","available_credit_limit":100,"document":{"number":"123456789000"},"created_at":"0001-01-01 00:00:00 +0000 UTC"}`,
+			wantBody:       `{"id":"cfd3c0e0-cfa7-4220-8e62-069657874aba","available_credit_limit":100,"document":{"number":"123456789000","type":""},"created_at":"0001-01-01 00:00:00 +0000 UTC"}`,
 			wantStatusCode: http.StatusOK,
 		},
 		{
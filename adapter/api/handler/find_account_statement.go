@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+// mediaTypeCSV is the Accept media type that renders the statement as CSV instead of JSON
+const mediaTypeCSV = "text/csv"
+
+// FindAccountStatementHandler defines the dependencies of the HTTP handler for the use case
+type FindAccountStatementHandler struct {
+	uc        usecase.FindAccountStatementUseCase
+	log       logger.Logger
+	validator *validator.Validate
+}
+
+// NewFindAccountStatementHandler creates new FindAccountStatementHandler with its dependencies
+func NewFindAccountStatementHandler(
+	uc usecase.FindAccountStatementUseCase,
+	log logger.Logger,
+	v *validator.Validate,
+) FindAccountStatementHandler {
+	return FindAccountStatementHandler{
+		uc:        uc,
+		log:       log,
+		validator: v,
+	}
+}
+
+// Handle handles http request
+func (f FindAccountStatementHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["account_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid account id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	input, errs := parseFindAccountStatementQuery(r)
+	if len(errs) > 0 {
+		f.log.Error(r.Context(), "invalid query", validation.Errors(errs), logger.AccountID(ID))
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+	input.AccountID = ID
+
+	if err := f.validator.Struct(input); err != nil {
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		f.log.Error(r.Context(), "invalid input", err, logger.AccountID(ID))
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := f.uc.Execute(r.Context(), input)
+	if err != nil {
+		f.log.Error(r.Context(), "failed to find statement", err, logger.AccountID(ID))
+		switch err {
+		case domain.ErrStatementPeriodInvalid:
+			response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
+			return
+		case domain.ErrAccountNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	f.log.Info(r.Context(), "success to find statement", logger.AccountID(ID))
+	if acceptsCSV(r) {
+		response.NewCSV(statementRecords(output), http.StatusOK).Send(w)
+		return
+	}
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func parseFindAccountStatementQuery(r *http.Request) (usecase.FindAccountStatementInput, []string) {
+	var (
+		q     = r.URL.Query()
+		input usecase.FindAccountStatementInput
+		errs  []string
+		err   error
+	)
+
+	if v := q.Get("from"); v != "" {
+		if input.From, err = time.Parse(time.RFC3339, v); err != nil {
+			errs = append(errs, "from must be a RFC3339 date")
+		}
+	}
+
+	if v := q.Get("to"); v != "" {
+		if input.To, err = time.Parse(time.RFC3339, v); err != nil {
+			errs = append(errs, "to must be a RFC3339 date")
+		}
+	}
+
+	return input, errs
+}
+
+// acceptsCSV reports whether any media type of the Accept header is text/csv
+func acceptsCSV(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept)); err == nil && mediaType == mediaTypeCSV {
+			return true
+		}
+	}
+
+	return false
+}
+
+// statementRecords flattens the statement into CSV rows: the opening limit, one row per
+// transaction with the limit after it, the totals by operation type and the closing limit
+func statementRecords(output usecase.FindAccountStatementOutput) [][]string {
+	var records = [][]string{
+		{"created_at", "transaction_id", "operation_id", "description", "type", "amount", "limit"},
+		{output.From, "", "", "OPENING LIMIT", "", "", strconv.FormatInt(output.OpeningLimit, 10)},
+	}
+
+	for _, entry := range output.Entries {
+		records = append(records, []string{
+			entry.CreatedAt,
+			entry.TransactionID,
+			entry.Operation.ID,
+			entry.Operation.Description,
+			entry.Operation.Type,
+			strconv.FormatInt(entry.Amount, 10),
+			strconv.FormatInt(entry.Limit, 10),
+		})
+	}
+
+	for _, opType := range []string{domain.Debit, domain.Credit} {
+		records = append(records, []string{
+			output.To, "", "", "TOTAL " + opType, opType, strconv.FormatInt(output.Totals[opType], 10), "",
+		})
+	}
+
+	return append(records, []string{output.To, "", "", "CLOSING LIMIT", "", "", strconv.FormatInt(output.ClosingLimit, 10)})
+}
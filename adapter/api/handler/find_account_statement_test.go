@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type stubFindAccountStatementUseCase struct {
+	result usecase.FindAccountStatementOutput
+	err    error
+}
+
+func (s stubFindAccountStatementUseCase) Execute(
+	_ context.Context,
+	_ usecase.FindAccountStatementInput,
+) (usecase.FindAccountStatementOutput, error) {
+	return s.result, s.err
+}
+
+func TestFindAccountStatementHandler_Handle(t *testing.T) {
+	var (
+		logFake   = logger.NewLogFake()
+		v         = validation.NewValidator()
+		statement = usecase.FindAccountStatementOutput{
+			AccountID:    "92c82203-cdba-4932-9860-bce2e6140267",
+			From:         "2020-10-01T00:00:00Z",
+			To:           "2020-10-31T00:00:00Z",
+			OpeningLimit: 5000,
+			Entries: []usecase.FindAccountStatementEntryOutput{
+				{
+					TransactionID: "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+					Operation: usecase.FindAccountStatementOperationOutput{
+						ID:          domain.CompraAVista,
+						Description: "COMPRA A VISTA",
+						Type:        domain.Debit,
+					},
+					Amount:    -1074,
+					Limit:     3926,
+					CreatedAt: "2020-10-16T17:50:39Z",
+				},
+			},
+			Totals:       map[string]int64{domain.Debit: -1074, domain.Credit: 0},
+			ClosingLimit: 3926,
+		}
+	)
+
+	type fields struct {
+		uc        usecase.FindAccountStatementUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	type args struct {
+		ID     string
+		query  string
+		accept string
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		args            args
+		wantBody        string
+		wantContentType string
+		wantStatusCode  int
+	}{
+		{
+			name: "Find statement as JSON",
+			fields: fields{
+				uc:        stubFindAccountStatementUseCase{result: statement},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:    "92c82203-cdba-4932-9860-bce2e6140267",
+				query: "from=2020-10-01T00:00:00Z&to=2020-10-31T00:00:00Z",
+			},
+			wantBody:        `{"account_id":"92c82203-cdba-4932-9860-bce2e6140267","from":"2020-10-01T00:00:00Z","to":"2020-10-31T00:00:00Z","opening_limit":5000,"entries":[{"transaction_id":"aef3836b-5ea4-4890-80ad-e13337ccf47f","operation":{"id":"1","description":"COMPRA A VISTA","type":"DEBIT"},"amount":-1074,"limit":3926,"created_at":"2020-10-16T17:50:39Z"}],"totals":{"CREDIT":0,"DEBIT":-1074},"closing_limit":3926}`,
+			wantContentType: "application/json",
+			wantStatusCode:  http.StatusOK,
+		},
+		{
+			name: "Find statement as CSV",
+			fields: fields{
+				uc:        stubFindAccountStatementUseCase{result: statement},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:     "92c82203-cdba-4932-9860-bce2e6140267",
+				query:  "from=2020-10-01T00:00:00Z&to=2020-10-31T00:00:00Z",
+				accept: "text/csv; charset=utf-8, application/json;q=0.5",
+			},
+			wantBody: strings.Join([]string{
+				"created_at,transaction_id,operation_id,description,type,amount,limit",
+				"2020-10-01T00:00:00Z,,,OPENING LIMIT,,,5000",
+				"2020-10-16T17:50:39Z,aef3836b-5ea4-4890-80ad-e13337ccf47f,1,COMPRA A VISTA,DEBIT,-1074,3926",
+				"2020-10-31T00:00:00Z,,,TOTAL DEBIT,DEBIT,-1074,",
+				"2020-10-31T00:00:00Z,,,TOTAL CREDIT,CREDIT,0,",
+				"2020-10-31T00:00:00Z,,,CLOSING LIMIT,,,3926",
+			}, "\n"),
+			wantContentType: "text/csv",
+			wantStatusCode:  http.StatusOK,
+		},
+		{
+			name: "Error invalid query",
+			fields: fields{
+				uc:        stubFindAccountStatementUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:    "92c82203-cdba-4932-9860-bce2e6140267",
+				query: "from=yesterday",
+			},
+			wantBody:        `{"errors":["from must be a RFC3339 date"]}`,
+			wantContentType: "application/json",
+			wantStatusCode:  http.StatusBadRequest,
+		},
+		{
+			name: "Error missing start of the period",
+			fields: fields{
+				uc:        stubFindAccountStatementUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID: "92c82203-cdba-4932-9860-bce2e6140267",
+			},
+			wantBody:        `{"errors":["from is a required field"]}`,
+			wantContentType: "application/json",
+			wantStatusCode:  http.StatusBadRequest,
+		},
+		{
+			name: "Error invalid period",
+			fields: fields{
+				uc:        stubFindAccountStatementUseCase{err: domain.ErrStatementPeriodInvalid},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:    "92c82203-cdba-4932-9860-bce2e6140267",
+				query: "from=2020-10-31T00:00:00Z&to=2020-10-01T00:00:00Z",
+			},
+			wantBody:        `{"errors":["statement period invalid"]}`,
+			wantContentType: "application/json",
+			wantStatusCode:  http.StatusBadRequest,
+		},
+		{
+			name: "Account not found when find statement",
+			fields: fields{
+				uc:        stubFindAccountStatementUseCase{err: domain.ErrAccountNotFound},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:     "92c82203-cdba-4932-9860-bce2e6140267",
+				query:  "from=2020-10-01T00:00:00Z",
+				accept: "text/csv",
+			},
+			wantBody:        `{"errors":["account not found"]}`,
+			wantContentType: "application/json",
+			wantStatusCode:  http.StatusNotFound,
+		},
+		{
+			name: "Repository error when find statement",
+			fields: fields{
+				uc:        stubFindAccountStatementUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:    "92c82203-cdba-4932-9860-bce2e6140267",
+				query: "from=2020-10-01T00:00:00Z",
+			},
+			wantBody:        `{"errors":["db_error"]}`,
+			wantContentType: "application/json",
+			wantStatusCode:  http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/accounts/%s/statement?%s", tt.args.ID, tt.args.query)
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+			req = mux.SetURLVars(req, map[string]string{"account_id": tt.args.ID})
+			if tt.args.accept != "" {
+				req.Header.Set("Accept", tt.args.accept)
+			}
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewFindAccountStatementHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			if got := w.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf(
+					"[TestCase '%s'] Got content type: '%v' | Want content type: '%v'",
+					tt.name,
+					got,
+					tt.wantContentType,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' | Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// FindAllOperationsHandler defines the dependencies of the HTTP handler for the use case
+type FindAllOperationsHandler struct {
+	uc  usecase.FindAllOperationsUseCase
+	log logger.Logger
+}
+
+// NewFindAllOperationsHandler creates new FindAllOperationsHandler with its dependencies
+func NewFindAllOperationsHandler(uc usecase.FindAllOperationsUseCase, log logger.Logger) FindAllOperationsHandler {
+	return FindAllOperationsHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request
+func (f FindAllOperationsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	output, err := f.uc.Execute(r.Context())
+	if err != nil {
+		f.log.Error(r.Context(), "failed to find operations", err)
+		response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+		return
+	}
+
+	f.log.Info(r.Context(), "success to find operations")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
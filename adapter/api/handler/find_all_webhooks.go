@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// FindAllWebhooksHandler defines the dependencies of the HTTP handler for the use case
+type FindAllWebhooksHandler struct {
+	uc  usecase.FindAllWebhooksUseCase
+	log logger.Logger
+}
+
+// NewFindAllWebhooksHandler creates new FindAllWebhooksHandler with its dependencies
+func NewFindAllWebhooksHandler(uc usecase.FindAllWebhooksUseCase, log logger.Logger) FindAllWebhooksHandler {
+	return FindAllWebhooksHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request. The account_id query parameter lists only the webhooks of the account
+func (f FindAllWebhooksHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	output, err := f.uc.Execute(r.Context(), usecase.FindAllWebhooksInput{
+		AccountID: r.URL.Query().Get("account_id"),
+	})
+	if err != nil {
+		f.log.Error(r.Context(), "failed to find webhooks", err)
+		response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+		return
+	}
+
+	f.log.Info(r.Context(), "success to find webhooks")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
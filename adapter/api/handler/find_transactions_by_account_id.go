@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+// FindTransactionsByAccountIDHandler defines the dependencies of the HTTP handler for the use case
+type FindTransactionsByAccountIDHandler struct {
+	uc        usecase.FindTransactionsByAccountIDUseCase
+	log       logger.Logger
+	validator *validator.Validate
+}
+
+// NewFindTransactionsByAccountIDHandler creates new FindTransactionsByAccountIDHandler with its dependencies
+func NewFindTransactionsByAccountIDHandler(
+	uc usecase.FindTransactionsByAccountIDUseCase,
+	log logger.Logger,
+	v *validator.Validate,
+) FindTransactionsByAccountIDHandler {
+	return FindTransactionsByAccountIDHandler{
+		uc:        uc,
+		log:       log,
+		validator: v,
+	}
+}
+
+// Handle handles http request
+func (f FindTransactionsByAccountIDHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["account_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid account id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	input, errs := parseFindTransactionsByAccountIDQuery(r)
+	if len(errs) > 0 {
+		f.log.Error(r.Context(), "invalid query", validation.Errors(errs), logger.AccountID(ID))
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+	input.AccountID = ID
+
+	if err := f.validator.Struct(input); err != nil {
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		f.log.Error(r.Context(), "invalid input", err, logger.AccountID(ID))
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := f.uc.Execute(r.Context(), input)
+	if err != nil {
+		f.log.Error(r.Context(), "failed to find transactions", err, logger.AccountID(ID))
+		switch err {
+		case domain.ErrTransactionCursorInvalid:
+			response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
+			return
+		case domain.ErrAccountNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	f.log.Info(r.Context(), "success to find transactions", logger.AccountID(ID))
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func parseFindTransactionsByAccountIDQuery(r *http.Request) (usecase.FindTransactionsByAccountIDInput, []string) {
+	var (
+		q     = r.URL.Query()
+		input = usecase.FindTransactionsByAccountIDInput{
+			OperationID: q.Get("operation_id"),
+			Type:        q.Get("type"),
+			Cursor:      q.Get("cursor"),
+		}
+		errs []string
+		err  error
+	)
+
+	if v := q.Get("min_amount"); v != "" {
+		if input.MinAmount, err = strconv.ParseInt(v, 10, 64); err != nil {
+			errs = append(errs, "min_amount must be an integer")
+		}
+	}
+
+	if v := q.Get("max_amount"); v != "" {
+		if input.MaxAmount, err = strconv.ParseInt(v, 10, 64); err != nil {
+			errs = append(errs, "max_amount must be an integer")
+		}
+	}
+
+	if v := q.Get("from"); v != "" {
+		if input.From, err = time.Parse(time.RFC3339, v); err != nil {
+			errs = append(errs, "from must be a RFC3339 date")
+		}
+	}
+
+	if v := q.Get("to"); v != "" {
+		if input.To, err = time.Parse(time.RFC3339, v); err != nil {
+			errs = append(errs, "to must be a RFC3339 date")
+		}
+	}
+
+	if v := q.Get("limit"); v != "" {
+		if input.Limit, err = strconv.Atoi(v); err != nil {
+			errs = append(errs, "limit must be an integer")
+		}
+	}
+
+	return input, errs
+}
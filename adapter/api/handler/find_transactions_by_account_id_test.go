@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type stubFindTransactionsByAccountIDUseCase struct {
+	result usecase.FindTransactionsByAccountIDOutput
+	err    error
+}
+
+func (s stubFindTransactionsByAccountIDUseCase) Execute(
+	_ context.Context,
+	_ usecase.FindTransactionsByAccountIDInput,
+) (usecase.FindTransactionsByAccountIDOutput, error) {
+	return s.result, s.err
+}
+
+func TestFindTransactionsByAccountIDHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.FindTransactionsByAccountIDUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	type args struct {
+		ID    string
+		query string
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Find transactions by account id successfully",
+			fields: fields{
+				uc: stubFindTransactionsByAccountIDUseCase{
+					result: usecase.FindTransactionsByAccountIDOutput{
+						Transactions: []usecase.FindTransactionsByAccountIDTransactionOutput{
+							{
+								ID:        "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+								AccountID: "92c82203-cdba-4932-9860-bce2e6140267",
+								Operation: usecase.FindTransactionsByAccountIDOperationOutput{
+									ID:          domain.CompraAVista,
+									Description: "COMPRA A VISTA",
+									Type:        domain.Debit,
+								},
+								Amount:    -1074,
+								Balance:   -1074,
+								CreatedAt: "2020-10-16T17:50:39Z",
+							},
+						},
+						NextCursor: "cursor",
+					},
+					err: nil,
+				},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:    "92c82203-cdba-4932-9860-bce2e6140267",
+				query: "type=DEBIT&min_amount=100&max_amount=2000&from=2020-10-01T00:00:00Z&limit=1",
+			},
+			wantBody:       `{"transactions":[{"id":"aef3836b-5ea4-4890-80ad-e13337ccf47f","account_id":"92c82203-cdba-4932-9860-bce2e6140267","operation":{"id":"1","description":"COMPRA A VISTA","type":"DEBIT"},"amount":-1074,"balance":-1074,"created_at":"2020-10-16T17:50:39Z"}],"next_cursor":"cursor"}`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error invalid query",
+			fields: fields{
+				uc:        stubFindTransactionsByAccountIDUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:    "92c82203-cdba-4932-9860-bce2e6140267",
+				query: "min_amount=abc&from=yesterday",
+			},
+			wantBody:       `{"errors":["min_amount must be an integer","from must be a RFC3339 date"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error invalid filters",
+			fields: fields{
+				uc:        stubFindTransactionsByAccountIDUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:    "92c82203-cdba-4932-9860-bce2e6140267",
+				query: "type=OTHER&limit=500",
+			},
+			wantBody:       `{"errors":["type must be one of [DEBIT CREDIT]","limit must be 100 or less"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error invalid account id",
+			fields: fields{
+				uc:        stubFindTransactionsByAccountIDUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID: "",
+			},
+			wantBody:       `{"errors":["invalid account id"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error invalid cursor",
+			fields: fields{
+				uc: stubFindTransactionsByAccountIDUseCase{
+					result: usecase.FindTransactionsByAccountIDOutput{},
+					err:    domain.ErrTransactionCursorInvalid,
+				},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID:    "92c82203-cdba-4932-9860-bce2e6140267",
+				query: "cursor=invalid",
+			},
+			wantBody:       `{"errors":["transaction cursor invalid"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Account not found when find transactions",
+			fields: fields{
+				uc: stubFindTransactionsByAccountIDUseCase{
+					result: usecase.FindTransactionsByAccountIDOutput{},
+					err:    domain.ErrAccountNotFound,
+				},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID: "92c82203-cdba-4932-9860-bce2e6140267",
+			},
+			wantBody:       `{"errors":["account not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Repository error when find transactions",
+			fields: fields{
+				uc: stubFindTransactionsByAccountIDUseCase{
+					result: usecase.FindTransactionsByAccountIDOutput{},
+					err:    errors.New("db_error"),
+				},
+				log:       logFake,
+				validator: v,
+			},
+			args: args{
+				ID: "92c82203-cdba-4932-9860-bce2e6140267",
+			},
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/accounts/%s/transactions?%s", tt.args.ID, tt.args.query)
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+			req = mux.SetURLVars(req, map[string]string{"account_id": tt.args.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewFindTransactionsByAccountIDHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' | Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+// FindWebhookByIDHandler defines the dependencies of the HTTP handler for the use case
+type FindWebhookByIDHandler struct {
+	uc  usecase.FindWebhookByIDUseCase
+	log logger.Logger
+}
+
+// NewFindWebhookByIDHandler creates new FindWebhookByIDHandler with its dependencies
+func NewFindWebhookByIDHandler(uc usecase.FindWebhookByIDUseCase, log logger.Logger) FindWebhookByIDHandler {
+	return FindWebhookByIDHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request
+func (f FindWebhookByIDHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["webhook_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid webhook id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := f.uc.Execute(r.Context(), usecase.FindWebhookByIDInput{ID: ID})
+	if err != nil {
+		f.log.Error(r.Context(), "failed to find webhook", err)
+		switch err {
+		case domain.ErrWebhookNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	f.log.Info(r.Context(), "success to find webhook")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
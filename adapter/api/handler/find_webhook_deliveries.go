@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+// FindWebhookDeliveriesHandler defines the dependencies of the HTTP handler for the use case
+type FindWebhookDeliveriesHandler struct {
+	uc  usecase.FindWebhookDeliveriesUseCase
+	log logger.Logger
+}
+
+// NewFindWebhookDeliveriesHandler creates new FindWebhookDeliveriesHandler with its dependencies
+func NewFindWebhookDeliveriesHandler(uc usecase.FindWebhookDeliveriesUseCase, log logger.Logger) FindWebhookDeliveriesHandler {
+	return FindWebhookDeliveriesHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request
+func (f FindWebhookDeliveriesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["webhook_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid webhook id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := f.uc.Execute(r.Context(), usecase.FindWebhookDeliveriesInput{WebhookID: ID})
+	if err != nil {
+		f.log.Error(r.Context(), "failed to find webhook deliveries", err)
+		switch err {
+		case domain.ErrWebhookNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	f.log.Info(r.Context(), "success to find webhook deliveries")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// ReconcileLedgerHandler defines the dependencies of the HTTP handler for the use case
+type ReconcileLedgerHandler struct {
+	uc  usecase.ReconcileLedgerUseCase
+	log logger.Logger
+}
+
+// NewReconcileLedgerHandler creates new ReconcileLedgerHandler with its dependencies
+func NewReconcileLedgerHandler(uc usecase.ReconcileLedgerUseCase, log logger.Logger) ReconcileLedgerHandler {
+	return ReconcileLedgerHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request
+func (r ReconcileLedgerHandler) Handle(w http.ResponseWriter, req *http.Request) {
+	output, err := r.uc.Execute(req.Context())
+	if err != nil {
+		r.log.Error(req.Context(), "failed to reconciling ledger", err)
+		response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+		return
+	}
+
+	if len(output.Drifts) > 0 {
+		r.log.Info(req.Context(), "ledger drift detected", "drifts", output.Drifts)
+	}
+
+	r.log.Info(req.Context(), "success to reconciling ledger")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
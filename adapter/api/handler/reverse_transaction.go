@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+// ReverseTransactionHandler defines the dependencies of the HTTP handler for the use case
+type ReverseTransactionHandler struct {
+	uc        usecase.ReverseTransactionUseCase
+	log       logger.Logger
+	validator *validator.Validate
+}
+
+// NewReverseTransactionHandler creates new ReverseTransactionHandler with its dependencies
+func NewReverseTransactionHandler(
+	uc usecase.ReverseTransactionUseCase,
+	log logger.Logger,
+	v *validator.Validate,
+) ReverseTransactionHandler {
+	return ReverseTransactionHandler{
+		uc:        uc,
+		log:       log,
+		validator: v,
+	}
+}
+
+// Handle handles http request. An empty body reverses the whole transaction
+func (rt ReverseTransactionHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var input usecase.ReverseTransactionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && err != io.EOF {
+		rt.log.Error(r.Context(), "failed to marshal message", err)
+		response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
+		return
+	}
+	defer r.Body.Close()
+
+	input.TransactionID = mux.Vars(r)["transaction_id"]
+
+	if err := rt.validator.Struct(input); err != nil {
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		rt.log.Error(r.Context(), "invalid input", err)
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := rt.uc.Execute(r.Context(), input)
+	if err != nil {
+		rt.log.Error(r.Context(), "failed to reversing transaction", err)
+		switch err {
+		case domain.ErrTransactionNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		case domain.ErrTransactionNotReversible,
+			domain.ErrTransactionAlreadyReversed,
+			domain.ErrTransactionReversalExceedsAmount:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	rt.log.Info(r.Context(), "success to reversing transaction", logger.AccountID(output.AccountID))
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
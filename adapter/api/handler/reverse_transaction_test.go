@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type stubReverseTransactionUseCase struct {
+	result usecase.ReverseTransactionOutput
+	err    error
+}
+
+func (s stubReverseTransactionUseCase) Execute(_ context.Context, _ usecase.ReverseTransactionInput) (usecase.ReverseTransactionOutput, error) {
+	return s.result, s.err
+}
+
+func TestReverseTransactionHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.ReverseTransactionUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Reverse transaction successfully",
+			fields: fields{
+				uc: stubReverseTransactionUseCase{
+					result: usecase.ReverseTransactionOutput{
+						ID:                    "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+						AccountID:             "92c82203-cdba-4932-9860-bce2e6140267",
+						OriginalTransactionID: "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+						Operation: usecase.ReverseTransactionOperationOutput{
+							ID:          domain.Estorno,
+							Description: "ESTORNO",
+							Type:        domain.Credit,
+						},
+						Amount:    1074,
+						CreatedAt: "2020-10-16T17:50:39Z",
+					},
+					err: nil,
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"id":"aef3836b-5ea4-4890-80ad-e13337ccf47f","account_id":"92c82203-cdba-4932-9860-bce2e6140267","original_transaction_id":"3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1","operation":{"id":"5","description":"ESTORNO","type":"CREDIT"},"amount":1074,"balance":0,"created_at":"2020-10-16T17:50:39Z"}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error invalid amount",
+			fields: fields{
+				uc:        stubReverseTransactionUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"amount": -10}`),
+			wantBody:       `{"errors":["amount must be 0 or greater"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error transaction not found",
+			fields: fields{
+				uc:        stubReverseTransactionUseCase{err: domain.ErrTransactionNotFound},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"amount": 10}`),
+			wantBody:       `{"errors":["transaction not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error transaction already reversed",
+			fields: fields{
+				uc:        stubReverseTransactionUseCase{err: domain.ErrTransactionAlreadyReversed},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["transaction already fully reversed"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when reverse transaction",
+			fields: fields{
+				uc:        stubReverseTransactionUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/transactions/3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1/reversal",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"transaction_id": "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1"})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewReverseTransactionHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
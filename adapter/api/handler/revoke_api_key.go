@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+// RevokeAPIKeyHandler defines the dependencies of the HTTP handler for the use case
+type RevokeAPIKeyHandler struct {
+	uc  usecase.RevokeAPIKeyUseCase
+	log logger.Logger
+}
+
+// NewRevokeAPIKeyHandler creates new RevokeAPIKeyHandler with its dependencies
+func NewRevokeAPIKeyHandler(uc usecase.RevokeAPIKeyUseCase, log logger.Logger) RevokeAPIKeyHandler {
+	return RevokeAPIKeyHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request
+func (d RevokeAPIKeyHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["api_key_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid api key id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	if err := d.uc.Execute(r.Context(), usecase.RevokeAPIKeyInput{ID: ID}); err != nil {
+		d.log.Error(r.Context(), "failed to revoking api key", err, "api_key_id", ID)
+		switch err {
+		case domain.ErrAPIKeyNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	d.log.Info(r.Context(), "success to revoking api key", "api_key_id", ID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubRevokeAPIKeyUseCase struct {
+	err error
+}
+
+func (s stubRevokeAPIKeyUseCase) Execute(_ context.Context, _ usecase.RevokeAPIKeyInput) error {
+	return s.err
+}
+
+func TestRevokeAPIKeyHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+
+	type fields struct {
+		uc  usecase.RevokeAPIKeyUseCase
+		log logger.Logger
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Revoke API key successfully",
+			fields: fields{
+				uc:  stubRevokeAPIKeyUseCase{},
+				log: logFake,
+			},
+			wantBody:       ``,
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Error api key not found",
+			fields: fields{
+				uc:  stubRevokeAPIKeyUseCase{err: domain.ErrAPIKeyNotFound},
+				log: logFake,
+			},
+			wantBody:       `{"errors":["api key not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Repository error when revoke API key",
+			fields: fields{
+				uc:  stubRevokeAPIKeyUseCase{err: errors.New("db_error")},
+				log: logFake,
+			},
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, "/admin/api-keys/1", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"api_key_id": "1"})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewRevokeAPIKeyHandler(tt.fields.uc, tt.fields.log)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// RequireScope serves the request with next only when the authenticated principal was granted the scope,
+// refusing it with 403 otherwise, or with 401 when the request was not authenticated
+func RequireScope(scope string, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := domain.PrincipalFrom(r.Context())
+		if !ok {
+			response.NewError([]string{domain.ErrCredentialsMissing.Error()}, http.StatusUnauthorized).Send(w)
+			return
+		}
+
+		if !principal.HasScope(scope) {
+			response.NewError([]string{domain.ErrScopeMissing.Error()}, http.StatusForbidden).Send(w)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestRequireScope(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Principal granted the scope",
+			ctx: domain.WithPrincipal(
+				context.Background(),
+				domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", []string{domain.ScopeTransactionsWrite}),
+			),
+			wantBody:       ``,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error scope missing",
+			ctx: domain.WithPrincipal(
+				context.Background(),
+				domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", []string{domain.ScopeAccountsRead}),
+			),
+			wantBody:       `{"errors":["scope missing"]}`,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "Error request not authenticated",
+			ctx:            context.Background(),
+			wantBody:       `{"errors":["credentials missing"]}`,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				w   = httptest.NewRecorder()
+				req = httptest.NewRequest(http.MethodPost, "/transactions", nil).WithContext(tt.ctx)
+			)
+
+			RequireScope(domain.ScopeTransactionsWrite, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})).ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
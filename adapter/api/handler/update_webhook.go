@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+// UpdateWebhookHandler defines the dependencies of the HTTP handler for the use case
+type UpdateWebhookHandler struct {
+	uc        usecase.UpdateWebhookUseCase
+	log       logger.Logger
+	validator *validator.Validate
+}
+
+// NewUpdateWebhookHandler creates new UpdateWebhookHandler with its dependencies
+func NewUpdateWebhookHandler(
+	uc usecase.UpdateWebhookUseCase,
+	log logger.Logger,
+	v *validator.Validate,
+) UpdateWebhookHandler {
+	return UpdateWebhookHandler{
+		uc:        uc,
+		log:       log,
+		validator: v,
+	}
+}
+
+// Handle handles http request
+func (u UpdateWebhookHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["webhook_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid webhook id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	var input usecase.UpdateWebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		u.log.Error(r.Context(), "failed to marshal message", err)
+		response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
+		return
+	}
+	defer r.Body.Close()
+
+	input.ID = ID
+	if err := u.validator.Struct(input); err != nil {
+		errs := validation.ErrMessages(err, r.Header.Get("Accept-Language"))
+		u.log.Error(r.Context(), "invalid input", err)
+		response.NewError(errs, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := u.uc.Execute(r.Context(), input)
+	if err != nil {
+		u.log.Error(r.Context(), "failed to updating webhook", err)
+		switch err {
+		case domain.ErrWebhookNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		case domain.ErrWebhookEventInvalid, domain.ErrWebhookURLInvalid:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	u.log.Info(r.Context(), "success to updating webhook")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
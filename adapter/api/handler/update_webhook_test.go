@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type stubUpdateWebhookUseCase struct {
+	result usecase.UpdateWebhookOutput
+	err    error
+}
+
+func (s stubUpdateWebhookUseCase) Execute(_ context.Context, i usecase.UpdateWebhookInput) (usecase.UpdateWebhookOutput, error) {
+	if s.err != nil {
+		return usecase.UpdateWebhookOutput{}, s.err
+	}
+
+	var result = s.result
+	result.ID = i.ID
+	return result, nil
+}
+
+func TestUpdateWebhookHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+	v := validation.NewValidator()
+
+	type fields struct {
+		uc        usecase.UpdateWebhookUseCase
+		log       logger.Logger
+		validator *validator.Validate
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Update webhook successfully",
+			fields: fields{
+				uc: stubUpdateWebhookUseCase{
+					result: usecase.UpdateWebhookOutput{
+						URL:       "https://partner.example.com/hooks",
+						Events:    []string{domain.EventTransactionRejected},
+						Active:    false,
+						CreatedAt: "2026-10-18T12:00:00Z",
+					},
+				},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"url": "https://partner.example.com/hooks", "events": ["TransactionRejected"], "active": false}`),
+			wantBody:       `{"id":"1","url":"https://partner.example.com/hooks","events":["TransactionRejected"],"active":false,"created_at":"2026-10-18T12:00:00Z"}`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error invalid fields",
+			fields: fields{
+				uc:        stubUpdateWebhookUseCase{},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"url": "https://partner.example.com/hooks", "events": ["TransactionRejected"]}`),
+			wantBody:       `{"errors":["active is a required field"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error webhook not found",
+			fields: fields{
+				uc:        stubUpdateWebhookUseCase{err: domain.ErrWebhookNotFound},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"url": "https://partner.example.com/hooks", "events": ["TransactionRejected"], "active": true}`),
+			wantBody:       `{"errors":["webhook not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error url not supported",
+			fields: fields{
+				uc:        stubUpdateWebhookUseCase{err: domain.ErrWebhookURLInvalid},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"url": "ftp://partner.example.com", "events": ["TransactionRejected"], "active": true}`),
+			wantBody:       `{"errors":["webhook url must be an absolute https url to a public address"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when update webhook",
+			fields: fields{
+				uc:        stubUpdateWebhookUseCase{err: errors.New("db_error")},
+				log:       logFake,
+				validator: v,
+			},
+			rawPayload:     []byte(`{"url": "https://partner.example.com/hooks", "events": ["TransactionRejected"], "active": true}`),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPut,
+				"/webhooks/1",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"webhook_id": "1"})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewUpdateWebhookHandler(tt.fields.uc, tt.fields.log, tt.fields.validator)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+// VoidAuthorizationHandler defines the dependencies of the HTTP handler for the use case
+type VoidAuthorizationHandler struct {
+	uc  usecase.VoidAuthorizationUseCase
+	log logger.Logger
+}
+
+// NewVoidAuthorizationHandler creates new VoidAuthorizationHandler with its dependencies
+func NewVoidAuthorizationHandler(uc usecase.VoidAuthorizationUseCase, log logger.Logger) VoidAuthorizationHandler {
+	return VoidAuthorizationHandler{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// Handle handles http request
+func (v VoidAuthorizationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ID := mux.Vars(r)["authorization_id"]
+	if ID == "" {
+		response.NewError([]string{"invalid authorization id"}, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	output, err := v.uc.Execute(r.Context(), usecase.VoidAuthorizationInput{AuthorizationID: ID})
+	if err != nil {
+		v.log.Error(r.Context(), "failed to voiding authorization", err)
+		switch err {
+		case domain.ErrAuthorizationNotFound:
+			response.NewError([]string{err.Error()}, http.StatusNotFound).Send(w)
+			return
+		case domain.ErrAuthorizationNotPending, domain.ErrAuthorizationExpired:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		}
+	}
+
+	v.log.Info(r.Context(), "success to voiding authorization", logger.AccountID(output.AccountID))
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubVoidAuthorizationUseCase struct {
+	result usecase.VoidAuthorizationOutput
+	err    error
+}
+
+func (s stubVoidAuthorizationUseCase) Execute(_ context.Context, _ usecase.VoidAuthorizationInput) (usecase.VoidAuthorizationOutput, error) {
+	return s.result, s.err
+}
+
+func TestVoidAuthorizationHandler_Handle(t *testing.T) {
+	logFake := logger.NewLogFake()
+
+	type fields struct {
+		uc  usecase.VoidAuthorizationUseCase
+		log logger.Logger
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		rawPayload     []byte
+		wantBody       string
+		wantStatusCode int
+	}{
+		{
+			name: "Void authorization successfully",
+			fields: fields{
+				uc: stubVoidAuthorizationUseCase{
+					result: usecase.VoidAuthorizationOutput{
+						ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+						AccountID: "92c82203-cdba-4932-9860-bce2e6140267",
+						Operation: usecase.VoidAuthorizationOperationOutput{
+							ID:          domain.CompraAVista,
+							Description: "COMPRA A VISTA",
+							Type:        domain.Debit,
+						},
+						Amount:    1074,
+						Status:    domain.AuthorizationVoided,
+						ExpiresAt: "2020-10-23T17:50:39Z",
+						CreatedAt: "2020-10-16T17:50:39Z",
+					},
+				},
+				log: logFake,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_id":"92c82203-cdba-4932-9860-bce2e6140267","operation":{"id":"1","description":"COMPRA A VISTA","type":"DEBIT"},"amount":1074,"captured_amount":0,"status":"VOIDED","expires_at":"2020-10-23T17:50:39Z","created_at":"2020-10-16T17:50:39Z"}`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error authorization not found",
+			fields: fields{
+				uc:  stubVoidAuthorizationUseCase{err: domain.ErrAuthorizationNotFound},
+				log: logFake,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"errors":["authorization not found"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error authorization not pending",
+			fields: fields{
+				uc:  stubVoidAuthorizationUseCase{err: domain.ErrAuthorizationNotPending},
+				log: logFake,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"errors":["authorization is not pending"]}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Repository error when void authorization",
+			fields: fields{
+				uc:  stubVoidAuthorizationUseCase{err: errors.New("db_error")},
+				log: logFake,
+			},
+			rawPayload:     []byte(``),
+			wantBody:       `{"errors":["db_error"]}`,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				"/authorizations/3c096a40-ccba-4b58-93ed-57379ab04680/void",
+				bytes.NewReader(tt.rawPayload),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"authorization_id": "3c096a40-ccba-4b58-93ed-57379ab04680"})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewVoidAuthorizationHandler(tt.fields.uc, tt.fields.log)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					w.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			var got = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(got, tt.wantBody) {
+				t.Errorf(
+					"[TestCase '%s'] Got body: '%v' |\n Want body: '%v'",
+					tt.name,
+					got,
+					tt.wantBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+)
+
+type (
+	// TokenVerifier authenticates the principal of a bearer token
+	TokenVerifier interface {
+		Verify(context.Context, string) (domain.Principal, error)
+	}
+
+	// APIKeyAuthenticator authenticates the principal of an API key
+	APIKeyAuthenticator interface {
+		Execute(context.Context, string) (domain.Principal, error)
+	}
+)
+
+// Authentication requires the requests to carry a JWT in the Authorization: Bearer header or an API key
+// in the X-API-Key header, and stores the authenticated principal in the context, scoping the request
+// to the tenant of the principal
+type Authentication struct {
+	tokens  TokenVerifier
+	apiKeys APIKeyAuthenticator
+	log     logger.Logger
+}
+
+// NewAuthentication creates new Authentication with its dependencies
+func NewAuthentication(tokens TokenVerifier, apiKeys APIKeyAuthenticator, log logger.Logger) *Authentication {
+	return &Authentication{
+		tokens:  tokens,
+		apiKeys: apiKeys,
+		log:     log,
+	}
+}
+
+// Execute refuses with 401 the requests without valid credentials
+func (a Authentication) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := a.authenticate(r)
+		if err != nil {
+			a.log.Error(r.Context(), "failed to authenticating request", err)
+			switch err {
+			case domain.ErrCredentialsMissing, domain.ErrCredentialsInvalid:
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				response.NewError([]string{err.Error()}, http.StatusUnauthorized).Send(w)
+			default:
+				response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			}
+			return
+		}
+
+		ctx := domain.WithPrincipal(r.Context(), principal)
+		ctx = domain.WithTenant(ctx, principal.TenantID())
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (a Authentication) authenticate(r *http.Request) (domain.Principal, error) {
+	if header := r.Header.Get("Authorization"); header != "" {
+		scheme, token, ok := strings.Cut(header, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			return domain.Principal{}, domain.ErrCredentialsInvalid
+		}
+
+		return a.tokens.Verify(r.Context(), token)
+	}
+
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return a.apiKeys.Execute(r.Context(), key)
+	}
+
+	return domain.Principal{}, domain.ErrCredentialsMissing
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+)
+
+type stubCredentials struct {
+	credential string
+	principal  domain.Principal
+	err        error
+}
+
+func (s stubCredentials) Verify(_ context.Context, credential string) (domain.Principal, error) {
+	return s.Execute(context.Background(), credential)
+}
+
+func (s stubCredentials) Execute(_ context.Context, credential string) (domain.Principal, error) {
+	if s.err != nil {
+		return domain.Principal{}, s.err
+	}
+
+	if credential != s.credential {
+		return domain.Principal{}, domain.ErrCredentialsInvalid
+	}
+
+	return s.principal, nil
+}
+
+func TestAuthentication_Execute(t *testing.T) {
+	var (
+		client  = domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", []string{domain.ScopeAccountsRead})
+		ci      = domain.NewPrincipal("k", domain.PrincipalAPIKey, "tenant-b", []string{domain.ScopeTransactionsWrite})
+		tokens  = stubCredentials{credential: "jwt", principal: client}
+		apiKeys = stubCredentials{credential: "gtk_key", principal: ci}
+	)
+
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		apiKeys        stubCredentials
+		want           domain.Principal
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "Authenticate bearer token",
+			headers:        map[string]string{"Authorization": "Bearer jwt"},
+			apiKeys:        apiKeys,
+			want:           client,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "Authenticate API key",
+			headers:        map[string]string{"X-API-Key": "gtk_key"},
+			apiKeys:        apiKeys,
+			want:           ci,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "Error credentials missing",
+			apiKeys:        apiKeys,
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"errors":["credentials missing"]}`,
+		},
+		{
+			name:           "Error bearer token invalid",
+			headers:        map[string]string{"Authorization": "Bearer other"},
+			apiKeys:        apiKeys,
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"errors":["credentials invalid"]}`,
+		},
+		{
+			name:           "Error authorization scheme invalid",
+			headers:        map[string]string{"Authorization": "Basic jwt"},
+			apiKeys:        apiKeys,
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"errors":["credentials invalid"]}`,
+		},
+		{
+			name:           "Error API key invalid",
+			headers:        map[string]string{"X-API-Key": "gtk_other"},
+			apiKeys:        apiKeys,
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"errors":["credentials invalid"]}`,
+		},
+		{
+			name:           "Repository error when authenticate API key",
+			headers:        map[string]string{"X-API-Key": "gtk_key"},
+			apiKeys:        stubCredentials{err: errors.New("db_error")},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       `{"errors":["db_error"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got       domain.Principal
+				gotTenant string
+				req       = httptest.NewRequest(http.MethodGet, "/v1/accounts", nil)
+				w         = httptest.NewRecorder()
+			)
+
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			NewAuthentication(tokens, tt.apiKeys, logger.NewLogFake()).Execute(
+				http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+					got, _ = domain.PrincipalFrom(r.Context())
+					gotTenant = domain.TenantFrom(r.Context())
+				}),
+			).ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("[TestCase '%s'] Got status code: '%v' | Want status code: '%v'", tt.name, w.Code, tt.wantStatusCode)
+			}
+
+			if body := strings.TrimSpace(w.Body.String()); body != tt.wantBody {
+				t.Errorf("[TestCase '%s'] Got body: '%v' | Want body: '%v'", tt.name, body, tt.wantBody)
+			}
+
+			if w.Code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") != "Bearer" {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: 'Bearer'", tt.name, w.Header().Get("WWW-Authenticate"))
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if gotTenant != tt.want.TenantID() {
+				t.Errorf("[TestCase '%s'] Got tenant: '%v' | Want tenant: '%v'", tt.name, gotTenant, tt.want.TenantID())
+			}
+		})
+	}
+}
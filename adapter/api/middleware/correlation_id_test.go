@@ -5,6 +5,8 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
 )
 
 func TestCorrelationID_Execute(t *testing.T) {
@@ -41,6 +43,10 @@ func TestCorrelationID_Execute(t *testing.T) {
 					t.Errorf("[TestCase '%s'] Header X-Correlation-Id undefined", tt.name)
 				}
 
+				if gotContext := logger.CorrelationID(r.Context()); gotContext != gotHeader {
+					t.Errorf("[TestCase '%s'] Got context: '%v' | Want: '%v'", tt.name, gotContext, gotHeader)
+				}
+
 				if (tt.want != "") && (!strings.EqualFold(gotHeader, tt.want)) {
 					t.Errorf(
 						"[TestCase '%s'] Got header: '%v' | Want header: '%v'",
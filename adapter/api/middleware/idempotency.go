@@ -0,0 +1,204 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/go-transactions/adapter/api/response"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+)
+
+const maxIdempotencyKeyLength = 255
+
+// Idempotency replays the stored response of requests sent with the same Idempotency-Key header
+type Idempotency struct {
+	creator   domain.IdempotencyKeyCreator
+	finder    domain.IdempotencyKeyFinder
+	updater   domain.IdempotencyKeyUpdater
+	reclaimer domain.IdempotencyKeyReclaimer
+	deleter   domain.IdempotencyKeyDeleter
+	log       logger.Logger
+	ttl       time.Duration
+}
+
+// NewIdempotency creates new Idempotency with its dependencies. A request still in progress after the
+// ttl is considered abandoned, and its key is taken over by the next identical request
+func NewIdempotency(
+	creator domain.IdempotencyKeyCreator,
+	finder domain.IdempotencyKeyFinder,
+	updater domain.IdempotencyKeyUpdater,
+	reclaimer domain.IdempotencyKeyReclaimer,
+	deleter domain.IdempotencyKeyDeleter,
+	log logger.Logger,
+	ttl time.Duration,
+) *Idempotency {
+	return &Idempotency{
+		creator:   creator,
+		finder:    finder,
+		updater:   updater,
+		reclaimer: reclaimer,
+		deleter:   deleter,
+		log:       log,
+		ttl:       ttl,
+	}
+}
+
+// Execute reserves the key before the request is handled and stores its response afterwards. The keys
+// of each tenant are apart, so two tenants sending the same key never see each other's responses
+func (i Idempotency) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			response.NewError([]string{"invalid idempotency key"}, http.StatusBadRequest).Send(w)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			response.NewError([]string{err.Error()}, http.StatusBadRequest).Send(w)
+			return
+		}
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var (
+			ctx            = r.Context()
+			fingerprint    = requestFingerprint(r, body)
+			idempotencyKey = domain.NewIdempotencyKey(key, fingerprint, 0, nil, time.Now()).WithTenant(domain.TenantFrom(ctx))
+		)
+
+		stored, reserved, err := i.reserve(ctx, idempotencyKey)
+		switch {
+		case err == domain.ErrIdempotencyKeyInProgress:
+			response.NewError([]string{err.Error()}, http.StatusConflict).Send(w)
+			return
+		case err != nil:
+			i.log.Error(ctx, "failed to reserve idempotency key", err)
+			response.NewError([]string{err.Error()}, http.StatusInternalServerError).Send(w)
+			return
+		case !reserved:
+			i.replay(w, stored, fingerprint)
+			return
+		}
+
+		rec := newResponseRecorder(w)
+		next.ServeHTTP(rec, r)
+
+		// The outcome is recorded even when the client went away, otherwise the key would be left in progress
+		ctx = context.WithoutCancel(ctx)
+
+		// Server errors release the key so that the client is able to retry the request
+		if rec.statusCode >= http.StatusInternalServerError {
+			if err := i.deleter.Delete(ctx, key); err != nil {
+				i.log.Error(ctx, "failed to release idempotency key", err)
+			}
+			return
+		}
+
+		idempotencyKey.Complete(rec.statusCode, rec.body.Bytes())
+		if err := i.updater.Complete(ctx, idempotencyKey); err != nil {
+			i.log.Error(ctx, "failed to store idempotency key response", err)
+		}
+	})
+}
+
+// reserve reserves the key to the request, or returns the key stored by another request to be replayed.
+// A key released meanwhile is reserved again, and a stale key of the same request is taken over
+func (i Idempotency) reserve(ctx context.Context, key domain.IdempotencyKey) (domain.IdempotencyKey, bool, error) {
+	_, err := i.creator.Create(ctx, key)
+	if err != domain.ErrIdempotencyKeyAlreadyExists {
+		return domain.IdempotencyKey{}, err == nil, err
+	}
+
+	stored, err := i.finder.FindByKey(ctx, key.Key())
+	switch {
+	case err == domain.ErrIdempotencyKeyNotFound:
+		// Released by a server error after the first attempt, so it is a new request
+		_, err = i.creator.Create(ctx, key)
+		if err == domain.ErrIdempotencyKeyAlreadyExists {
+			return domain.IdempotencyKey{}, false, domain.ErrIdempotencyKeyInProgress
+		}
+
+		return domain.IdempotencyKey{}, err == nil, err
+	case err != nil:
+		return domain.IdempotencyKey{}, false, err
+	}
+
+	if stored.Fingerprint() != key.Fingerprint() || !stored.Stale(key.CreatedAt(), i.ttl) {
+		return stored, false, nil
+	}
+
+	err = i.reclaimer.Reclaim(ctx, key, key.CreatedAt().Add(-i.ttl))
+	switch {
+	case err == domain.ErrIdempotencyKeyInProgress:
+		return stored, false, nil
+	case err != nil:
+		return domain.IdempotencyKey{}, false, err
+	}
+
+	i.log.Info(ctx, "took over stale idempotency key")
+	return domain.IdempotencyKey{}, true, nil
+}
+
+func (i Idempotency) replay(w http.ResponseWriter, stored domain.IdempotencyKey, fingerprint string) {
+	if err := stored.Match(fingerprint); err != nil {
+		switch err {
+		case domain.ErrIdempotencyKeyReused:
+			response.NewError([]string{err.Error()}, http.StatusUnprocessableEntity).Send(w)
+			return
+		default:
+			response.NewError([]string{err.Error()}, http.StatusConflict).Send(w)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(stored.StatusCode())
+	w.Write(stored.Body())
+}
+
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	if principal, ok := domain.PrincipalFrom(r.Context()); ok {
+		h.Write([]byte(principal.ID()))
+	}
+	h.Write([]byte(r.Method))
+	h.Write([]byte(r.URL.Path))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{
+		ResponseWriter: w,
+		statusCode:     http.StatusOK,
+	}
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,272 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/adapter/repository/memory"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+)
+
+// releasedIdempotencyKeyRepo reports the key as reserved once, as when it is released by a server error
+// between the reservation and the search of the key
+type releasedIdempotencyKeyRepo struct {
+	*memory.IdempotencyKeyRepository
+	reserved *bool
+}
+
+func (r releasedIdempotencyKeyRepo) Create(ctx context.Context, key domain.IdempotencyKey) (domain.IdempotencyKey, error) {
+	if !*r.reserved {
+		*r.reserved = true
+		return domain.IdempotencyKey{}, domain.ErrIdempotencyKeyAlreadyExists
+	}
+
+	return r.IdempotencyKeyRepository.Create(ctx, key)
+}
+
+// spyCompleteIdempotencyKeyRepo records the error of the context the response was stored with
+type spyCompleteIdempotencyKeyRepo struct {
+	*memory.IdempotencyKeyRepository
+	ctxErr *error
+}
+
+func (s spyCompleteIdempotencyKeyRepo) Complete(ctx context.Context, key domain.IdempotencyKey) error {
+	*s.ctxErr = ctx.Err()
+	return s.IdempotencyKeyRepository.Complete(ctx, key)
+}
+
+func TestIdempotency_Execute(t *testing.T) {
+	type request struct {
+		key    string
+		tenant string
+		body   string
+	}
+	tests := []struct {
+		name           string
+		stored         []domain.IdempotencyKey
+		requests       []request
+		handlerStatus  int
+		wantStatusCode int
+		wantBody       string
+		wantCalls      int
+	}{
+		{
+			name:           "Request without idempotency key",
+			requests:       []request{{body: `{"amount":100}`}, {body: `{"amount":100}`}},
+			handlerStatus:  http.StatusCreated,
+			wantStatusCode: http.StatusCreated,
+			wantBody:       `{"amount":100}`,
+			wantCalls:      2,
+		},
+		{
+			name: "Replay response of identical request",
+			requests: []request{
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+			},
+			handlerStatus:  http.StatusCreated,
+			wantStatusCode: http.StatusCreated,
+			wantBody:       `{"amount":100}`,
+			wantCalls:      1,
+		},
+		{
+			name: "Same key in another tenant",
+			requests: []request{
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", tenant: "tenant-a", body: `{"amount":100}`},
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", tenant: "tenant-b", body: `{"amount":100}`},
+			},
+			handlerStatus:  http.StatusCreated,
+			wantStatusCode: http.StatusCreated,
+			wantBody:       `{"amount":100}`,
+			wantCalls:      2,
+		},
+		{
+			name: "Error key reused with different request",
+			requests: []request{
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":200}`},
+			},
+			handlerStatus:  http.StatusCreated,
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantBody:       `{"errors":["idempotency key already used with a different request"]}`,
+			wantCalls:      1,
+		},
+		{
+			name: "Error request in progress",
+			stored: []domain.IdempotencyKey{
+				domain.NewIdempotencyKey(
+					"b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2",
+					requestFingerprint(httptest.NewRequest(http.MethodPost, "/transactions", nil), []byte(`{"amount":100}`)),
+					0,
+					nil,
+					time.Now(),
+				),
+			},
+			requests: []request{
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+			},
+			handlerStatus:  http.StatusCreated,
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"errors":["request with the same idempotency key is in progress"]}`,
+			wantCalls:      0,
+		},
+		{
+			name: "Take over stale request in progress",
+			stored: []domain.IdempotencyKey{
+				domain.NewIdempotencyKey(
+					"b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2",
+					requestFingerprint(httptest.NewRequest(http.MethodPost, "/transactions", nil), []byte(`{"amount":100}`)),
+					0,
+					nil,
+					time.Now().Add(-time.Hour),
+				),
+			},
+			requests: []request{
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+			},
+			handlerStatus:  http.StatusCreated,
+			wantStatusCode: http.StatusCreated,
+			wantBody:       `{"amount":100}`,
+			wantCalls:      1,
+		},
+		{
+			name: "Error stale key reused with different request",
+			stored: []domain.IdempotencyKey{
+				domain.NewIdempotencyKey(
+					"b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2",
+					requestFingerprint(httptest.NewRequest(http.MethodPost, "/transactions", nil), []byte(`{"amount":200}`)),
+					0,
+					nil,
+					time.Now().Add(-time.Hour),
+				),
+			},
+			requests: []request{
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+			},
+			handlerStatus:  http.StatusCreated,
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantBody:       `{"errors":["idempotency key already used with a different request"]}`,
+			wantCalls:      0,
+		},
+		{
+			name: "Server error releases the key",
+			requests: []request{
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+				{key: "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2", body: `{"amount":100}`},
+			},
+			handlerStatus:  http.StatusInternalServerError,
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       `{"amount":100}`,
+			wantCalls:      2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.NewIdempotencyKeyRepository()
+			for _, k := range tt.stored {
+				if _, err := repo.Create(context.Background(), k); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var calls int
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				body, _ := io.ReadAll(r.Body)
+				w.WriteHeader(tt.handlerStatus)
+				w.Write(body)
+			})
+
+			handler := NewIdempotency(repo, repo, repo, repo, repo, logger.NewLogFake(), time.Minute).Execute(next)
+
+			var rr *httptest.ResponseRecorder
+			for _, req := range tt.requests {
+				r := httptest.NewRequest(http.MethodPost, "/transactions", strings.NewReader(req.body))
+				if req.key != "" {
+					r.Header.Set("Idempotency-Key", req.key)
+				}
+
+				if req.tenant != "" {
+					r = r.WithContext(domain.WithTenant(r.Context(), req.tenant))
+				}
+
+				rr = httptest.NewRecorder()
+				handler.ServeHTTP(rr, r)
+			}
+
+			if rr.Code != tt.wantStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] Got status code: '%v' | Want status code: '%v'",
+					tt.name,
+					rr.Code,
+					tt.wantStatusCode,
+				)
+			}
+
+			if got := strings.TrimSpace(rr.Body.String()); got != tt.wantBody {
+				t.Errorf("[TestCase '%s'] Got body: '%v' | Want body: '%v'", tt.name, got, tt.wantBody)
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("[TestCase '%s'] Got calls: '%v' | Want calls: '%v'", tt.name, calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestIdempotency_Execute_Released(t *testing.T) {
+	var (
+		reserved bool
+		calls    int
+		repo     = memory.NewIdempotencyKeyRepository()
+		creator  = releasedIdempotencyKeyRepo{IdempotencyKeyRepository: repo, reserved: &reserved}
+		next     = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+		})
+	)
+
+	r := httptest.NewRequest(http.MethodPost, "/transactions", strings.NewReader(`{"amount":100}`))
+	r.Header.Set("Idempotency-Key", "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2")
+
+	rr := httptest.NewRecorder()
+	NewIdempotency(creator, repo, repo, repo, repo, logger.NewLogFake(), time.Minute).Execute(next).ServeHTTP(rr, r)
+
+	if rr.Code != http.StatusCreated || calls != 1 {
+		t.Errorf("[TestCase '%s'] Got: '%v %v' | Want: '%v %v'", "Key released meanwhile", rr.Code, calls, http.StatusCreated, 1)
+	}
+}
+
+func TestIdempotency_Execute_ClientGone(t *testing.T) {
+	var (
+		ctxErr      = context.Canceled
+		repo        = memory.NewIdempotencyKeyRepository()
+		updater     = spyCompleteIdempotencyKeyRepo{IdempotencyKeyRepository: repo, ctxErr: &ctxErr}
+		ctx, cancel = context.WithCancel(context.Background())
+		next        = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			cancel()
+		})
+	)
+
+	r := httptest.NewRequest(http.MethodPost, "/transactions", strings.NewReader(`{"amount":100}`)).WithContext(ctx)
+	r.Header.Set("Idempotency-Key", "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2")
+
+	NewIdempotency(repo, repo, updater, repo, repo, logger.NewLogFake(), time.Minute).Execute(next).ServeHTTP(httptest.NewRecorder(), r)
+
+	if ctxErr != nil {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Response stored after the client went away", ctxErr, nil)
+	}
+
+	stored, err := repo.FindByKey(context.Background(), "b7c1f7c4-4a1e-4f0e-9a47-6a3b4a6bd1a2")
+	if err != nil || stored.StatusCode() != http.StatusCreated {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Err: '%v'", "Response stored after the client went away", stored.StatusCode(), err)
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+)
+
+// HTTPMetrics defines the recorder of the HTTP requests served
+type HTTPMetrics interface {
+	ObserveRequest(method string, route string, status int, duration time.Duration)
+}
+
+// Metrics records the duration and the status of the requests by route
+type Metrics struct {
+	metrics HTTPMetrics
+}
+
+// NewMetrics creates new Metrics
+func NewMetrics(metrics HTTPMetrics) *Metrics {
+	return &Metrics{metrics: metrics}
+}
+
+// Execute records the request labeled by the path template of the matched route, keeping the
+// identifiers in the path out of the labels
+func (m Metrics) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			start    = time.Now()
+			recorder = &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		)
+
+		next.ServeHTTP(recorder, r)
+
+		m.metrics.ObserveRequest(r.Method, routeTemplate(r), recorder.statusCode, time.Since(start))
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
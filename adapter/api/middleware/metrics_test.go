@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type spyHTTPMetrics struct {
+	method string
+	route  string
+	status int
+}
+
+func (s *spyHTTPMetrics) ObserveRequest(method string, route string, status int, _ time.Duration) {
+	s.method = method
+	s.route = route
+	s.status = status
+}
+
+func TestMetrics_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		status     int
+		wantRoute  string
+		wantStatus int
+	}{
+		{
+			name:       "Request observed by the path template",
+			path:       "/v1/accounts/deeb291c-18a0-45c3-b28b-df7ebcabe4f8",
+			status:     http.StatusNotFound,
+			wantRoute:  "/v1/accounts/{account_id}",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Request observed with the implicit status",
+			path:       "/v1/accounts/deeb291c-18a0-45c3-b28b-df7ebcabe4f8",
+			wantRoute:  "/v1/accounts/{account_id}",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spy = &spyHTTPMetrics{}
+
+			router := mux.NewRouter()
+			router.Use(NewMetrics(spy).Execute)
+			router.HandleFunc("/v1/accounts/{account_id}", func(w http.ResponseWriter, _ *http.Request) {
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+				_, _ = w.Write([]byte("{}"))
+			})
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if spy.method != http.MethodGet || spy.route != tt.wantRoute || spy.status != tt.wantStatus {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%s %s %d' | Want: '%s %s %d'",
+					tt.name, spy.method, spy.route, spy.status, http.MethodGet, tt.wantRoute, tt.wantStatus,
+				)
+			}
+		})
+	}
+}
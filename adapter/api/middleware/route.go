@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/gorilla/mux"
+)
+
+// Route stores the route that serves the request in the context, so the log entries identify it
+type Route struct{}
+
+// NewRoute creates new Route
+func NewRoute() *Route {
+	return &Route{}
+}
+
+// Execute stores the method and the path template of the matched route, such as "GET /v1/accounts/{account_id}"
+func (rt Route) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(logger.WithRoute(r.Context(), r.Method+" "+routeTemplate(r))))
+	})
+}
+
+// routeTemplate returns the path template of the matched route, or the path when no route matched
+func routeTemplate(r *http.Request) string {
+	if current := mux.CurrentRoute(r); current != nil {
+		if template, err := current.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+
+	return r.URL.Path
+}
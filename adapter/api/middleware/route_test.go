@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/gorilla/mux"
+)
+
+func TestRoute_Execute(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "Route of the path template",
+			path: "/v1/accounts/deeb291c-18a0-45c3-b28b-df7ebcabe4f8",
+			want: "GET /v1/accounts/{account_id}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+
+			router := mux.NewRouter()
+			router.Use(NewRoute().Execute)
+			router.HandleFunc("/v1/accounts/{account_id}", func(_ http.ResponseWriter, r *http.Request) {
+				got = logger.Route(r.Context())
+			})
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// correlationIDKey is the span attribute carrying the correlation id of the request
+const correlationIDKey = attribute.Key("correlation_id")
+
+// Tracing starts the server span of the requests, continuing the trace of the caller
+type Tracing struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracing creates new Tracing with the spans created by provider
+func NewTracing(provider trace.TracerProvider) *Tracing {
+	return &Tracing{
+		tracer:     provider.Tracer("github.com/GSabadini/go-transactions/adapter/api"),
+		propagator: propagation.TraceContext{},
+	}
+}
+
+// Execute extracts the W3C traceparent header and starts the span of the request, named by the
+// method and the path template of the matched route and carrying the correlation id.
+// Responses with status 5xx mark the span as failed
+func (t Tracing) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			route = routeTemplate(r)
+			ctx   = t.propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		)
+
+		ctx, span := t.tracer.Start(
+			ctx,
+			r.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.HTTPRoute(route),
+				correlationIDKey.String(logger.CorrelationID(ctx)),
+			),
+		)
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(recorder.statusCode))
+		if recorder.statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.statusCode))
+		}
+	})
+}
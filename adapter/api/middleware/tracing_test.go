@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gorilla/mux"
+)
+
+func TestTracing_Execute(t *testing.T) {
+	const (
+		traceID       = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID  = "00f067aa0ba902b7"
+		correlationID = "0fb8a2d1-6d4c-4d6b-9f57-8a4d8c1e0b3a"
+	)
+
+	tests := []struct {
+		name        string
+		traceparent string
+		status      int
+		wantTraceID string
+		wantParent  string
+		wantFailed  bool
+	}{
+		{
+			name:        "Span continues the trace of the traceparent header",
+			traceparent: "00-" + traceID + "-" + parentSpanID + "-01",
+			status:      http.StatusCreated,
+			wantTraceID: traceID,
+			wantParent:  parentSpanID,
+		},
+		{
+			name:   "Span starts a trace without traceparent header",
+			status: http.StatusCreated,
+		},
+		{
+			name:       "Span failed by a server error",
+			status:     http.StatusInternalServerError,
+			wantFailed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				recorder = tracetest.NewSpanRecorder()
+				provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+				inner    trace.SpanContext
+			)
+
+			router := mux.NewRouter()
+			router.Use(NewCorrelationID().Execute, NewTracing(provider).Execute)
+			router.HandleFunc("/v1/accounts/{account_id}", func(w http.ResponseWriter, r *http.Request) {
+				inner = trace.SpanContextFromContext(r.Context())
+				w.WriteHeader(tt.status)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/accounts/deeb291c-18a0-45c3-b28b-df7ebcabe4f8", nil)
+			req.Header.Set("X-Correlation-Id", correlationID)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("[TestCase '%s'] Got spans: '%v' | Want: '%v'", tt.name, len(spans), 1)
+			}
+
+			span := spans[0]
+			if got, want := span.Name(), "GET /v1/accounts/{account_id}"; got != want {
+				t.Errorf("[TestCase '%s'] Got name: '%v' | Want: '%v'", tt.name, got, want)
+			}
+
+			if span.SpanContext().SpanID() != inner.SpanID() {
+				t.Errorf("[TestCase '%s'] Got handler span: '%v' | Want: '%v'", tt.name, inner.SpanID(), span.SpanContext().SpanID())
+			}
+
+			if tt.wantTraceID != "" && span.SpanContext().TraceID().String() != tt.wantTraceID {
+				t.Errorf("[TestCase '%s'] Got trace: '%v' | Want: '%v'", tt.name, span.SpanContext().TraceID(), tt.wantTraceID)
+			}
+
+			if got := span.Parent().SpanID(); tt.wantParent != "" && got.String() != tt.wantParent {
+				t.Errorf("[TestCase '%s'] Got parent: '%v' | Want: '%v'", tt.name, got, tt.wantParent)
+			}
+
+			if tt.wantParent == "" && span.Parent().IsValid() {
+				t.Errorf("[TestCase '%s'] Got parent: '%v' | Want: none", tt.name, span.Parent().SpanID())
+			}
+
+			var attrs = make(map[string]string)
+			for _, attr := range span.Attributes() {
+				attrs[string(attr.Key)] = attr.Value.Emit()
+			}
+
+			if got := attrs["correlation_id"]; got != correlationID {
+				t.Errorf("[TestCase '%s'] Got correlation id: '%v' | Want: '%v'", tt.name, got, correlationID)
+			}
+
+			if got := span.Status().Code == codes.Error; got != tt.wantFailed {
+				t.Errorf("[TestCase '%s'] Got failed: '%v' | Want: '%v'", tt.name, got, tt.wantFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package response
+
+import (
+	"encoding/csv"
+	"net/http"
+)
+
+// CSV defines the structure of success for http responses rendered as CSV
+type CSV struct {
+	statusCode int
+	records    [][]string
+}
+
+// NewCSV creates new CSV
+func NewCSV(records [][]string, status int) CSV {
+	return CSV{
+		statusCode: status,
+		records:    records,
+	}
+}
+
+// Send returns a response with CSV format
+func (c CSV) Send(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	w.WriteHeader(c.statusCode)
+	return csv.NewWriter(w).WriteAll(c.records)
+}
@@ -0,0 +1,40 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type captureAuthorizationPresenter struct{}
+
+// NewCaptureAuthorizationPresenter creates new captureAuthorizationPresenter
+func NewCaptureAuthorizationPresenter() usecase.CaptureAuthorizationPresenter {
+	return captureAuthorizationPresenter{}
+}
+
+// Output returns the authorization capture response
+func (c captureAuthorizationPresenter) Output(
+	authorization domain.Authorization,
+	transaction domain.Transaction,
+) usecase.CaptureAuthorizationOutput {
+	return usecase.CaptureAuthorizationOutput{
+		ID:             authorization.ID(),
+		AccountID:      authorization.AccountID(),
+		Amount:         authorization.Amount(),
+		CapturedAmount: authorization.CapturedAmount(),
+		Status:         authorization.Status(),
+		Transaction: usecase.CaptureAuthorizationTransactionOutput{
+			ID: transaction.ID(),
+			Operation: usecase.CaptureAuthorizationOperationOutput{
+				ID:          transaction.Operation().ID(),
+				Description: transaction.Operation().Description(),
+				Type:        transaction.Operation().Type(),
+			},
+			Amount:    transaction.Amount(),
+			Balance:   transaction.Balance(),
+			CreatedAt: transaction.CreatedAt().Format(time.RFC3339),
+		},
+	}
+}
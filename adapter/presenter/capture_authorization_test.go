@@ -0,0 +1,75 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_captureAuthorizationPresenter_Output(t *testing.T) {
+	var opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
+
+	type args struct {
+		authorization domain.Authorization
+		transaction   domain.Transaction
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.CaptureAuthorizationOutput
+	}{
+		{
+			name: "Capture authorization output",
+			args: args{
+				authorization: domain.NewAuthorization(
+					"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					"eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+					opCompraAVista,
+					10025,
+					10000,
+					domain.AuthorizationCaptured,
+					"3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+					time.Time{},
+					time.Time{},
+				),
+				transaction: domain.NewTransaction(
+					"3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+					"eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+					opCompraAVista,
+					10000,
+					-10000,
+					time.Time{},
+				),
+			},
+			want: usecase.CaptureAuthorizationOutput{
+				ID:             "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				AccountID:      "eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+				Amount:         10025,
+				CapturedAmount: 10000,
+				Status:         "CAPTURED",
+				Transaction: usecase.CaptureAuthorizationTransactionOutput{
+					ID: "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+					Operation: usecase.CaptureAuthorizationOperationOutput{
+						ID:          "1",
+						Description: "COMPRA A VISTA",
+						Type:        "DEBIT",
+					},
+					Amount:    -10000,
+					Balance:   -10000,
+					CreatedAt: "0001-01-01T00:00:00Z",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewCaptureAuthorizationPresenter()
+			if got := pre.Output(tt.args.authorization, tt.args.transaction); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
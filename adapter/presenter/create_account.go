@@ -20,6 +20,7 @@ func (c createAccountPresenter) Output(account domain.Account) usecase.CreateAcc
 		ID: account.ID(),
 		Document: usecase.CreateAccountDocumentOutput{
 			Number: account.Document().Number(),
+			Type:   account.Document().Type(),
 		},
 		AvailableCreditLimit: account.AvailableCreditLimit(),
 		CreatedAt:            account.CreatedAt().Format(time.RFC3339),
@@ -21,7 +21,7 @@ func Test_createAccountPresenter_Output(t *testing.T) {
 		{
 			name: "Create account output",
 			args: args{
-				account: domain.NewAccount(
+				account: domain.NewStoredAccount(
 					"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
 					"12345678900",
 					100,
@@ -0,0 +1,26 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type createAPIKeyPresenter struct{}
+
+// NewCreateAPIKeyPresenter creates new createAPIKeyPresenter
+func NewCreateAPIKeyPresenter() usecase.CreateAPIKeyPresenter {
+	return createAPIKeyPresenter{}
+}
+
+// Output returns the API key creation response, the only one that exposes the key
+func (c createAPIKeyPresenter) Output(apiKey domain.APIKey) usecase.CreateAPIKeyOutput {
+	return usecase.CreateAPIKeyOutput{
+		ID:        apiKey.ID(),
+		Name:      apiKey.Name(),
+		Key:       apiKey.Key(),
+		Scopes:    apiKey.Scopes(),
+		CreatedAt: apiKey.CreatedAt().Format(time.RFC3339),
+	}
+}
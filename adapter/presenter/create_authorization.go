@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type createAuthorizationPresenter struct{}
+
+// NewCreateAuthorizationPresenter creates new createAuthorizationPresenter
+func NewCreateAuthorizationPresenter() usecase.CreateAuthorizationPresenter {
+	return createAuthorizationPresenter{}
+}
+
+// Output returns the authorization creation response
+func (c createAuthorizationPresenter) Output(authorization domain.Authorization) usecase.CreateAuthorizationOutput {
+	return usecase.CreateAuthorizationOutput{
+		ID:        authorization.ID(),
+		AccountID: authorization.AccountID(),
+		Operation: usecase.CreateAuthorizationOperationOutput{
+			ID:          authorization.Operation().ID(),
+			Description: authorization.Operation().Description(),
+			Type:        authorization.Operation().Type(),
+		},
+		Amount:         authorization.Amount(),
+		CapturedAmount: authorization.CapturedAmount(),
+		Status:         authorization.Status(),
+		ExpiresAt:      authorization.ExpiresAt().Format(time.RFC3339),
+		CreatedAt:      authorization.CreatedAt().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,62 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_createAuthorizationPresenter_Output(t *testing.T) {
+	var opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
+
+	type args struct {
+		authorization domain.Authorization
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.CreateAuthorizationOutput
+	}{
+		{
+			name: "Create authorization output",
+			args: args{
+				authorization: domain.NewAuthorization(
+					"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					"eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+					opCompraAVista,
+					10025,
+					0,
+					domain.AuthorizationPending,
+					"",
+					time.Date(2020, 10, 24, 22, 17, 40, 0, time.UTC),
+					time.Date(2020, 10, 17, 22, 17, 40, 0, time.UTC),
+				),
+			},
+			want: usecase.CreateAuthorizationOutput{
+				ID:        "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				AccountID: "eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+				Operation: usecase.CreateAuthorizationOperationOutput{
+					ID:          "1",
+					Description: "COMPRA A VISTA",
+					Type:        "DEBIT",
+				},
+				Amount:         10025,
+				CapturedAmount: 0,
+				Status:         "PENDING",
+				ExpiresAt:      "2020-10-24T22:17:40Z",
+				CreatedAt:      "2020-10-17T22:17:40Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewCreateAuthorizationPresenter()
+			if got := pre.Output(tt.args.authorization); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
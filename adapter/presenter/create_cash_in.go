@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type createCashInPresenter struct{}
+
+// NewCreateCashInPresenter creates new createCashInPresenter
+func NewCreateCashInPresenter() usecase.CreateCashInPresenter {
+	return createCashInPresenter{}
+}
+
+// Output returns the cash-in creation response
+func (c createCashInPresenter) Output(cashIn domain.CashIn) usecase.CreateCashInOutput {
+	return usecase.CreateCashInOutput{
+		ID:        cashIn.ID(),
+		AccountID: cashIn.AccountID(),
+		Source: usecase.CreateCashInSourceOutput{
+			Type:      cashIn.Source().Type(),
+			Reference: cashIn.Source().Reference(),
+		},
+		Amount:        cashIn.Amount(),
+		TransactionID: cashIn.Transaction().ID(),
+		CreatedAt:     cashIn.CreatedAt().Format(time.RFC3339),
+	}
+}
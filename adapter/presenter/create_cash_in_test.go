@@ -0,0 +1,59 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_createCashInPresenter_Output(t *testing.T) {
+	var (
+		account   = domain.NewStoredAccount("eae0bbf7-19ee-46d6-8244-77bccd64ab93", "12345678900", 0, time.Time{})
+		source, _ = domain.NewCashInSource(domain.CashInSourcePix, "E00038166202010161750abcdefghijk")
+	)
+
+	type args struct {
+		cashIn domain.CashIn
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.CreateCashInOutput
+	}{
+		{
+			name: "Create cash-in output",
+			args: args{
+				cashIn: domain.NewCashIn(
+					"3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+					&account,
+					"aef3836b-5ea4-4890-80ad-e13337ccf47f",
+					source,
+					2500,
+					time.Time{},
+				),
+			},
+			want: usecase.CreateCashInOutput{
+				ID:        "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+				AccountID: "eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+				Source: usecase.CreateCashInSourceOutput{
+					Type:      "PIX",
+					Reference: "E00038166202010161750abcdefghijk",
+				},
+				Amount:        2500,
+				TransactionID: "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+				CreatedAt:     "0001-01-01T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewCreateCashInPresenter()
+			if got := pre.Output(tt.args.cashIn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
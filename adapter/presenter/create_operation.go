@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type createOperationPresenter struct{}
+
+// NewCreateOperationPresenter creates new createOperationPresenter
+func NewCreateOperationPresenter() usecase.CreateOperationPresenter {
+	return createOperationPresenter{}
+}
+
+// Output returns the operation creation response
+func (c createOperationPresenter) Output(operation domain.Operation) usecase.CreateOperationOutput {
+	return usecase.CreateOperationOutput{
+		ID:          operation.ID(),
+		Description: operation.Description(),
+		Type:        operation.Type(),
+		Enabled:     operation.Enabled(),
+	}
+}
@@ -24,8 +24,26 @@ func (c createTransactionPresenter) Output(transaction domain.Transaction) useca
 			Description: transaction.Operation().Description(),
 			Type:        transaction.Operation().Type(),
 		},
-		Amount:    transaction.Amount(),
-		Balance:   transaction.Balance(),
-		CreatedAt: transaction.CreatedAt().Format(time.RFC3339),
+		Amount:       transaction.Amount(),
+		Balance:      transaction.Balance(),
+		Installments: createTransactionInstallmentsOutput(transaction.InstallmentPlan()),
+		CreatedAt:    transaction.CreatedAt().Format(time.RFC3339),
 	}
 }
+
+func createTransactionInstallmentsOutput(plan domain.InstallmentPlan) []usecase.CreateTransactionInstallmentOutput {
+	if plan.IsZero() {
+		return nil
+	}
+
+	var o = make([]usecase.CreateTransactionInstallmentOutput, 0, len(plan.Installments()))
+	for _, installment := range plan.Installments() {
+		o = append(o, usecase.CreateTransactionInstallmentOutput{
+			Number:  installment.Number(),
+			Amount:  installment.Amount(),
+			DueDate: installment.DueDate().Format(dateLayout),
+		})
+	}
+
+	return o
+}
@@ -11,10 +11,21 @@ import (
 
 func Test_createTransactionPresenter_Output(t *testing.T) {
 	var (
-		opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
-		opPagamento, _    = domain.NewOperation(domain.Pagamento)
+		opCompraAVista, _    = domain.NewOperation(domain.CompraAVista)
+		opPagamento, _       = domain.NewOperation(domain.Pagamento)
+		opCompraParcelada, _ = domain.NewOperation(domain.CompraParcelada)
 	)
 
+	compraParcelada := domain.NewTransaction(
+		"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+		"eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+		opCompraParcelada,
+		10025,
+		-10025,
+		time.Date(2020, 10, 17, 22, 17, 40, 0, time.UTC),
+	)
+	_ = compraParcelada.SplitInstallments(2, domain.RemainderOnFirst)
+
 	type args struct {
 		transaction domain.Transaction
 	}
@@ -73,6 +84,28 @@ func Test_createTransactionPresenter_Output(t *testing.T) {
 				CreatedAt: "0001-01-01T00:00:00Z",
 			},
 		},
+		{
+			name: "Create transaction operation type compra parcelada output",
+			args: args{
+				transaction: compraParcelada,
+			},
+			want: usecase.CreateTransactionOutput{
+				ID:        "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				AccountID: "eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+				Operation: usecase.CreateTransactionOperationOutput{
+					ID:          "2",
+					Description: "COMPRA PARCELADA",
+					Type:        "DEBIT",
+				},
+				Amount:  -10025,
+				Balance: -10025,
+				Installments: []usecase.CreateTransactionInstallmentOutput{
+					{Number: 1, Amount: 5013, DueDate: "2020-11-17"},
+					{Number: 2, Amount: 5012, DueDate: "2020-12-17"},
+				},
+				CreatedAt: "2020-10-17T22:17:40Z",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
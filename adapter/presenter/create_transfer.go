@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type createTransferPresenter struct{}
+
+// NewCreateTransferPresenter creates new createTransferPresenter
+func NewCreateTransferPresenter() usecase.CreateTransferPresenter {
+	return createTransferPresenter{}
+}
+
+// Output returns the transfer creation response
+func (c createTransferPresenter) Output(transfer domain.Transfer) usecase.CreateTransferOutput {
+	return usecase.CreateTransferOutput{
+		ID:                  transfer.ID(),
+		PayerID:             transfer.PayerID(),
+		PayeeID:             transfer.PayeeID(),
+		Amount:              transfer.Amount(),
+		DebitTransactionID:  transfer.Debit().ID(),
+		CreditTransactionID: transfer.Credit().ID(),
+		CreatedAt:           transfer.CreatedAt().Format(time.RFC3339),
+	}
+}
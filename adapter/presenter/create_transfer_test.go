@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_createTransferPresenter_Output(t *testing.T) {
+	var (
+		payer = domain.NewStoredAccount("eae0bbf7-19ee-46d6-8244-77bccd64ab93", "12345678900", 10000, time.Time{})
+		payee = domain.NewStoredAccount("fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "98765432100", 0, time.Time{})
+	)
+
+	transfer, _ := domain.NewTransfer(
+		"3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+		&payer,
+		&payee,
+		"aef3836b-5ea4-4890-80ad-e13337ccf47f",
+		"92c82203-cdba-4932-9860-bce2e6140267",
+		2500,
+		time.Time{},
+	)
+
+	type args struct {
+		transfer domain.Transfer
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.CreateTransferOutput
+	}{
+		{
+			name: "Create transfer output",
+			args: args{
+				transfer: transfer,
+			},
+			want: usecase.CreateTransferOutput{
+				ID:                  "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+				PayerID:             "eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+				PayeeID:             "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				Amount:              2500,
+				DebitTransactionID:  "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+				CreditTransactionID: "92c82203-cdba-4932-9860-bce2e6140267",
+				CreatedAt:           "0001-01-01T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewCreateTransferPresenter()
+			if got := pre.Output(tt.args.transfer); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
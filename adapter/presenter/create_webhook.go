@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type createWebhookPresenter struct{}
+
+// NewCreateWebhookPresenter creates new createWebhookPresenter
+func NewCreateWebhookPresenter() usecase.CreateWebhookPresenter {
+	return createWebhookPresenter{}
+}
+
+// Output returns the webhook creation response, the only one that exposes the secret
+func (c createWebhookPresenter) Output(webhook domain.Webhook) usecase.CreateWebhookOutput {
+	return usecase.CreateWebhookOutput{
+		ID:        webhook.ID(),
+		AccountID: webhook.AccountID(),
+		URL:       webhook.URL(),
+		Secret:    webhook.Secret(),
+		Events:    webhook.Events(),
+		Active:    webhook.Active(),
+		CreatedAt: webhook.CreatedAt().Format(time.RFC3339),
+	}
+}
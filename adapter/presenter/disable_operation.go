@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type disableOperationPresenter struct{}
+
+// NewDisableOperationPresenter creates new disableOperationPresenter
+func NewDisableOperationPresenter() usecase.DisableOperationPresenter {
+	return disableOperationPresenter{}
+}
+
+// Output returns the operation disabling response
+func (d disableOperationPresenter) Output(operation domain.Operation) usecase.DisableOperationOutput {
+	return usecase.DisableOperationOutput{
+		ID:          operation.ID(),
+		Description: operation.Description(),
+		Type:        operation.Type(),
+		Enabled:     operation.Enabled(),
+	}
+}
@@ -20,6 +20,7 @@ func (f findAccountByIDPresenter) Output(account domain.Account) usecase.FindAcc
 		ID: account.ID(),
 		Document: usecase.FindAccountByIDDocumentOutput{
 			Number: account.Document().Number(),
+			Type:   account.Document().Type(),
 		},
 		AvailableCreditLimit: account.AvailableCreditLimit(),
 		CreatedAt:            account.CreatedAt().Format(time.RFC3339),
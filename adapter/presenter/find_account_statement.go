@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type findAccountStatementPresenter struct{}
+
+// NewFindAccountStatementPresenter creates new findAccountStatementPresenter
+func NewFindAccountStatementPresenter() usecase.FindAccountStatementPresenter {
+	return findAccountStatementPresenter{}
+}
+
+// Output returns the account statement fetch response
+func (f findAccountStatementPresenter) Output(statement domain.Statement) usecase.FindAccountStatementOutput {
+	var entries = make([]usecase.FindAccountStatementEntryOutput, 0)
+	for _, entry := range statement.Entries() {
+		transaction := entry.Transaction()
+		entries = append(entries, usecase.FindAccountStatementEntryOutput{
+			TransactionID: transaction.ID(),
+			Operation: usecase.FindAccountStatementOperationOutput{
+				ID:          transaction.Operation().ID(),
+				Description: transaction.Operation().Description(),
+				Type:        transaction.Operation().Type(),
+			},
+			Amount:    transaction.Amount(),
+			Limit:     entry.Limit(),
+			CreatedAt: transaction.CreatedAt().Format(time.RFC3339),
+		})
+	}
+
+	return usecase.FindAccountStatementOutput{
+		AccountID:    statement.AccountID(),
+		From:         statement.From().Format(time.RFC3339),
+		To:           statement.To().Format(time.RFC3339),
+		OpeningLimit: statement.OpeningLimit(),
+		Entries:      entries,
+		Totals:       statement.Totals(),
+		ClosingLimit: statement.ClosingLimit(),
+	}
+}
@@ -0,0 +1,73 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_findAccountStatementPresenter_Output(t *testing.T) {
+	var (
+		opSaque, _ = domain.NewOperation(domain.Saque)
+		from       = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		to         = time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+		statement  = func() domain.Statement {
+			s, _ := domain.NewStatement(
+				domain.NewStoredAccount("1", "12345678900", 900, time.Time{}),
+				from,
+				to,
+				1000,
+				[]domain.Transaction{domain.NewTransaction("a", "1", opSaque, 100, -100, from.Add(time.Hour))},
+			)
+			return s
+		}()
+	)
+
+	type args struct {
+		statement domain.Statement
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.FindAccountStatementOutput
+	}{
+		{
+			name: "Statement with one withdrawal",
+			args: args{
+				statement: statement,
+			},
+			want: usecase.FindAccountStatementOutput{
+				AccountID:    "1",
+				From:         "2026-10-01T00:00:00Z",
+				To:           "2026-10-31T00:00:00Z",
+				OpeningLimit: 1000,
+				Entries: []usecase.FindAccountStatementEntryOutput{
+					{
+						TransactionID: "a",
+						Operation: usecase.FindAccountStatementOperationOutput{
+							ID:          domain.Saque,
+							Description: "SAQUE",
+							Type:        domain.Debit,
+						},
+						Amount:    -100,
+						Limit:     900,
+						CreatedAt: "2026-10-01T01:00:00Z",
+					},
+				},
+				Totals:       map[string]int64{domain.Debit: -100, domain.Credit: 0},
+				ClosingLimit: 900,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewFindAccountStatementPresenter()
+			if got := pre.Output(tt.args.statement); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
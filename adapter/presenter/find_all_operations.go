@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type findAllOperationsPresenter struct{}
+
+// NewFindAllOperationsPresenter creates new findAllOperationsPresenter
+func NewFindAllOperationsPresenter() usecase.FindAllOperationsPresenter {
+	return findAllOperationsPresenter{}
+}
+
+// Output returns the operation catalog response
+func (f findAllOperationsPresenter) Output(operations []domain.Operation) usecase.FindAllOperationsOutput {
+	var o = make([]usecase.FindAllOperationsOperationOutput, 0, len(operations))
+	for _, operation := range operations {
+		o = append(o, usecase.FindAllOperationsOperationOutput{
+			ID:          operation.ID(),
+			Description: operation.Description(),
+			Type:        operation.Type(),
+			Enabled:     operation.Enabled(),
+		})
+	}
+
+	return usecase.FindAllOperationsOutput{Operations: o}
+}
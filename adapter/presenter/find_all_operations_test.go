@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_findAllOperationsPresenter_Output(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		anuidade, _     = domain.NewCatalogOperation("9", "ANUIDADE", domain.Debit, false)
+	)
+
+	type args struct {
+		operations []domain.Operation
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.FindAllOperationsOutput
+	}{
+		{
+			name: "Find all operations output",
+			args: args{
+				operations: []domain.Operation{compraAVista, anuidade},
+			},
+			want: usecase.FindAllOperationsOutput{
+				Operations: []usecase.FindAllOperationsOperationOutput{
+					{ID: "1", Description: "COMPRA A VISTA", Type: "DEBIT", Enabled: true},
+					{ID: "9", Description: "ANUIDADE", Type: "DEBIT", Enabled: false},
+				},
+			},
+		},
+		{
+			name: "Find all operations empty output",
+			args: args{
+				operations: []domain.Operation{},
+			},
+			want: usecase.FindAllOperationsOutput{
+				Operations: []usecase.FindAllOperationsOperationOutput{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewFindAllOperationsPresenter()
+			if got := pre.Output(tt.args.operations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
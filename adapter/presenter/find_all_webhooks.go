@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type findAllWebhooksPresenter struct{}
+
+// NewFindAllWebhooksPresenter creates new findAllWebhooksPresenter
+func NewFindAllWebhooksPresenter() usecase.FindAllWebhooksPresenter {
+	return findAllWebhooksPresenter{}
+}
+
+// Output returns the webhook list response
+func (f findAllWebhooksPresenter) Output(webhooks []domain.Webhook) usecase.FindAllWebhooksOutput {
+	var o = make([]usecase.FindAllWebhooksWebhookOutput, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		o = append(o, usecase.FindAllWebhooksWebhookOutput{
+			ID:        webhook.ID(),
+			AccountID: webhook.AccountID(),
+			URL:       webhook.URL(),
+			Events:    webhook.Events(),
+			Active:    webhook.Active(),
+			CreatedAt: webhook.CreatedAt().Format(time.RFC3339),
+		})
+	}
+
+	return usecase.FindAllWebhooksOutput{Webhooks: o}
+}
@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type findTransactionsByAccountIDPresenter struct{}
+
+// NewFindTransactionsByAccountIDPresenter creates new findTransactionsByAccountIDPresenter
+func NewFindTransactionsByAccountIDPresenter() usecase.FindTransactionsByAccountIDPresenter {
+	return findTransactionsByAccountIDPresenter{}
+}
+
+// Output returns the account transactions fetch response
+func (f findTransactionsByAccountIDPresenter) Output(
+	transactions []domain.Transaction,
+	next domain.TransactionCursor,
+) usecase.FindTransactionsByAccountIDOutput {
+	var o = make([]usecase.FindTransactionsByAccountIDTransactionOutput, 0)
+	for _, transaction := range transactions {
+		o = append(o, usecase.FindTransactionsByAccountIDTransactionOutput{
+			ID:        transaction.ID(),
+			AccountID: transaction.AccountID(),
+			Operation: usecase.FindTransactionsByAccountIDOperationOutput{
+				ID:          transaction.Operation().ID(),
+				Description: transaction.Operation().Description(),
+				Type:        transaction.Operation().Type(),
+			},
+			Amount:       transaction.Amount(),
+			Balance:      transaction.Balance(),
+			Installments: findTransactionsByAccountIDInstallmentsOutput(transaction.InstallmentPlan()),
+			CreatedAt:    transaction.CreatedAt().Format(time.RFC3339),
+		})
+	}
+
+	return usecase.FindTransactionsByAccountIDOutput{
+		Transactions: o,
+		NextCursor:   next.String(),
+	}
+}
+
+func findTransactionsByAccountIDInstallmentsOutput(plan domain.InstallmentPlan) []usecase.FindTransactionsByAccountIDInstallmentOutput {
+	if plan.IsZero() {
+		return nil
+	}
+
+	var o = make([]usecase.FindTransactionsByAccountIDInstallmentOutput, 0, len(plan.Installments()))
+	for _, installment := range plan.Installments() {
+		o = append(o, usecase.FindTransactionsByAccountIDInstallmentOutput{
+			Number:  installment.Number(),
+			Amount:  installment.Amount(),
+			DueDate: installment.DueDate().Format(dateLayout),
+		})
+	}
+
+	return o
+}
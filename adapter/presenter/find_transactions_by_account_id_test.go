@@ -0,0 +1,77 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_findTransactionsByAccountIDPresenter_Output(t *testing.T) {
+	var (
+		opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		transaction       = domain.NewTransaction(
+			"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+			"eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+			opCompraAVista,
+			10025,
+			-10025,
+			time.Time{},
+		)
+	)
+
+	type args struct {
+		transactions []domain.Transaction
+		next         domain.TransactionCursor
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.FindTransactionsByAccountIDOutput
+	}{
+		{
+			name: "Find transactions by account id output",
+			args: args{
+				transactions: []domain.Transaction{transaction},
+				next:         domain.NewTransactionCursor(transaction),
+			},
+			want: usecase.FindTransactionsByAccountIDOutput{
+				Transactions: []usecase.FindTransactionsByAccountIDTransactionOutput{
+					{
+						ID:        "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						AccountID: "eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+						Operation: usecase.FindTransactionsByAccountIDOperationOutput{
+							ID:          "1",
+							Description: "COMPRA A VISTA",
+							Type:        "DEBIT",
+						},
+						Amount:    -10025,
+						Balance:   -10025,
+						CreatedAt: "0001-01-01T00:00:00Z",
+					},
+				},
+				NextCursor: "MDAwMS0wMS0wMVQwMDowMDowMFp8ZmM5NWU5MDctZTBlYi00ZWY4LTkyN2UtM2VhYWQzYTRkOWE4",
+			},
+		},
+		{
+			name: "Find transactions by account id empty output",
+			args: args{
+				transactions: []domain.Transaction{},
+				next:         domain.TransactionCursor{},
+			},
+			want: usecase.FindTransactionsByAccountIDOutput{
+				Transactions: []usecase.FindTransactionsByAccountIDTransactionOutput{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewFindTransactionsByAccountIDPresenter()
+			if got := pre.Output(tt.args.transactions, tt.args.next); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
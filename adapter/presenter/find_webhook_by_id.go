@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type findWebhookByIDPresenter struct{}
+
+// NewFindWebhookByIDPresenter creates new findWebhookByIDPresenter
+func NewFindWebhookByIDPresenter() usecase.FindWebhookByIDPresenter {
+	return findWebhookByIDPresenter{}
+}
+
+// Output returns the webhook response
+func (f findWebhookByIDPresenter) Output(webhook domain.Webhook) usecase.FindWebhookByIDOutput {
+	return usecase.FindWebhookByIDOutput{
+		ID:        webhook.ID(),
+		AccountID: webhook.AccountID(),
+		URL:       webhook.URL(),
+		Events:    webhook.Events(),
+		Active:    webhook.Active(),
+		CreatedAt: webhook.CreatedAt().Format(time.RFC3339),
+	}
+}
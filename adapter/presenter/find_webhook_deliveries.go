@@ -0,0 +1,43 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type findWebhookDeliveriesPresenter struct{}
+
+// NewFindWebhookDeliveriesPresenter creates new findWebhookDeliveriesPresenter
+func NewFindWebhookDeliveriesPresenter() usecase.FindWebhookDeliveriesPresenter {
+	return findWebhookDeliveriesPresenter{}
+}
+
+// Output returns the delivery log response. The next attempt is shown only while the delivery is pending
+func (f findWebhookDeliveriesPresenter) Output(deliveries []domain.WebhookDelivery) usecase.FindWebhookDeliveriesOutput {
+	var o = make([]usecase.FindWebhookDeliveriesDeliveryOutput, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		var output = usecase.FindWebhookDeliveriesDeliveryOutput{
+			ID:        delivery.ID(),
+			EventID:   delivery.EventID(),
+			EventType: delivery.EventType(),
+			Status:    delivery.Status(),
+			Attempts:  delivery.Attempts(),
+			LastError: delivery.LastError(),
+			CreatedAt: delivery.CreatedAt().Format(time.RFC3339),
+		}
+
+		if delivery.Status() == domain.WebhookDeliveryPending {
+			output.NextAttemptAt = delivery.NextAttemptAt().Format(time.RFC3339)
+		}
+
+		if !delivery.DeliveredAt().IsZero() {
+			output.DeliveredAt = delivery.DeliveredAt().Format(time.RFC3339)
+		}
+
+		o = append(o, output)
+	}
+
+	return usecase.FindWebhookDeliveriesOutput{Deliveries: o}
+}
@@ -0,0 +1,83 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_findWebhookDeliveriesPresenter_Output(t *testing.T) {
+	var now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		deliveries []domain.WebhookDelivery
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.FindWebhookDeliveriesOutput
+	}{
+		{
+			name: "Find webhook deliveries output",
+			args: args{
+				deliveries: []domain.WebhookDelivery{
+					domain.NewWebhookDelivery("3", "w", "e3", domain.EventTransactionRejected, nil, domain.WebhookDeliveryPending, 1, "unexpected status code 500", now.Add(30*time.Second), time.Time{}, now),
+					domain.NewWebhookDelivery("2", "w", "e2", domain.EventTransactionCreated, nil, domain.WebhookDeliveryDead, 10, "connection refused", now, time.Time{}, now),
+					domain.NewWebhookDelivery("1", "w", "e1", domain.EventTransactionCreated, nil, domain.WebhookDeliveryDelivered, 1, "", now, now, now),
+				},
+			},
+			want: usecase.FindWebhookDeliveriesOutput{
+				Deliveries: []usecase.FindWebhookDeliveriesDeliveryOutput{
+					{
+						ID:            "3",
+						EventID:       "e3",
+						EventType:     domain.EventTransactionRejected,
+						Status:        domain.WebhookDeliveryPending,
+						Attempts:      1,
+						LastError:     "unexpected status code 500",
+						NextAttemptAt: "2026-10-18T12:00:30Z",
+						CreatedAt:     "2026-10-18T12:00:00Z",
+					},
+					{
+						ID:        "2",
+						EventID:   "e2",
+						EventType: domain.EventTransactionCreated,
+						Status:    domain.WebhookDeliveryDead,
+						Attempts:  10,
+						LastError: "connection refused",
+						CreatedAt: "2026-10-18T12:00:00Z",
+					},
+					{
+						ID:          "1",
+						EventID:     "e1",
+						EventType:   domain.EventTransactionCreated,
+						Status:      domain.WebhookDeliveryDelivered,
+						Attempts:    1,
+						DeliveredAt: "2026-10-18T12:00:00Z",
+						CreatedAt:   "2026-10-18T12:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			name: "Find webhook deliveries empty output",
+			args: args{
+				deliveries: []domain.WebhookDelivery{},
+			},
+			want: usecase.FindWebhookDeliveriesOutput{
+				Deliveries: []usecase.FindWebhookDeliveriesDeliveryOutput{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewFindWebhookDeliveriesPresenter()
+			if got := pre.Output(tt.args.deliveries); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
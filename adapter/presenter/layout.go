@@ -0,0 +1,4 @@
+package presenter
+
+// dateLayout formats dates without time, such as due dates
+const dateLayout = "2006-01-02"
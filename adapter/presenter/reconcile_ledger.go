@@ -0,0 +1,31 @@
+package presenter
+
+import (
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type reconcileLedgerPresenter struct{}
+
+// NewReconcileLedgerPresenter creates new reconcileLedgerPresenter
+func NewReconcileLedgerPresenter() usecase.ReconcileLedgerPresenter {
+	return reconcileLedgerPresenter{}
+}
+
+// Output returns the ledger reconciliation response
+func (r reconcileLedgerPresenter) Output(checked int, drifts []domain.AccountLedgerBalance) usecase.ReconcileLedgerOutput {
+	var o = make([]usecase.ReconcileLedgerDriftOutput, 0, len(drifts))
+	for _, drift := range drifts {
+		o = append(o, usecase.ReconcileLedgerDriftOutput{
+			AccountID:            drift.AccountID(),
+			AvailableCreditLimit: drift.Limit(),
+			LedgerLimit:          drift.LedgerLimit(),
+			Drift:                drift.Drift(),
+		})
+	}
+
+	return usecase.ReconcileLedgerOutput{
+		Checked: checked,
+		Drifts:  o,
+	}
+}
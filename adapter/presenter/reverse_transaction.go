@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type reverseTransactionPresenter struct{}
+
+// NewReverseTransactionPresenter creates new reverseTransactionPresenter
+func NewReverseTransactionPresenter() usecase.ReverseTransactionPresenter {
+	return reverseTransactionPresenter{}
+}
+
+// Output returns the transaction reversal response
+func (r reverseTransactionPresenter) Output(transaction domain.Transaction) usecase.ReverseTransactionOutput {
+	return usecase.ReverseTransactionOutput{
+		ID:                    transaction.ID(),
+		AccountID:             transaction.AccountID(),
+		OriginalTransactionID: transaction.OriginalTransactionID(),
+		Operation: usecase.ReverseTransactionOperationOutput{
+			ID:          transaction.Operation().ID(),
+			Description: transaction.Operation().Description(),
+			Type:        transaction.Operation().Type(),
+		},
+		Amount:    transaction.Amount(),
+		Balance:   transaction.Balance(),
+		CreatedAt: transaction.CreatedAt().Format(time.RFC3339),
+	}
+}
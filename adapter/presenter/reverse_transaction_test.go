@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func Test_reverseTransactionPresenter_Output(t *testing.T) {
+	var opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
+
+	reversal, _ := domain.NewTransaction(
+		"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+		"eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+		opCompraAVista,
+		10025,
+		-10025,
+		time.Time{},
+	).Reverse("3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1", 25, 0, time.Time{})
+
+	type args struct {
+		transaction domain.Transaction
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.ReverseTransactionOutput
+	}{
+		{
+			name: "Reverse transaction output",
+			args: args{
+				transaction: reversal,
+			},
+			want: usecase.ReverseTransactionOutput{
+				ID:                    "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+				AccountID:             "eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+				OriginalTransactionID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				Operation: usecase.ReverseTransactionOperationOutput{
+					ID:          "5",
+					Description: "ESTORNO",
+					Type:        "CREDIT",
+				},
+				Amount:    25,
+				Balance:   0,
+				CreatedAt: "0001-01-01T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewReverseTransactionPresenter()
+			if got := pre.Output(tt.args.transaction); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
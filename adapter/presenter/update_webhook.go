@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type updateWebhookPresenter struct{}
+
+// NewUpdateWebhookPresenter creates new updateWebhookPresenter
+func NewUpdateWebhookPresenter() usecase.UpdateWebhookPresenter {
+	return updateWebhookPresenter{}
+}
+
+// Output returns the webhook update response
+func (u updateWebhookPresenter) Output(webhook domain.Webhook) usecase.UpdateWebhookOutput {
+	return usecase.UpdateWebhookOutput{
+		ID:        webhook.ID(),
+		AccountID: webhook.AccountID(),
+		URL:       webhook.URL(),
+		Events:    webhook.Events(),
+		Active:    webhook.Active(),
+		CreatedAt: webhook.CreatedAt().Format(time.RFC3339),
+	}
+}
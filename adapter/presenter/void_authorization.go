@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+type voidAuthorizationPresenter struct{}
+
+// NewVoidAuthorizationPresenter creates new voidAuthorizationPresenter
+func NewVoidAuthorizationPresenter() usecase.VoidAuthorizationPresenter {
+	return voidAuthorizationPresenter{}
+}
+
+// Output returns the authorization void response
+func (v voidAuthorizationPresenter) Output(authorization domain.Authorization) usecase.VoidAuthorizationOutput {
+	return usecase.VoidAuthorizationOutput{
+		ID:        authorization.ID(),
+		AccountID: authorization.AccountID(),
+		Operation: usecase.VoidAuthorizationOperationOutput{
+			ID:          authorization.Operation().ID(),
+			Description: authorization.Operation().Description(),
+			Type:        authorization.Operation().Type(),
+		},
+		Amount:         authorization.Amount(),
+		CapturedAmount: authorization.CapturedAmount(),
+		Status:         authorization.Status(),
+		ExpiresAt:      authorization.ExpiresAt().Format(time.RFC3339),
+		CreatedAt:      authorization.CreatedAt().Format(time.RFC3339),
+	}
+}
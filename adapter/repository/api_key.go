@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+// apiKeyColumns are the columns read by scanAPIKey, in order
+const apiKeyColumns = `id, tenant_id, name, key_hash, scopes, created_at, revoked_at`
+
+type apiKeyRepository struct {
+	db *sql.DB
+}
+
+// NewAPIKeyRepository creates new apiKeyRepository with its dependencies
+func NewAPIKeyRepository(db *sql.DB) domain.APIKeyRepository {
+	return apiKeyRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database. The scopes are stored comma separated
+func (a apiKeyRepository) Create(ctx context.Context, apiKey domain.APIKey) (domain.APIKey, error) {
+	ctx, span := startSpan(ctx, "CreateAPIKey", "")
+	defer span.End()
+
+	if _, err := executorFrom(ctx, a.db).ExecContext(
+		ctx,
+		`INSERT INTO api_keys (id, tenant_id, name, key_hash, scopes, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		apiKey.ID(),
+		apiKey.TenantID(),
+		apiKey.Name(),
+		apiKey.Hash(),
+		strings.Join(apiKey.Scopes(), ","),
+		apiKey.CreatedAt(),
+	); err != nil {
+		return domain.APIKey{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return apiKey, nil
+}
+
+// FindByID performs select into the database
+func (a apiKeyRepository) FindByID(ctx context.Context, ID string) (domain.APIKey, error) {
+	ctx, span := startSpan(ctx, "FindAPIKeyByID", "")
+	defer span.End()
+
+	return a.find(
+		ctx,
+		`SELECT `+apiKeyColumns+` FROM api_keys WHERE id = ? AND (? OR tenant_id = ?)`,
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	)
+}
+
+// FindByHash performs select into the database
+func (a apiKeyRepository) FindByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	ctx, span := startSpan(ctx, "FindAPIKeyByHash", "")
+	defer span.End()
+
+	return a.find(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash = ?`, hash)
+}
+
+// Update performs update into the database
+func (a apiKeyRepository) Update(ctx context.Context, apiKey domain.APIKey) error {
+	ctx, span := startSpan(ctx, "UpdateAPIKey", "")
+	defer span.End()
+
+	if _, err := executorFrom(ctx, a.db).ExecContext(
+		ctx,
+		`UPDATE api_keys SET name = ?, scopes = ?, revoked_at = ? WHERE id = ? AND (? OR tenant_id = ?)`,
+		apiKey.Name(),
+		strings.Join(apiKey.Scopes(), ","),
+		sql.NullTime{
+			Time:  apiKey.RevokedAt(),
+			Valid: apiKey.Revoked(),
+		},
+		apiKey.ID(),
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+func (a apiKeyRepository) find(ctx context.Context, query string, args ...interface{}) (domain.APIKey, error) {
+	apiKey, err := scanAPIKey(executorFrom(ctx, a.db).QueryRowContext(ctx, query, args...))
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.APIKey{}, domain.ErrAPIKeyNotFound
+	case err != nil:
+		return domain.APIKey{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return apiKey, nil
+}
+
+func scanAPIKey(row scanner) (domain.APIKey, error) {
+	var (
+		id        string
+		tenantID  string
+		name      string
+		hash      string
+		scopes    string
+		createdAt time.Time
+		revokedAt sql.NullTime
+	)
+
+	if err := row.Scan(&id, &tenantID, &name, &hash, &scopes, &createdAt, &revokedAt); err != nil {
+		return domain.APIKey{}, err
+	}
+
+	return domain.NewAPIKey(id, tenantID, name, hash, strings.Split(scopes, ","), createdAt, revokedAt.Time), nil
+}
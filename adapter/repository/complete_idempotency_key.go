@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type completeIdempotencyKeyRepository struct {
+	db *sql.DB
+}
+
+// NewCompleteIdempotencyKeyRepository creates new completeIdempotencyKeyRepository with its dependencies
+func NewCompleteIdempotencyKeyRepository(db *sql.DB) domain.IdempotencyKeyUpdater {
+	return completeIdempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// Complete performs update into the database
+func (c completeIdempotencyKeyRepository) Complete(ctx context.Context, key domain.IdempotencyKey) error {
+	ctx, span := startSpan(ctx, "CompleteIdempotencyKey", "")
+	defer span.End()
+
+	if _, err := traced(c.db).ExecContext(
+		ctx,
+		`UPDATE idempotency_keys SET status_code = ?, response_body = ? WHERE tenant_id = ? AND id = ?`,
+		key.StatusCode(),
+		key.Body(),
+		key.TenantID(),
+		key.Key(),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/GSabadini/go-transactions/domain"
 	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 )
 
@@ -20,12 +21,19 @@ func NewCreateAccountRepository(db *sql.DB) domain.AccountCreator {
 	}
 }
 
-// Create performs insert into the database
+// Create performs insert into the database, along with the journal entry of the initial credit limit
+// and the AccountCreated event
 func (c createAccountRepository) Create(ctx context.Context, account domain.Account) (domain.Account, error) {
-	if _, err := c.db.ExecContext(
+	ctx, span := startSpan(ctx, "CreateAccount", account.ID())
+	defer span.End()
+
+	db := executorFrom(ctx, c.db)
+
+	if _, err := db.ExecContext(
 		ctx,
-		`INSERT INTO accounts (id, document_number, available_credit_limit, created_at) VALUES (?, ?, ?, ?)`,
+		`INSERT INTO accounts (id, tenant_id, document_number, available_credit_limit, created_at) VALUES (?, ?, ?, ?, ?)`,
 		account.ID(),
+		account.TenantID(),
 		account.Document().Number(),
 		account.AvailableCreditLimit(),
 		account.CreatedAt(),
@@ -39,5 +47,13 @@ func (c createAccountRepository) Create(ctx context.Context, account domain.Acco
 		return domain.Account{}, errors.Wrap(err, errUnknown.Error())
 	}
 
+	if err := createJournalEntry(ctx, db, domain.NewOpeningJournalEntry(uuid.New().String(), account)); err != nil {
+		return domain.Account{}, err
+	}
+
+	if err := createOutboxEvent(ctx, db, domain.NewAccountCreatedEvent(uuid.New().String(), account)); err != nil {
+		return domain.Account{}, err
+	}
+
 	return account, nil
 }
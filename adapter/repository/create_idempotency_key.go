@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+)
+
+type createIdempotencyKeyRepository struct {
+	db *sql.DB
+}
+
+// NewCreateIdempotencyKeyRepository creates new createIdempotencyKeyRepository with its dependencies
+func NewCreateIdempotencyKeyRepository(db *sql.DB) domain.IdempotencyKeyCreator {
+	return createIdempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database
+func (c createIdempotencyKeyRepository) Create(ctx context.Context, key domain.IdempotencyKey) (domain.IdempotencyKey, error) {
+	ctx, span := startSpan(ctx, "CreateIdempotencyKey", "")
+	defer span.End()
+
+	if _, err := traced(c.db).ExecContext(
+		ctx,
+		`INSERT INTO idempotency_keys (id, tenant_id, fingerprint, status_code, response_body, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		key.Key(),
+		key.TenantID(),
+		key.Fingerprint(),
+		key.StatusCode(),
+		key.Body(),
+		key.CreatedAt(),
+	); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			if mysqlErr.Number == errDupEntry {
+				return domain.IdempotencyKey{}, domain.ErrIdempotencyKeyAlreadyExists
+			}
+		}
+
+		return domain.IdempotencyKey{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return key, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 )
 
@@ -19,47 +20,57 @@ func NewCreateTransactionRepository(db *sql.DB) domain.TransactionCreator {
 	}
 }
 
-// Create performs insert into the database
+// Create performs insert into the database, along with the journal entry
+// of the transaction and the TransactionCreated event
 func (c createTransactionRepository) Create(ctx context.Context, transaction domain.Transaction) (domain.Transaction, error) {
-	tx, ok := ctx.Value("TxKey").(*sql.Tx)
-	if !ok {
-		var err error
-		tx, err = c.db.BeginTx(ctx, &sql.TxOptions{})
-		if err != nil {
-			return domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
-		}
+	ctx, span := startSpan(ctx, "CreateTransaction", transaction.AccountID())
+	defer span.End()
+
+	if !domain.TenantAllowed(ctx, transaction.TenantID()) {
+		return domain.Transaction{}, domain.ErrAccountNotFound
 	}
 
-	if _, err := tx.ExecContext(
+	db := executorFrom(ctx, c.db)
+
+	if _, err := db.ExecContext(
 		ctx,
-		`INSERT INTO transactions (id, account_id, operation_id, amount, balance, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		`INSERT INTO transactions (id, tenant_id, account_id, operation_id, amount, balance, original_transaction_id, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
 		transaction.ID(),
+		transaction.TenantID(),
 		transaction.AccountID(),
 		transaction.Operation().ID(),
 		transaction.Amount(),
 		transaction.Balance(),
+		sql.NullString{
+			String: transaction.OriginalTransactionID(),
+			Valid:  transaction.OriginalTransactionID() != "",
+		},
 		transaction.CreatedAt(),
 	); err != nil {
 		return domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
 	}
 
-	return transaction, nil
-}
+	for _, installment := range transaction.InstallmentPlan().Installments() {
+		if _, err := db.ExecContext(
+			ctx,
+			`INSERT INTO installments (transaction_id, number, amount, due_date) VALUES (?, ?, ?, ?)`,
+			transaction.ID(),
+			installment.Number(),
+			installment.Amount(),
+			installment.DueDate(),
+		); err != nil {
+			return domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+		}
+	}
 
-func (c createTransactionRepository) WithTransaction(ctx context.Context, fn func(ctxFn context.Context) error) error {
-	tx, err := c.db.BeginTx(ctx, &sql.TxOptions{})
-	if err != nil {
-		return errors.Wrap(err, errUnknown.Error())
+	if err := createJournalEntry(ctx, db, domain.NewTransactionJournalEntry(uuid.New().String(), transaction)); err != nil {
+		return domain.Transaction{}, err
 	}
 
-	ctxTx := context.WithValue(ctx, "TxKey", tx)
-	err = fn(ctxTx)
-	if err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			return errors.Wrap(err, "rollback error")
-		}
-		return err
+	if err := createOutboxEvent(ctx, db, domain.NewTransactionCreatedEvent(uuid.New().String(), transaction)); err != nil {
+		return domain.Transaction{}, err
 	}
 
-	return tx.Commit()
+	return transaction, nil
 }
@@ -1,11 +1,29 @@
 package repository
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	pkgerrors "github.com/pkg/errors"
+)
 
 const (
-	errDupEntry = 1062
+	errDupEntry        = 1062
+	errLockWaitTimeout = 1205
+	errDeadlock        = 1213
 )
 
 var (
 	errUnknown = errors.New("unknown error")
 )
+
+// isRetryable reports whether the error aborted the database transaction because of a lock
+// conflict with a concurrent transaction, in which case running it again may succeed
+func isRetryable(err error) bool {
+	mysqlErr, ok := pkgerrors.Cause(err).(*mysql.MySQLError)
+	if !ok {
+		return false
+	}
+
+	return mysqlErr.Number == errDeadlock || mysqlErr.Number == errLockWaitTimeout
+}
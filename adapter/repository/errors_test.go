@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func Test_isRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "Deadlock",
+			err:  &mysql.MySQLError{Number: errDeadlock},
+			want: true,
+		},
+		{
+			name: "Lock wait timeout wrapped by a repository",
+			err:  pkgerrors.Wrap(&mysql.MySQLError{Number: errLockWaitTimeout}, errUnknown.Error()),
+			want: true,
+		},
+		{
+			name: "Duplicate entry",
+			err:  &mysql.MySQLError{Number: errDupEntry},
+			want: false,
+		},
+		{
+			name: "Not a mysql error",
+			err:  errors.New("credit limit insufficient"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
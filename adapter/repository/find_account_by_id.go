@@ -22,31 +22,30 @@ func NewAccountByIDRepository(db *sql.DB) domain.AccountFinder {
 
 // FindByID performs select into the database
 func (f findAccountByIDRepository) FindByID(ctx context.Context, ID string) (domain.Account, error) {
-	tx, ok := ctx.Value("TxKey").(*sql.Tx)
-	if !ok {
-		var err error
-		tx, err = f.db.BeginTx(ctx, &sql.TxOptions{})
-		if err != nil {
-			return domain.Account{}, errors.Wrap(err, errUnknown.Error())
-		}
-	}
+	ctx, span := startSpan(ctx, "FindAccountByID", ID)
+	defer span.End()
+
+	db := executorFrom(ctx, f.db)
 
 	var (
 		id            string
+		tenantID      string
 		docNumber     string
 		avCreditLimit int64
 		createdAt     time.Time
 	)
 
-	err := tx.QueryRowContext(
+	err := db.QueryRowContext(
 		ctx,
-		"SELECT * FROM accounts WHERE id = ?",
+		"SELECT id, tenant_id, document_number, available_credit_limit, created_at FROM accounts WHERE id = ? AND (? OR tenant_id = ?)",
 		ID,
-	).Scan(&id, &docNumber, &avCreditLimit, &createdAt)
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	).Scan(&id, &tenantID, &docNumber, &avCreditLimit, &createdAt)
 	switch {
 	case err == sql.ErrNoRows:
 		return domain.Account{}, domain.ErrAccountNotFound
 	default:
-		return domain.NewAccount(id, docNumber, avCreditLimit, createdAt), errors.Wrap(err, errUnknown.Error())
+		return domain.NewStoredAccount(id, docNumber, avCreditLimit, createdAt).WithTenant(tenantID), errors.Wrap(err, errUnknown.Error())
 	}
 }
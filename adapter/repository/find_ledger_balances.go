@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findLedgerBalancesRepository struct {
+	db *sql.DB
+}
+
+// NewFindLedgerBalancesRepository creates new findLedgerBalancesRepository with its dependencies
+func NewFindLedgerBalancesRepository(db *sql.DB) domain.LedgerBalanceFinder {
+	return findLedgerBalancesRepository{
+		db: db,
+	}
+}
+
+// FindAccountBalances performs select into the database, summing the postings and the pending holds of every account of the tenant
+func (f findLedgerBalancesRepository) FindAccountBalances(ctx context.Context) ([]domain.AccountLedgerBalance, error) {
+	ctx, span := startSpan(ctx, "FindLedgerBalances", "")
+	defer span.End()
+
+	rows, err := executorFrom(ctx, f.db).QueryContext(
+		ctx,
+		`SELECT
+			a.id,
+			a.available_credit_limit,
+			COALESCE((SELECT SUM(p.amount) FROM postings p WHERE p.ledger_account = CONCAT(?, a.id)), 0),
+			COALESCE((SELECT SUM(h.amount) FROM authorizations h WHERE h.account_id = a.id AND h.status = ?), 0)
+		FROM accounts a
+		WHERE (? OR a.tenant_id = ?)
+		ORDER BY a.id`,
+		domain.CustomerLedgerAccount(""),
+		domain.AuthorizationPending,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	)
+	if err != nil {
+		return []domain.AccountLedgerBalance{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var balances = make([]domain.AccountLedgerBalance, 0)
+	for rows.Next() {
+		var (
+			id            string
+			limit         int64
+			ledgerBalance int64
+			held          int64
+		)
+
+		if err = rows.Scan(&id, &limit, &ledgerBalance, &held); err != nil {
+			return []domain.AccountLedgerBalance{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		balances = append(balances, domain.NewAccountLedgerBalance(id, limit, ledgerBalance, held))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.AccountLedgerBalance{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return balances, nil
+}
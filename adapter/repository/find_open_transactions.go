@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findOpenTransactionsRepository struct {
+	db         *sql.DB
+	operations domain.OperationFinder
+}
+
+// NewFindOpenTransactionsRepository creates new findOpenTransactionsRepository with its dependencies
+func NewFindOpenTransactionsRepository(db *sql.DB, operations domain.OperationFinder) domain.TransactionBalanceFinder {
+	return findOpenTransactionsRepository{
+		db:         db,
+		operations: operations,
+	}
+}
+
+// FindOpenByAccountID performs select into the database, locking the rows with open balance oldest first,
+// by id when created at the same time, so every settlement locks and settles them in the same order
+func (f findOpenTransactionsRepository) FindOpenByAccountID(ctx context.Context, accountID string) ([]domain.Transaction, error) {
+	ctx, span := startSpan(ctx, "FindOpenTransactions", accountID)
+	defer span.End()
+
+	db := executorFrom(ctx, f.db)
+
+	rows, err := db.QueryContext(
+		ctx,
+		`SELECT id, tenant_id, account_id, operation_id, amount, balance, created_at FROM transactions
+			WHERE account_id = ? AND (? OR tenant_id = ?) AND balance <> 0 ORDER BY created_at ASC, id ASC FOR UPDATE`,
+		accountID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	)
+	if err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var transactions = make([]domain.Transaction, 0)
+	for rows.Next() {
+		var (
+			id          string
+			tenantID    string
+			accID       string
+			operationID string
+			amount      int64
+			balance     int64
+			createdAt   time.Time
+		)
+
+		if err = rows.Scan(&id, &tenantID, &accID, &operationID, &amount, &balance, &createdAt); err != nil {
+			return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		op, err := f.operations.FindByID(ctx, operationID)
+		if err != nil {
+			return []domain.Transaction{}, err
+		}
+
+		transactions = append(transactions, domain.NewTransaction(
+			id,
+			accID,
+			op,
+			absAmount(amount),
+			balance,
+			createdAt,
+		).WithTenant(tenantID))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return transactions, nil
+}
+
+// absAmount returns the amount as received by domain.NewTransaction, which signs it by operation type
+func absAmount(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+	return amount
+}
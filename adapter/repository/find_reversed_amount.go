@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findReversedAmountRepository struct {
+	db *sql.DB
+}
+
+// NewFindReversedAmountRepository creates new findReversedAmountRepository with its dependencies
+func NewFindReversedAmountRepository(db *sql.DB) domain.TransactionReversedAmountFinder {
+	return findReversedAmountRepository{
+		db: db,
+	}
+}
+
+// FindReversedAmount performs select into the database summing the reversals of the transaction
+func (f findReversedAmountRepository) FindReversedAmount(ctx context.Context, ID string) (int64, error) {
+	ctx, span := startSpan(ctx, "FindReversedAmount", "")
+	defer span.End()
+
+	db := executorFrom(ctx, f.db)
+
+	var reversed int64
+	if err := db.QueryRowContext(
+		ctx,
+		`SELECT COALESCE(SUM(amount), 0) FROM transactions WHERE original_transaction_id = ? AND (? OR tenant_id = ?)`,
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	).Scan(&reversed); err != nil {
+		return 0, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return reversed, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findTransactionByIDRepository struct {
+	db         *sql.DB
+	operations domain.OperationFinder
+}
+
+// NewFindTransactionByIDRepository creates new findTransactionByIDRepository with its dependencies
+func NewFindTransactionByIDRepository(db *sql.DB, operations domain.OperationFinder) domain.TransactionByIDFinder {
+	return findTransactionByIDRepository{
+		db:         db,
+		operations: operations,
+	}
+}
+
+// FindByID performs select into the database, locking the row until the end of the transaction
+func (f findTransactionByIDRepository) FindByID(ctx context.Context, ID string) (domain.Transaction, error) {
+	ctx, span := startSpan(ctx, "FindTransactionByID", "")
+	defer span.End()
+
+	db := executorFrom(ctx, f.db)
+
+	var (
+		id          string
+		tenantID    string
+		accID       string
+		operationID string
+		amount      int64
+		balance     int64
+		createdAt   time.Time
+	)
+
+	err := db.QueryRowContext(
+		ctx,
+		`SELECT id, tenant_id, account_id, operation_id, amount, balance, created_at FROM transactions WHERE id = ? AND (? OR tenant_id = ?) FOR UPDATE`,
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	).Scan(&id, &tenantID, &accID, &operationID, &amount, &balance, &createdAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.Transaction{}, domain.ErrTransactionNotFound
+	case err != nil:
+		return domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	op, err := f.operations.FindByID(ctx, operationID)
+	if err != nil {
+		return domain.Transaction{}, err
+	}
+
+	return domain.NewTransaction(id, accID, op, absAmount(amount), balance, createdAt).WithTenant(tenantID), nil
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findTransactionsByAccountIDRepository struct {
+	db         *sql.DB
+	operations domain.OperationFinder
+}
+
+// NewFindTransactionsByAccountIDRepository creates new findTransactionsByAccountIDRepository with its dependencies
+func NewFindTransactionsByAccountIDRepository(db *sql.DB, operations domain.OperationFinder) domain.TransactionFinder {
+	return findTransactionsByAccountIDRepository{
+		db:         db,
+		operations: operations,
+	}
+}
+
+// FindByAccountID performs select into the database, newest transactions first
+func (f findTransactionsByAccountIDRepository) FindByAccountID(
+	ctx context.Context,
+	filter domain.TransactionFilter,
+) ([]domain.Transaction, error) {
+	ctx, span := startSpan(ctx, "FindTransactionsByAccountID", filter.AccountID)
+	defer span.End()
+
+	var (
+		conditions = []string{"account_id = ?"}
+		args       = []interface{}{filter.AccountID}
+	)
+
+	if !domain.AllTenants(ctx) {
+		conditions = append(conditions, "tenant_id = ?")
+		args = append(args, domain.TenantFrom(ctx))
+	}
+
+	if filter.OperationID != "" {
+		conditions = append(conditions, "operation_id = ?")
+		args = append(args, filter.OperationID)
+	}
+
+	switch filter.Type {
+	case domain.Debit:
+		conditions = append(conditions, "amount < 0")
+	case domain.Credit:
+		conditions = append(conditions, "amount > 0")
+	}
+
+	if filter.MinAmount > 0 {
+		conditions = append(conditions, "ABS(amount) >= ?")
+		args = append(args, filter.MinAmount)
+	}
+
+	if filter.MaxAmount > 0 {
+		conditions = append(conditions, "ABS(amount) <= ?")
+		args = append(args, filter.MaxAmount)
+	}
+
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.From)
+	}
+
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "created_at <= ?")
+		args = append(args, filter.To)
+	}
+
+	if !filter.After.IsZero() {
+		conditions = append(conditions, "(created_at < ? OR (created_at = ? AND id < ?))")
+		args = append(args, filter.After.CreatedAt(), filter.After.CreatedAt(), filter.After.ID())
+	}
+
+	args = append(args, filter.Limit)
+
+	rows, err := executorFrom(ctx, f.db).QueryContext(
+		ctx,
+		`SELECT id, tenant_id, account_id, operation_id, amount, balance, created_at FROM transactions WHERE `+
+			strings.Join(conditions, " AND ")+
+			` ORDER BY created_at DESC, id DESC LIMIT ?`,
+		args...,
+	)
+	if err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var transactions = make([]domain.Transaction, 0)
+	for rows.Next() {
+		var (
+			id          string
+			tenantID    string
+			accID       string
+			operationID string
+			amount      int64
+			balance     int64
+			createdAt   time.Time
+		)
+
+		if err = rows.Scan(&id, &tenantID, &accID, &operationID, &amount, &balance, &createdAt); err != nil {
+			return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		op, err := f.operations.FindByID(ctx, operationID)
+		if err != nil {
+			return []domain.Transaction{}, err
+		}
+
+		transactions = append(transactions, domain.NewTransaction(
+			id,
+			accID,
+			op,
+			absAmount(amount),
+			balance,
+			createdAt,
+		).WithTenant(tenantID))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return f.withInstallmentPlans(ctx, transactions)
+}
+
+// withInstallmentPlans loads the installments of the COMPRA PARCELADA transactions
+func (f findTransactionsByAccountIDRepository) withInstallmentPlans(
+	ctx context.Context,
+	transactions []domain.Transaction,
+) ([]domain.Transaction, error) {
+	var (
+		placeholders []string
+		args         []interface{}
+	)
+
+	for _, transaction := range transactions {
+		if transaction.Operation().ID() == domain.CompraParcelada {
+			placeholders = append(placeholders, "?")
+			args = append(args, transaction.ID())
+		}
+	}
+
+	if len(args) == 0 {
+		return transactions, nil
+	}
+
+	rows, err := executorFrom(ctx, f.db).QueryContext(
+		ctx,
+		`SELECT transaction_id, number, amount, due_date FROM installments WHERE transaction_id IN (`+
+			strings.Join(placeholders, ", ")+
+			`) ORDER BY transaction_id, number`,
+		args...,
+	)
+	if err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var installments = make(map[string][]domain.Installment)
+	for rows.Next() {
+		var (
+			transactionID string
+			number        int
+			amount        int64
+			dueDate       time.Time
+		)
+
+		if err = rows.Scan(&transactionID, &number, &amount, &dueDate); err != nil {
+			return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		installments[transactionID] = append(installments[transactionID], domain.NewInstallment(number, amount, dueDate))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	for i, transaction := range transactions {
+		if plan, ok := installments[transaction.ID()]; ok {
+			transactions[i] = transaction.WithInstallmentPlan(domain.NewInstallmentPlan(plan))
+		}
+	}
+
+	return transactions, nil
+}
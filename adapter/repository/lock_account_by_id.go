@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type lockAccountByIDRepository struct {
+	db *sql.DB
+}
+
+// NewLockAccountByIDRepository creates new lockAccountByIDRepository with its dependencies
+func NewLockAccountByIDRepository(db *sql.DB) domain.AccountLocker {
+	return lockAccountByIDRepository{
+		db: db,
+	}
+}
+
+// LockByID performs select for update into the database, holding the row lock until the transaction ends
+func (l lockAccountByIDRepository) LockByID(ctx context.Context, ID string) (domain.Account, error) {
+	ctx, span := startSpan(ctx, "LockAccountByID", ID)
+	defer span.End()
+
+	db := executorFrom(ctx, l.db)
+
+	var (
+		id            string
+		tenantID      string
+		docNumber     string
+		avCreditLimit int64
+		createdAt     time.Time
+	)
+
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT id, tenant_id, document_number, available_credit_limit, created_at FROM accounts WHERE id = ? AND (? OR tenant_id = ?) FOR UPDATE",
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	).Scan(&id, &tenantID, &docNumber, &avCreditLimit, &createdAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.Account{}, domain.ErrAccountNotFound
+	default:
+		return domain.NewStoredAccount(id, docNumber, avCreditLimit, createdAt).WithTenant(tenantID), errors.Wrap(err, errUnknown.Error())
+	}
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type markOutboxEventPublishedRepository struct {
+	db *sql.DB
+}
+
+// NewMarkOutboxEventPublishedRepository creates new markOutboxEventPublishedRepository with its dependencies
+func NewMarkOutboxEventPublishedRepository(db *sql.DB) domain.OutboxUpdater {
+	return markOutboxEventPublishedRepository{
+		db: db,
+	}
+}
+
+// MarkPublished performs update into the database
+func (m markOutboxEventPublishedRepository) MarkPublished(ctx context.Context, ID string, publishedAt time.Time) error {
+	ctx, span := startSpan(ctx, "MarkOutboxEventPublished", "")
+	defer span.End()
+
+	if _, err := executorFrom(ctx, m.db).ExecContext(
+		ctx,
+		`UPDATE outbox_events SET published_at = ? WHERE id = ?`,
+		publishedAt,
+		ID,
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
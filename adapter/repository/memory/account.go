@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+// AccountRepository stores accounts in memory
+type AccountRepository struct {
+	store *Store
+}
+
+// NewAccountRepository creates new AccountRepository backed by the store
+func NewAccountRepository(store *Store) *AccountRepository {
+	return &AccountRepository{
+		store: store,
+	}
+}
+
+// Create stores the account, the journal entry of its initial credit limit and the AccountCreated event,
+// rejecting a repeated id or a document number repeated in the tenant
+func (r *AccountRepository) Create(ctx context.Context, account domain.Account) (domain.Account, error) {
+	err := r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.accounts[account.ID()]; ok {
+			return domain.ErrAccountAlreadyExists
+		}
+
+		for _, a := range t.accounts {
+			if a.TenantID() == account.TenantID() && a.Document().Number() == account.Document().Number() {
+				return domain.ErrAccountAlreadyExists
+			}
+		}
+
+		t.accounts[account.ID()] = account
+		t.enqueue(domain.NewAccountCreatedEvent(uuid.New().String(), account))
+		return t.record(domain.NewOpeningJournalEntry(uuid.New().String(), account))
+	})
+	if err != nil {
+		return domain.Account{}, err
+	}
+
+	return account, nil
+}
+
+// FindByID returns the account of the tenant of the request
+func (r *AccountRepository) FindByID(ctx context.Context, ID string) (domain.Account, error) {
+	var (
+		account domain.Account
+		ok      bool
+	)
+
+	r.store.read(ctx, func(t *tables) {
+		account, ok = t.accounts[ID]
+	})
+	if !ok || !domain.TenantAllowed(ctx, account.TenantID()) {
+		return domain.Account{}, domain.ErrAccountNotFound
+	}
+
+	return account, nil
+}
+
+// LockByID returns the account. Inside a unit of work the whole store is already held until it ends
+func (r *AccountRepository) LockByID(ctx context.Context, ID string) (domain.Account, error) {
+	return r.FindByID(ctx, ID)
+}
+
+// UpdateCreditLimit stores the available credit limit of the account of the tenant of the request
+func (r *AccountRepository) UpdateCreditLimit(ctx context.Context, ID string, limit int64) error {
+	return r.store.write(ctx, func(t *tables) error {
+		account, ok := t.accounts[ID]
+		if !ok || !domain.TenantAllowed(ctx, account.TenantID()) {
+			return domain.ErrAccountNotFound
+		}
+
+		t.accounts[ID] = domain.NewStoredAccount(
+			account.ID(),
+			account.Document().Number(),
+			limit,
+			account.CreatedAt(),
+		).WithTenant(account.TenantID())
+		return nil
+	})
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestAccountRepository_Tenant(t *testing.T) {
+	var (
+		now     = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		tenantA = domain.WithTenant(context.Background(), "tenant-a")
+		tenantB = domain.WithTenant(context.Background(), "tenant-b")
+		repo    = NewAccountRepository(NewStore())
+	)
+
+	if _, err := repo.Create(tenantA, domain.NewStoredAccount("1", "12345678900", 1000, now).WithTenant("tenant-a")); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create", err)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		execute func(context.Context) error
+		wantErr error
+	}{
+		{
+			name: "Find account of the tenant",
+			ctx:  tenantA,
+			execute: func(ctx context.Context) error {
+				_, err := repo.FindByID(ctx, "1")
+				return err
+			},
+		},
+		{
+			name: "Find account of every tenant",
+			ctx:  domain.WithAllTenants(context.Background()),
+			execute: func(ctx context.Context) error {
+				_, err := repo.FindByID(ctx, "1")
+				return err
+			},
+		},
+		{
+			name: "Error find account without tenant",
+			ctx:  context.Background(),
+			execute: func(ctx context.Context) error {
+				_, err := repo.FindByID(ctx, "1")
+				return err
+			},
+			wantErr: domain.ErrAccountNotFound,
+		},
+		{
+			name: "Error find account of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) error {
+				_, err := repo.FindByID(ctx, "1")
+				return err
+			},
+			wantErr: domain.ErrAccountNotFound,
+		},
+		{
+			name: "Error lock account of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) error {
+				_, err := repo.LockByID(ctx, "1")
+				return err
+			},
+			wantErr: domain.ErrAccountNotFound,
+		},
+		{
+			name: "Error update credit limit of account of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) error {
+				return repo.UpdateCreditLimit(ctx, "1", 0)
+			},
+			wantErr: domain.ErrAccountNotFound,
+		},
+		{
+			name: "Create account with document of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) error {
+				_, err := repo.Create(ctx, domain.NewStoredAccount("2", "12345678900", 1000, now).WithTenant("tenant-b"))
+				return err
+			},
+		},
+		{
+			name: "Error create account with document repeated in the tenant",
+			ctx:  tenantA,
+			execute: func(ctx context.Context) error {
+				_, err := repo.Create(ctx, domain.NewStoredAccount("3", "12345678900", 1000, now).WithTenant("tenant-a"))
+				return err
+			},
+			wantErr: domain.ErrAccountAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.execute(tt.ctx); err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+
+	account, err := repo.FindByID(tenantA, "1")
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "FindByID", err)
+	}
+
+	if account.AvailableCreditLimit() != 1000 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Credit limit kept", account.AvailableCreditLimit(), 1000)
+	}
+}
@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// APIKeyRepository stores API keys in memory
+type APIKeyRepository struct {
+	store *Store
+}
+
+// NewAPIKeyRepository creates new APIKeyRepository backed by the store
+func NewAPIKeyRepository(store *Store) *APIKeyRepository {
+	return &APIKeyRepository{
+		store: store,
+	}
+}
+
+// Create stores the API key
+func (r *APIKeyRepository) Create(ctx context.Context, apiKey domain.APIKey) (domain.APIKey, error) {
+	err := r.store.write(ctx, func(t *tables) error {
+		t.apiKeys[apiKey.ID()] = apiKey
+		return nil
+	})
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+
+	return apiKey, nil
+}
+
+// FindByID returns the API key of the tenant of the request
+func (r *APIKeyRepository) FindByID(ctx context.Context, ID string) (domain.APIKey, error) {
+	var (
+		apiKey domain.APIKey
+		ok     bool
+	)
+
+	r.store.read(ctx, func(t *tables) {
+		apiKey, ok = t.apiKeys[ID]
+	})
+	if !ok || !domain.TenantAllowed(ctx, apiKey.TenantID()) {
+		return domain.APIKey{}, domain.ErrAPIKeyNotFound
+	}
+
+	return stored(apiKey), nil
+}
+
+// FindByHash returns the API key with the hash, of any tenant, as it is searched to authenticate the request
+func (r *APIKeyRepository) FindByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	var (
+		apiKey domain.APIKey
+		ok     bool
+	)
+
+	r.store.read(ctx, func(t *tables) {
+		for _, a := range t.apiKeys {
+			if a.Hash() == hash {
+				apiKey, ok = a, true
+				return
+			}
+		}
+	})
+	if !ok {
+		return domain.APIKey{}, domain.ErrAPIKeyNotFound
+	}
+
+	return stored(apiKey), nil
+}
+
+// Update stores the API key of the tenant of the request
+func (r *APIKeyRepository) Update(ctx context.Context, apiKey domain.APIKey) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if current, ok := t.apiKeys[apiKey.ID()]; !ok || !domain.TenantAllowed(ctx, current.TenantID()) {
+			return domain.ErrAPIKeyNotFound
+		}
+
+		t.apiKeys[apiKey.ID()] = apiKey
+		return nil
+	})
+}
+
+// stored returns the API key as the database repositories read it, without the key itself
+func stored(apiKey domain.APIKey) domain.APIKey {
+	return domain.NewAPIKey(
+		apiKey.ID(),
+		apiKey.TenantID(),
+		apiKey.Name(),
+		apiKey.Hash(),
+		apiKey.Scopes(),
+		apiKey.CreatedAt(),
+		apiKey.RevokedAt(),
+	)
+}
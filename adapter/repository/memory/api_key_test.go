@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestAPIKeyRepository_FindByHash(t *testing.T) {
+	var (
+		now    = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		scopes = []string{domain.ScopeAccountsRead}
+	)
+
+	tests := []struct {
+		name    string
+		key     string
+		revoke  bool
+		wantErr error
+	}{
+		{
+			name: "Find API key by the hash of the key",
+			key:  "gtk_key",
+		},
+		{
+			name:   "Find revoked API key",
+			key:    "gtk_key",
+			revoke: true,
+		},
+		{
+			name:    "API key not found",
+			key:     "gtk_unknown",
+			wantErr: domain.ErrAPIKeyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = domain.WithTenant(context.Background(), "tenant-a")
+				repo = NewAPIKeyRepository(NewStore())
+			)
+
+			apiKey, _ := domain.IssueAPIKey("k", "tenant-a", "ci", "gtk_key", scopes, now)
+			_, _ = repo.Create(ctx, apiKey)
+			if tt.revoke {
+				apiKey.Revoke(now)
+				_ = repo.Update(ctx, apiKey)
+			}
+
+			got, err := repo.FindByHash(ctx, domain.HashAPIKey(tt.key))
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.ID() != "k" || got.Key() != "" {
+				t.Errorf("[TestCase '%s'] Got: '%s' '%s' | Want: 'k' without the key", tt.name, got.ID(), got.Key())
+			}
+
+			if got.Revoked() != tt.revoke {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Revoked(), tt.revoke)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// AuthorizationRepository stores authorizations in memory
+type AuthorizationRepository struct {
+	store *Store
+}
+
+// NewAuthorizationRepository creates new AuthorizationRepository backed by the store
+func NewAuthorizationRepository(store *Store) *AuthorizationRepository {
+	return &AuthorizationRepository{
+		store: store,
+	}
+}
+
+// Create stores the authorization
+func (r *AuthorizationRepository) Create(ctx context.Context, authorization domain.Authorization) (domain.Authorization, error) {
+	err := r.store.write(ctx, func(t *tables) error {
+		t.authorizations[authorization.ID()] = authorization
+		return nil
+	})
+	if err != nil {
+		return domain.Authorization{}, err
+	}
+
+	return authorization, nil
+}
+
+// FindByID returns the authorization of an account of the tenant
+func (r *AuthorizationRepository) FindByID(ctx context.Context, ID string) (domain.Authorization, error) {
+	var (
+		authorization domain.Authorization
+		ok            bool
+	)
+
+	r.store.read(ctx, func(t *tables) {
+		if authorization, ok = t.authorizations[ID]; ok {
+			account, found := t.accounts[authorization.AccountID()]
+			ok = found && domain.TenantAllowed(ctx, account.TenantID())
+		}
+	})
+	if !ok {
+		return domain.Authorization{}, domain.ErrAuthorizationNotFound
+	}
+
+	return authorization, nil
+}
+
+// FindExpired returns up to limit pending authorizations past their expiration, the oldest expiration first
+func (r *AuthorizationRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]domain.Authorization, error) {
+	var authorizations = make([]domain.Authorization, 0)
+	r.store.read(ctx, func(t *tables) {
+		for _, authorization := range t.authorizations {
+			if authorization.Status() == domain.AuthorizationPending && !authorization.ExpiresAt().After(now) {
+				authorizations = append(authorizations, authorization)
+			}
+		}
+	})
+
+	sort.Slice(authorizations, func(i, j int) bool {
+		return authorizations[i].ExpiresAt().Before(authorizations[j].ExpiresAt())
+	})
+
+	if len(authorizations) > limit {
+		authorizations = authorizations[:limit]
+	}
+
+	return authorizations, nil
+}
+
+// Update stores the authorization
+func (r *AuthorizationRepository) Update(ctx context.Context, authorization domain.Authorization) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.authorizations[authorization.ID()]; !ok {
+			return domain.ErrAuthorizationNotFound
+		}
+
+		t.authorizations[authorization.ID()] = authorization
+		return nil
+	})
+}
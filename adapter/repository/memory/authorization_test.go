@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestAuthorizationRepository_FindByID_Tenant(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		tenantA         = domain.WithTenant(context.Background(), "tenant-a")
+		store           = NewStore()
+		repo            = NewAuthorizationRepository(store)
+	)
+
+	_, _ = NewAccountRepository(store).Create(tenantA, domain.NewStoredAccount("1", "12345678909", 1000, now).WithTenant("tenant-a"))
+	_, _ = repo.Create(tenantA, domain.NewAuthorization(
+		"a",
+		"1",
+		compraAVista,
+		100,
+		0,
+		domain.AuthorizationPending,
+		"",
+		now.Add(time.Hour),
+		now,
+	))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{
+			name: "Authorization of the tenant",
+			ctx:  tenantA,
+		},
+		{
+			name: "Authorization of every tenant",
+			ctx:  domain.WithAllTenants(context.Background()),
+		},
+		{
+			name:    "Authorization of another tenant",
+			ctx:     domain.WithTenant(context.Background(), "tenant-b"),
+			wantErr: domain.ErrAuthorizationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := repo.FindByID(tt.ctx, "a"); err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
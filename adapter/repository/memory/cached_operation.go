@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// CachedOperationRepository serves the operation catalog from memory. The catalog is reloaded
+// from the source once the ttl expires and after every write. An operation not cached reloads it
+// at most once every missTTL, so unknown ids are answered from memory in between
+type CachedOperationRepository struct {
+	source   domain.OperationRepository
+	ttl      time.Duration
+	missTTL  time.Duration
+	loading  sync.Mutex
+	mu       sync.RWMutex
+	cache    map[string]domain.Operation
+	loadedAt time.Time
+}
+
+// NewCachedOperationRepository creates new CachedOperationRepository
+func NewCachedOperationRepository(source domain.OperationRepository, ttl time.Duration, missTTL time.Duration) *CachedOperationRepository {
+	return &CachedOperationRepository{
+		source:  source,
+		ttl:     ttl,
+		missTTL: missTTL,
+	}
+}
+
+// Create creates the operation on the source and invalidates the cache
+func (r *CachedOperationRepository) Create(ctx context.Context, operation domain.Operation) (domain.Operation, error) {
+	operation, err := r.source.Create(ctx, operation)
+	if err != nil {
+		return domain.Operation{}, err
+	}
+
+	r.invalidate()
+	return operation, nil
+}
+
+// FindByID returns the cached operation, disabled operations included
+func (r *CachedOperationRepository) FindByID(ctx context.Context, ID string) (domain.Operation, error) {
+	if operation, ok := r.cached(ID); ok {
+		return operation, nil
+	}
+
+	if r.loadedWithin(r.missTTL) {
+		return domain.Operation{}, domain.ErrOperationNotFound
+	}
+
+	if err := r.reload(ctx, r.missTTL); err != nil {
+		return domain.Operation{}, err
+	}
+
+	if operation, ok := r.cached(ID); ok {
+		return operation, nil
+	}
+
+	return domain.Operation{}, domain.ErrOperationNotFound
+}
+
+// FindAll returns all the cached operations ordered by id
+func (r *CachedOperationRepository) FindAll(ctx context.Context) ([]domain.Operation, error) {
+	if !r.loadedWithin(r.ttl) {
+		if err := r.reload(ctx, r.ttl); err != nil {
+			return []domain.Operation{}, err
+		}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var operations = make([]domain.Operation, 0, len(r.cache))
+	for _, operation := range r.cache {
+		operations = append(operations, operation)
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].ID() < operations[j].ID()
+	})
+
+	return operations, nil
+}
+
+// Update updates the operation on the source and invalidates the cache
+func (r *CachedOperationRepository) Update(ctx context.Context, operation domain.Operation) error {
+	if err := r.source.Update(ctx, operation); err != nil {
+		return err
+	}
+
+	r.invalidate()
+	return nil
+}
+
+func (r *CachedOperationRepository) cached(ID string) (domain.Operation, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.cache == nil || time.Since(r.loadedAt) >= r.ttl {
+		return domain.Operation{}, false
+	}
+
+	operation, ok := r.cache[ID]
+	return operation, ok
+}
+
+// reload loads the catalog from the source unless it was loaded within maxAge, which happens when
+// concurrent callers wait for the same reload
+func (r *CachedOperationRepository) reload(ctx context.Context, maxAge time.Duration) error {
+	r.loading.Lock()
+	defer r.loading.Unlock()
+
+	if r.loadedWithin(maxAge) {
+		return nil
+	}
+
+	operations, err := r.source.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	var cache = make(map[string]domain.Operation, len(operations))
+	for _, operation := range operations {
+		cache[operation.ID()] = operation
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache = cache
+	r.loadedAt = time.Now()
+
+	return nil
+}
+
+func (r *CachedOperationRepository) invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache = nil
+}
+
+func (r *CachedOperationRepository) loadedWithin(maxAge time.Duration) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.cache != nil && time.Since(r.loadedAt) < maxAge
+}
@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type spyOperationSource struct {
+	operations map[string]domain.Operation
+	loads      int
+}
+
+func (s *spyOperationSource) Create(_ context.Context, operation domain.Operation) (domain.Operation, error) {
+	if _, ok := s.operations[operation.ID()]; ok {
+		return domain.Operation{}, domain.ErrOperationAlreadyExists
+	}
+
+	s.operations[operation.ID()] = operation
+	return operation, nil
+}
+
+func (s *spyOperationSource) FindByID(_ context.Context, ID string) (domain.Operation, error) {
+	operation, ok := s.operations[ID]
+	if !ok {
+		return domain.Operation{}, domain.ErrOperationNotFound
+	}
+
+	return operation, nil
+}
+
+func (s *spyOperationSource) FindAll(_ context.Context) ([]domain.Operation, error) {
+	s.loads++
+
+	var operations []domain.Operation
+	for _, operation := range s.operations {
+		operations = append(operations, operation)
+	}
+
+	return operations, nil
+}
+
+func (s *spyOperationSource) Update(_ context.Context, operation domain.Operation) error {
+	s.operations[operation.ID()] = operation
+	return nil
+}
+
+func TestCachedOperationRepository(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		pagamento, _    = domain.NewOperation(domain.Pagamento)
+		anuidade, _     = domain.NewCatalogOperation("9", "ANUIDADE", domain.Debit, true)
+		ctx             = context.Background()
+	)
+
+	source := &spyOperationSource{
+		operations: map[string]domain.Operation{
+			compraAVista.ID(): compraAVista,
+			pagamento.ID():    pagamento,
+		},
+	}
+	repo := NewCachedOperationRepository(source, time.Hour, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if _, err := repo.FindByID(ctx, domain.CompraAVista); err != nil {
+			t.Fatalf("[TestCase '%s'] Err: '%v'", "Find cached operation", err)
+		}
+	}
+
+	if source.loads != 1 {
+		t.Errorf("[TestCase '%s'] Got loads: '%v' | Want: '%v'", "Load the catalog once", source.loads, 1)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := repo.FindByID(ctx, "123"); err != domain.ErrOperationNotFound {
+			t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Unknown operation", err, domain.ErrOperationNotFound)
+		}
+	}
+
+	if source.loads != 1 {
+		t.Errorf("[TestCase '%s'] Got loads: '%v' | Want: '%v'", "Cache unknown operations", source.loads, 1)
+	}
+
+	if _, err := repo.Create(ctx, anuidade); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create operation", err)
+	}
+
+	got, err := repo.FindByID(ctx, "9")
+	if err != nil || !reflect.DeepEqual(got, anuidade) {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", "Find created operation", got, anuidade)
+	}
+
+	compraAVista.Disable()
+	if err := repo.Update(ctx, compraAVista); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Disable operation", err)
+	}
+
+	got, err = repo.FindByID(ctx, domain.CompraAVista)
+	if err != nil || got.Enabled() {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want disabled", "Disabled operation keeps resolving", got)
+	}
+
+	all, err := repo.FindAll(ctx)
+	if err != nil || len(all) != 3 || all[0].ID() != domain.CompraAVista || all[2].ID() != "9" {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%v' operations ordered by id", "Find all operations", all, 3)
+	}
+}
+
+func TestCachedOperationRepository_MissTTL(t *testing.T) {
+	var (
+		anuidade, _ = domain.NewCatalogOperation("9", "ANUIDADE", domain.Debit, true)
+		ctx         = context.Background()
+		source      = &spyOperationSource{operations: map[string]domain.Operation{}}
+		repo        = NewCachedOperationRepository(source, time.Hour, 10*time.Millisecond)
+	)
+
+	if _, err := repo.FindByID(ctx, "9"); err != domain.ErrOperationNotFound {
+		t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Unknown operation", err, domain.ErrOperationNotFound)
+	}
+
+	// Created by another instance, so the cache isn't invalidated
+	source.operations[anuidade.ID()] = anuidade
+
+	if _, err := repo.FindByID(ctx, "9"); err != domain.ErrOperationNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Miss answered from memory", err, domain.ErrOperationNotFound)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	got, err := repo.FindByID(ctx, "9")
+	if err != nil || !reflect.DeepEqual(got, anuidade) {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", "Reload after the miss ttl", got, anuidade)
+	}
+
+	if source.loads != 2 {
+		t.Errorf("[TestCase '%s'] Got loads: '%v' | Want: '%v'", "Reload once per miss ttl", source.loads, 2)
+	}
+}
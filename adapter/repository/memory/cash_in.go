@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// CashInRepository stores cash-ins in memory
+type CashInRepository struct {
+	store *Store
+}
+
+// NewCashInRepository creates new CashInRepository backed by the store
+func NewCashInRepository(store *Store) *CashInRepository {
+	return &CashInRepository{
+		store: store,
+	}
+}
+
+// Create stores the cash-in, rejecting a source already deposited in the tenant
+func (r *CashInRepository) Create(ctx context.Context, cashIn domain.CashIn) (domain.CashIn, error) {
+	err := r.store.write(ctx, func(t *tables) error {
+		for _, c := range t.cashIns {
+			if c.TenantID() == cashIn.TenantID() && c.Source() == cashIn.Source() {
+				return domain.ErrCashInAlreadyProcessed
+			}
+		}
+
+		t.cashIns[cashIn.ID()] = cashIn
+		return nil
+	})
+	if err != nil {
+		return domain.CashIn{}, err
+	}
+
+	return cashIn, nil
+}
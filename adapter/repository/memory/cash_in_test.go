@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestCashInRepository_Create_Tenant(t *testing.T) {
+	var (
+		now       = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		source, _ = domain.NewCashInSource(domain.CashInSourcePix, "E1234567820261018120012345678901")
+		ctx       = domain.WithAllTenants(context.Background())
+		repo      = NewCashInRepository(NewStore())
+	)
+
+	tests := []struct {
+		name    string
+		id      string
+		account domain.Account
+		wantErr error
+	}{
+		{
+			name:    "Source of the tenant",
+			id:      "a",
+			account: domain.NewStoredAccount("1", "12345678909", 1000, now).WithTenant("tenant-a"),
+		},
+		{
+			name:    "Source already processed in the tenant",
+			id:      "b",
+			account: domain.NewStoredAccount("2", "52998224725", 1000, now).WithTenant("tenant-a"),
+			wantErr: domain.ErrCashInAlreadyProcessed,
+		},
+		{
+			name:    "Source of another tenant",
+			id:      "c",
+			account: domain.NewStoredAccount("3", "12345678909", 1000, now).WithTenant("tenant-b"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cashIn := domain.NewCashIn(tt.id, &tt.account, tt.id, source, 100, now)
+			if _, err := repo.Create(ctx, cashIn); err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
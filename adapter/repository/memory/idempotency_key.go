@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// IdempotencyKeyRepository stores idempotency keys in memory
+type IdempotencyKeyRepository struct {
+	mu   sync.RWMutex
+	keys map[idempotencyKeyID]domain.IdempotencyKey
+}
+
+// idempotencyKeyID identifies a key within its tenant
+type idempotencyKeyID struct {
+	tenantID string
+	key      string
+}
+
+// NewIdempotencyKeyRepository creates new IdempotencyKeyRepository
+func NewIdempotencyKeyRepository() *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{
+		keys: make(map[idempotencyKeyID]domain.IdempotencyKey),
+	}
+}
+
+// Create reserves the idempotency key
+func (r *IdempotencyKeyRepository) Create(_ context.Context, key domain.IdempotencyKey) (domain.IdempotencyKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := idempotencyKeyID{tenantID: key.TenantID(), key: key.Key()}
+	if _, ok := r.keys[id]; ok {
+		return domain.IdempotencyKey{}, domain.ErrIdempotencyKeyAlreadyExists
+	}
+
+	r.keys[id] = key
+	return key, nil
+}
+
+// FindByKey returns the idempotency key of the tenant
+func (r *IdempotencyKeyRepository) FindByKey(ctx context.Context, key string) (domain.IdempotencyKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	k, ok := r.keys[idempotencyKeyID{tenantID: domain.TenantFrom(ctx), key: key}]
+	if !ok {
+		return domain.IdempotencyKey{}, domain.ErrIdempotencyKeyNotFound
+	}
+
+	return k, nil
+}
+
+// Complete stores the response of the idempotency key
+func (r *IdempotencyKeyRepository) Complete(_ context.Context, key domain.IdempotencyKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := idempotencyKeyID{tenantID: key.TenantID(), key: key.Key()}
+	if _, ok := r.keys[id]; !ok {
+		return domain.ErrIdempotencyKeyNotFound
+	}
+
+	r.keys[id] = key
+	return nil
+}
+
+// Reclaim renews the idempotency key of the same request in progress since before staleBefore
+func (r *IdempotencyKeyRepository) Reclaim(_ context.Context, key domain.IdempotencyKey, staleBefore time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := idempotencyKeyID{tenantID: key.TenantID(), key: key.Key()}
+	stored, ok := r.keys[id]
+	if !ok || stored.Completed() || stored.Fingerprint() != key.Fingerprint() || !stored.CreatedAt().Before(staleBefore) {
+		return domain.ErrIdempotencyKeyInProgress
+	}
+
+	r.keys[id] = key
+	return nil
+}
+
+// Delete releases the idempotency key of the tenant
+func (r *IdempotencyKeyRepository) Delete(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.keys, idempotencyKeyID{tenantID: domain.TenantFrom(ctx), key: key})
+	return nil
+}
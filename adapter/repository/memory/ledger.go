@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// LedgerRepository reads the journal of the store
+type LedgerRepository struct {
+	store *Store
+}
+
+// NewLedgerRepository creates new LedgerRepository backed by the store
+func NewLedgerRepository(store *Store) *LedgerRepository {
+	return &LedgerRepository{
+		store: store,
+	}
+}
+
+// FindAccountBalances sums the postings and the pending holds of every account of the tenant, ordered by account id
+func (r *LedgerRepository) FindAccountBalances(ctx context.Context) ([]domain.AccountLedgerBalance, error) {
+	var balances = make([]domain.AccountLedgerBalance, 0)
+
+	r.store.read(ctx, func(t *tables) {
+		var postings = make(map[string]int64)
+		for _, entry := range t.journal {
+			for _, posting := range entry.Postings() {
+				postings[posting.LedgerAccount()] += posting.Amount()
+			}
+		}
+
+		var held = make(map[string]int64)
+		for _, authorization := range t.authorizations {
+			if authorization.Status() == domain.AuthorizationPending {
+				held[authorization.AccountID()] += authorization.Amount()
+			}
+		}
+
+		for _, account := range t.accounts {
+			if !domain.TenantAllowed(ctx, account.TenantID()) {
+				continue
+			}
+
+			balances = append(balances, domain.NewAccountLedgerBalance(
+				account.ID(),
+				account.AvailableCreditLimit(),
+				postings[domain.CustomerLedgerAccount(account.ID())],
+				held[account.ID()],
+			))
+		}
+	})
+
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].AccountID() < balances[j].AccountID()
+	})
+
+	return balances, nil
+}
+
+// FindLimitBefore sums the postings of the customer ledger account of the account of the tenant recorded
+// in journal entries created before the moment
+func (r *LedgerRepository) FindLimitBefore(ctx context.Context, accountID string, before time.Time) (int64, error) {
+	var limit int64
+
+	r.store.read(ctx, func(t *tables) {
+		if account, ok := t.accounts[accountID]; !ok || !domain.TenantAllowed(ctx, account.TenantID()) {
+			return
+		}
+
+		for _, entry := range t.journal {
+			if entry.AccountID() != accountID || !entry.CreatedAt().Before(before) {
+				continue
+			}
+
+			for _, posting := range entry.Postings() {
+				if posting.LedgerAccount() == domain.CustomerLedgerAccount(accountID) {
+					limit += posting.Amount()
+				}
+			}
+		}
+	})
+
+	return limit, nil
+}
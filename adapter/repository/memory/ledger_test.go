@@ -0,0 +1,220 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestLedgerRepository_FindAccountBalances(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, store *Store, account *domain.Account) error
+		wantDrift int64
+	}{
+		{
+			name: "Debit recorded with its journal entry",
+			setup: func(ctx context.Context, store *Store, account *domain.Account) error {
+				if err := account.Withdraw(100); err != nil {
+					return err
+				}
+
+				if err := NewAccountRepository(store).UpdateCreditLimit(ctx, account.ID(), account.AvailableCreditLimit()); err != nil {
+					return err
+				}
+
+				_, err := NewTransactionRepository(store).Create(
+					ctx,
+					domain.NewTransaction("t", account.ID(), compraAVista, 100, -100, now),
+				)
+				return err
+			},
+		},
+		{
+			name: "Pending authorization holding the limit",
+			setup: func(ctx context.Context, store *Store, account *domain.Account) error {
+				authorization, err := domain.PlaceAuthorization("a", account, compraAVista, 300, now.Add(time.Hour), now)
+				if err != nil {
+					return err
+				}
+
+				if err = NewAccountRepository(store).UpdateCreditLimit(ctx, account.ID(), account.AvailableCreditLimit()); err != nil {
+					return err
+				}
+
+				_, err = NewAuthorizationRepository(store).Create(ctx, authorization)
+				return err
+			},
+		},
+		{
+			name: "Limit changed without a transaction",
+			setup: func(ctx context.Context, store *Store, account *domain.Account) error {
+				return NewAccountRepository(store).UpdateCreditLimit(ctx, account.ID(), 1500)
+			},
+			wantDrift: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx     = domain.WithAllTenants(context.Background())
+				store   = NewStore()
+				account = domain.NewStoredAccount("1", "12345678900", 1000, now)
+			)
+
+			if _, err := NewAccountRepository(store).Create(ctx, account); err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			if err := store.WithTransaction(ctx, func(ctx context.Context) error {
+				return tt.setup(ctx, store, &account)
+			}); err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			balances, err := NewLedgerRepository(store).FindAccountBalances(ctx)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			if len(balances) != 1 || balances[0].Drift() != tt.wantDrift {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want drift: '%v'", tt.name, balances, tt.wantDrift)
+			}
+		})
+	}
+}
+
+func TestLedgerRepository_FindAccountBalances_Tenant(t *testing.T) {
+	var (
+		now     = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		tenantA = domain.WithTenant(context.Background(), "tenant-a")
+		tenantB = domain.WithTenant(context.Background(), "tenant-b")
+		store   = NewStore()
+	)
+
+	_, _ = NewAccountRepository(store).Create(tenantA, domain.NewStoredAccount("1", "12345678909", 1000, now).WithTenant("tenant-a"))
+	_, _ = NewAccountRepository(store).Create(tenantB, domain.NewStoredAccount("2", "12345678909", 1000, now).WithTenant("tenant-b"))
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{
+			name: "Balances of the tenant",
+			ctx:  tenantA,
+			want: []string{"1"},
+		},
+		{
+			name: "Balances of every tenant",
+			ctx:  domain.WithAllTenants(context.Background()),
+			want: []string{"1", "2"},
+		},
+		{
+			name: "Balances without tenant",
+			ctx:  context.Background(),
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balances, err := NewLedgerRepository(store).FindAccountBalances(tt.ctx)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			var got = make([]string, 0)
+			for _, balance := range balances {
+				got = append(got, balance.AccountID())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStore_WithTransaction_DiscardJournal(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		ctx             = domain.WithAllTenants(context.Background())
+		store           = NewStore()
+	)
+
+	_ = store.WithTransaction(ctx, func(ctx context.Context) error {
+		_, _ = NewAccountRepository(store).Create(ctx, domain.NewStoredAccount("1", "12345678900", 1000, time.Time{}))
+		_, _ = NewTransactionRepository(store).Create(ctx, domain.NewTransaction("t", "1", compraAVista, 100, -100, time.Time{}))
+		return domain.ErrAccountNotFound
+	})
+
+	if len(store.tables.journal) != 0 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Discard journal", len(store.tables.journal), 0)
+	}
+}
+
+func TestLedgerRepository_FindLimitBefore(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		tenantA         = domain.WithTenant(context.Background(), "tenant-a")
+		store           = NewStore()
+	)
+
+	_, _ = NewAccountRepository(store).Create(tenantA, domain.NewStoredAccount("1", "12345678909", 1000, now).WithTenant("tenant-a"))
+	_, _ = NewTransactionRepository(store).Create(
+		tenantA,
+		domain.NewTransaction("t", "1", compraAVista, 100, -100, now.Add(time.Hour)).WithTenant("tenant-a"),
+	)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		before time.Time
+		want   int64
+	}{
+		{
+			name:   "Limit before the account was opened",
+			ctx:    tenantA,
+			before: now,
+			want:   0,
+		},
+		{
+			name:   "Limit before the purchase",
+			ctx:    tenantA,
+			before: now.Add(time.Hour),
+			want:   1000,
+		},
+		{
+			name:   "Limit after the purchase",
+			ctx:    tenantA,
+			before: now.Add(2 * time.Hour),
+			want:   900,
+		},
+		{
+			name:   "Limit of an account of another tenant",
+			ctx:    domain.WithTenant(context.Background(), "tenant-b"),
+			before: now.Add(2 * time.Hour),
+			want:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewLedgerRepository(store).FindLimitBefore(tt.ctx, "1", tt.before)
+			if err != nil || got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v' | Err: '%v'", tt.name, got, tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// builtInOperations are the ids of the operations seeded on the catalog, as in the operations table
+var builtInOperations = []string{
+	domain.CompraAVista,
+	domain.CompraParcelada,
+	domain.Saque,
+	domain.Pagamento,
+	domain.Estorno,
+	domain.TransferenciaEnviada,
+	domain.TransferenciaRecebida,
+	domain.Deposito,
+}
+
+// OperationRepository stores the operation catalog in memory, seeded with the built-in operations
+type OperationRepository struct {
+	mu         sync.RWMutex
+	operations map[string]domain.Operation
+}
+
+// NewOperationRepository creates new OperationRepository
+func NewOperationRepository() *OperationRepository {
+	var operations = make(map[string]domain.Operation, len(builtInOperations))
+	for _, ID := range builtInOperations {
+		operation, _ := domain.NewOperation(ID)
+		operations[ID] = operation
+	}
+
+	return &OperationRepository{
+		operations: operations,
+	}
+}
+
+// Create stores the operation, rejecting a repeated id
+func (r *OperationRepository) Create(_ context.Context, operation domain.Operation) (domain.Operation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.operations[operation.ID()]; ok {
+		return domain.Operation{}, domain.ErrOperationAlreadyExists
+	}
+
+	r.operations[operation.ID()] = operation
+	return operation, nil
+}
+
+// FindByID returns the operation, disabled operations included
+func (r *OperationRepository) FindByID(_ context.Context, ID string) (domain.Operation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	operation, ok := r.operations[ID]
+	if !ok {
+		return domain.Operation{}, domain.ErrOperationNotFound
+	}
+
+	return operation, nil
+}
+
+// FindAll returns all the operations ordered by id
+func (r *OperationRepository) FindAll(_ context.Context) ([]domain.Operation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var operations = make([]domain.Operation, 0, len(r.operations))
+	for _, operation := range r.operations {
+		operations = append(operations, operation)
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].ID() < operations[j].ID()
+	})
+
+	return operations, nil
+}
+
+// Update stores the operation
+func (r *OperationRepository) Update(_ context.Context, operation domain.Operation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.operations[operation.ID()]; !ok {
+		return domain.ErrOperationNotFound
+	}
+
+	r.operations[operation.ID()] = operation
+	return nil
+}
@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// OutboxRepository records, reads and updates the outbox of the store
+type OutboxRepository struct {
+	store *Store
+}
+
+// NewOutboxRepository creates new OutboxRepository backed by the store
+func NewOutboxRepository(store *Store) *OutboxRepository {
+	return &OutboxRepository{
+		store: store,
+	}
+}
+
+// Create appends the event to the outbox
+func (r *OutboxRepository) Create(ctx context.Context, event domain.Event) error {
+	return r.store.write(ctx, func(t *tables) error {
+		t.enqueue(event)
+		return nil
+	})
+}
+
+// FindPending returns the oldest events not yet published, leaving out the accounts with an event
+// postponed past now
+func (r *OutboxRepository) FindPending(ctx context.Context, now time.Time, limit int) ([]domain.Event, error) {
+	var events = make([]domain.Event, 0)
+
+	r.store.read(ctx, func(t *tables) {
+		var postponed = make(map[string]bool)
+		for _, pending := range t.outbox {
+			if pending.publishedAt.IsZero() && pending.nextAttemptAt.After(now) {
+				postponed[pending.event.AccountID()] = true
+			}
+		}
+
+		for _, pending := range t.outbox {
+			if len(events) == limit {
+				return
+			}
+
+			if pending.publishedAt.IsZero() && !postponed[pending.event.AccountID()] {
+				events = append(events, pending.event)
+			}
+		}
+	})
+
+	return events, nil
+}
+
+// Postpone records until when the event is held back
+func (r *OutboxRepository) Postpone(ctx context.Context, ID string, until time.Time) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for i := range t.outbox {
+			if t.outbox[i].event.ID() == ID {
+				t.outbox[i].nextAttemptAt = until
+			}
+		}
+
+		return nil
+	})
+}
+
+// MarkPublished records when the event was published
+func (r *OutboxRepository) MarkPublished(ctx context.Context, ID string, publishedAt time.Time) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for i := range t.outbox {
+			if t.outbox[i].event.ID() == ID {
+				t.outbox[i].publishedAt = publishedAt
+			}
+		}
+
+		return nil
+	})
+}
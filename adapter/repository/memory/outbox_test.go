@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestOutboxRepository(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name      string
+		rollback  bool
+		published []string
+		limit     int
+		want      []string
+	}{
+		{
+			name:  "Pending events in the order they were recorded",
+			limit: 10,
+			want:  []string{domain.EventAccountCreated, domain.EventTransactionCreated},
+		},
+		{
+			name:  "Pending events up to the limit",
+			limit: 1,
+			want:  []string{domain.EventAccountCreated},
+		},
+		{
+			name:      "Published events are skipped",
+			published: []string{domain.EventAccountCreated},
+			limit:     10,
+			want:      []string{domain.EventTransactionCreated},
+		},
+		{
+			name:     "Events discarded with the transaction",
+			rollback: true,
+			limit:    10,
+			want:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx    = domain.WithAllTenants(context.Background())
+				store  = NewStore()
+				outbox = NewOutboxRepository(store)
+			)
+
+			_ = store.WithTransaction(ctx, func(ctx context.Context) error {
+				_, _ = NewAccountRepository(store).Create(ctx, domain.NewStoredAccount("1", "12345678900", 1000, now))
+				_, _ = NewTransactionRepository(store).Create(ctx, domain.NewTransaction("t", "1", compraAVista, 100, -100, now))
+				if tt.rollback {
+					return domain.ErrAccountNotFound
+				}
+
+				return nil
+			})
+
+			pending, _ := outbox.FindPending(ctx, now, 10)
+			for _, event := range pending {
+				for _, published := range tt.published {
+					if event.Type() == published {
+						_ = outbox.MarkPublished(ctx, event.ID(), now)
+					}
+				}
+			}
+
+			events, err := outbox.FindPending(ctx, now, tt.limit)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			var got = make([]string, 0)
+			for _, event := range events {
+				got = append(got, event.Type())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutboxRepository_Postpone(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		ctx             = domain.WithAllTenants(context.Background())
+		store           = NewStore()
+		outbox          = NewOutboxRepository(store)
+		accounts        = NewAccountRepository(store)
+		transactions    = NewTransactionRepository(store)
+	)
+
+	_, _ = accounts.Create(ctx, domain.NewStoredAccount("1", "12345678909", 1000, now))
+	_, _ = transactions.Create(ctx, domain.NewTransaction("t1", "1", compraAVista, 100, -100, now))
+	_, _ = transactions.Create(ctx, domain.NewTransaction("t2", "1", compraAVista, 100, -100, now))
+	_, _ = accounts.Create(ctx, domain.NewStoredAccount("2", "11222333000181", 1000, now))
+
+	pending, _ := outbox.FindPending(ctx, now, 1)
+	if err := outbox.Postpone(ctx, pending[0].ID(), now.Add(time.Minute)); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Postpone", err)
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want []string
+	}{
+		{
+			name: "Events of the postponed account are left out of the batch",
+			now:  now,
+			want: []string{"2"},
+		},
+		{
+			name: "Events of the account are found again once it is due",
+			now:  now.Add(time.Minute),
+			want: []string{"1", "1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := outbox.FindPending(ctx, tt.now, 2)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			var got = make([]string, 0)
+			for _, event := range events {
+				got = append(got, event.AccountID())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// txKey is the context key marking the store as held by the unit of work running in the context
+	txKey struct{}
+
+	// tables holds the entities of the store indexed by id
+	tables struct {
+		accounts       map[string]domain.Account
+		transactions   map[string]domain.Transaction
+		authorizations map[string]domain.Authorization
+		transfers      map[string]domain.Transfer
+		cashIns        map[string]domain.CashIn
+		webhooks       map[string]domain.Webhook
+		deliveries     map[string]domain.WebhookDelivery
+		apiKeys        map[string]domain.APIKey
+		journal        []domain.JournalEntry
+		outbox         []outboxEvent
+	}
+
+	// outboxEvent is an event of the outbox, when it was published, zero while pending, and until when
+	// it is postponed
+	outboxEvent struct {
+		event         domain.Event
+		publishedAt   time.Time
+		nextAttemptAt time.Time
+	}
+)
+
+// Store keeps the entities of the in-memory repositories. It implements domain.UnitOfWork: a unit of work
+// holds the store exclusively until it ends, serializing the writers as the row locks of the database do,
+// and restores the snapshot taken when it began if it fails
+type Store struct {
+	mu     sync.RWMutex
+	tables tables
+}
+
+// NewStore creates new empty Store
+func NewStore() *Store {
+	return &Store{
+		tables: tables{
+			accounts:       make(map[string]domain.Account),
+			transactions:   make(map[string]domain.Transaction),
+			authorizations: make(map[string]domain.Authorization),
+			transfers:      make(map[string]domain.Transfer),
+			cashIns:        make(map[string]domain.CashIn),
+			webhooks:       make(map[string]domain.Webhook),
+			deliveries:     make(map[string]domain.WebhookDelivery),
+			apiKeys:        make(map[string]domain.APIKey),
+		},
+	}
+}
+
+// WithTransaction runs fn holding the store, keeping its changes on success and discarding them on error.
+// When ctx already holds the store, fn runs nested in that unit of work and only its own changes are discarded
+func (s *Store) WithTransaction(ctx context.Context, fn func(ctxFn context.Context) error) error {
+	if !s.held(ctx) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		ctx = context.WithValue(ctx, txKey{}, s)
+	}
+
+	snapshot := s.tables.clone()
+	defer func() {
+		if p := recover(); p != nil {
+			s.tables = snapshot
+			panic(p)
+		}
+	}()
+
+	if err := fn(ctx); err != nil {
+		s.tables = snapshot
+		return err
+	}
+
+	return nil
+}
+
+// read runs fn with the store locked for reading, unless ctx already holds it
+func (s *Store) read(ctx context.Context, fn func(t *tables)) {
+	if !s.held(ctx) {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+	}
+
+	fn(&s.tables)
+}
+
+// write runs fn with the store locked for writing, unless ctx already holds it
+func (s *Store) write(ctx context.Context, fn func(t *tables) error) error {
+	if !s.held(ctx) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	}
+
+	return fn(&s.tables)
+}
+
+// held reports whether ctx belongs to a unit of work holding the store
+func (s *Store) held(ctx context.Context) bool {
+	store, ok := ctx.Value(txKey{}).(*Store)
+	return ok && store == s
+}
+
+// clone copies the indexes of the tables. The entities are values, so the copy is not affected
+// by later writes to the original
+func (t tables) clone() tables {
+	return tables{
+		accounts:       maps.Clone(t.accounts),
+		transactions:   maps.Clone(t.transactions),
+		authorizations: maps.Clone(t.authorizations),
+		transfers:      maps.Clone(t.transfers),
+		cashIns:        maps.Clone(t.cashIns),
+		webhooks:       maps.Clone(t.webhooks),
+		deliveries:     maps.Clone(t.deliveries),
+		apiKeys:        maps.Clone(t.apiKeys),
+		journal:        slices.Clone(t.journal),
+		outbox:         slices.Clone(t.outbox),
+	}
+}
+
+// record appends the entry to the journal, rejecting unbalanced postings as the database repositories do
+func (t *tables) record(entry domain.JournalEntry) error {
+	if !entry.Balanced() {
+		return domain.ErrJournalEntryUnbalanced
+	}
+
+	t.journal = append(t.journal, entry)
+	return nil
+}
+
+// enqueue appends the event to the outbox in the order the changes happened
+func (t *tables) enqueue(event domain.Event) {
+	t.outbox = append(t.outbox, outboxEvent{event: event})
+}
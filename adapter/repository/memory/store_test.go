@@ -0,0 +1,208 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/adapter/presenter"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+func TestStore_WithTransaction(t *testing.T) {
+	var errFn = errors.New("failed")
+
+	type args struct {
+		fn func(store *Store, accounts *AccountRepository) func(context.Context) error
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantLimit int64
+		wantErr   error
+	}{
+		{
+			name: "Keep the changes on success",
+			args: args{
+				fn: func(_ *Store, accounts *AccountRepository) func(context.Context) error {
+					return func(ctx context.Context) error {
+						return accounts.UpdateCreditLimit(ctx, "1", 500)
+					}
+				},
+			},
+			wantLimit: 500,
+		},
+		{
+			name: "Discard the changes on error",
+			args: args{
+				fn: func(_ *Store, accounts *AccountRepository) func(context.Context) error {
+					return func(ctx context.Context) error {
+						if err := accounts.UpdateCreditLimit(ctx, "1", 500); err != nil {
+							return err
+						}
+						return errFn
+					}
+				},
+			},
+			wantLimit: 1000,
+			wantErr:   errFn,
+		},
+		{
+			name: "Discard only the changes of the nested unit of work on error",
+			args: args{
+				fn: func(store *Store, accounts *AccountRepository) func(context.Context) error {
+					return func(ctx context.Context) error {
+						if err := accounts.UpdateCreditLimit(ctx, "1", 500); err != nil {
+							return err
+						}
+
+						_ = store.WithTransaction(ctx, func(ctx context.Context) error {
+							if err := accounts.UpdateCreditLimit(ctx, "1", 0); err != nil {
+								return err
+							}
+							return errFn
+						})
+
+						return nil
+					}
+				},
+			},
+			wantLimit: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				store    = NewStore()
+				accounts = NewAccountRepository(store)
+				ctx      = domain.WithAllTenants(context.Background())
+			)
+
+			if _, err := accounts.Create(ctx, domain.NewStoredAccount("1", "12345678900", 1000, time.Time{})); err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			if err := store.WithTransaction(ctx, tt.args.fn(store, accounts)); err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			account, err := accounts.FindByID(ctx, "1")
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			if account.AvailableCreditLimit() != tt.wantLimit {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, account.AvailableCreditLimit(), tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestStore_WithTransaction_Panic(t *testing.T) {
+	var (
+		store    = NewStore()
+		accounts = NewAccountRepository(store)
+		ctx      = domain.WithAllTenants(context.Background())
+	)
+
+	func() {
+		defer func() {
+			if p := recover(); p == nil {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Panic", p, "panic propagated")
+			}
+		}()
+
+		_ = store.WithTransaction(ctx, func(ctx context.Context) error {
+			_, _ = accounts.Create(ctx, domain.NewStoredAccount("1", "12345678900", 1000, time.Time{}))
+			panic("boom")
+		})
+	}()
+
+	if _, err := accounts.FindByID(ctx, "1"); err != domain.ErrAccountNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Panic", err, domain.ErrAccountNotFound)
+	}
+}
+
+func TestStore_ConcurrentDebits(t *testing.T) {
+	const (
+		initialLimit = 1000
+		debits       = 50
+		amount       = 100
+	)
+
+	var (
+		store        = NewStore()
+		accounts     = NewAccountRepository(store)
+		transactions = NewTransactionRepository(store)
+		uc           = usecase.NewCreateTransactionInteractor(
+			store,
+			transactions,
+			transactions,
+			transactions,
+			accounts,
+			accounts,
+			NewOperationRepository(),
+			NewOutboxRepository(store),
+			presenter.NewCreateTransactionPresenter(),
+			usecase.NewNoopTransactionMetrics(),
+			time.Second,
+		)
+		wg sync.WaitGroup
+	)
+
+	if _, err := accounts.Create(domain.WithAllTenants(context.Background()), domain.NewStoredAccount("1", "12345678900", initialLimit, time.Now())); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Concurrent debits", err)
+	}
+
+	for i := 0; i < debits; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := uc.Execute(domain.WithAllTenants(context.Background()), usecase.CreateTransactionInput{
+				AccountID:   "1",
+				OperationID: domain.CompraAVista,
+				Amount:      amount,
+			})
+			if err != nil && err != domain.ErrAccountInsufficientCreditLimit {
+				t.Errorf("[TestCase '%s'] Err: '%v'", "Concurrent debits", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	account, err := accounts.FindByID(domain.WithAllTenants(context.Background()), "1")
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Concurrent debits", err)
+	}
+
+	created, err := transactions.FindByAccountID(domain.WithAllTenants(context.Background()), domain.TransactionFilter{AccountID: "1"})
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Concurrent debits", err)
+	}
+
+	if account.AvailableCreditLimit() != 0 || len(created) != initialLimit/amount {
+		t.Errorf(
+			"[TestCase '%s'] Got limit: '%v' transactions: '%v' | Want limit: '%v' transactions: '%v'",
+			"Concurrent debits",
+			account.AvailableCreditLimit(),
+			len(created),
+			0,
+			initialLimit/amount,
+		)
+	}
+
+	balances, err := NewLedgerRepository(store).FindAccountBalances(domain.WithAllTenants(context.Background()))
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Concurrent debits", err)
+	}
+
+	if len(balances) != 1 || balances[0].Drift() != 0 {
+		t.Errorf("[TestCase '%s'] Got ledger: '%+v' | Want drift: '%v'", "Concurrent debits", balances, 0)
+	}
+}
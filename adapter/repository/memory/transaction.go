@@ -0,0 +1,169 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+// TransactionRepository stores transactions in memory
+type TransactionRepository struct {
+	store *Store
+}
+
+// NewTransactionRepository creates new TransactionRepository backed by the store
+func NewTransactionRepository(store *Store) *TransactionRepository {
+	return &TransactionRepository{
+		store: store,
+	}
+}
+
+// Create stores the transaction with its installment plan, journal entry and TransactionCreated event,
+// refusing the transactions of other tenants than the one of the request
+func (r *TransactionRepository) Create(ctx context.Context, transaction domain.Transaction) (domain.Transaction, error) {
+	err := r.store.write(ctx, func(t *tables) error {
+		if !domain.TenantAllowed(ctx, transaction.TenantID()) {
+			return domain.ErrAccountNotFound
+		}
+
+		t.transactions[transaction.ID()] = transaction
+		t.enqueue(domain.NewTransactionCreatedEvent(uuid.New().String(), transaction))
+		return t.record(domain.NewTransactionJournalEntry(uuid.New().String(), transaction))
+	})
+	if err != nil {
+		return domain.Transaction{}, err
+	}
+
+	return transaction, nil
+}
+
+// FindByID returns the transaction of the tenant of the request
+func (r *TransactionRepository) FindByID(ctx context.Context, ID string) (domain.Transaction, error) {
+	var (
+		transaction domain.Transaction
+		ok          bool
+	)
+
+	r.store.read(ctx, func(t *tables) {
+		transaction, ok = t.transactions[ID]
+	})
+	if !ok || !domain.TenantAllowed(ctx, transaction.TenantID()) {
+		return domain.Transaction{}, domain.ErrTransactionNotFound
+	}
+
+	return transaction, nil
+}
+
+// FindByAccountID returns the transactions matching the filter, newest first
+func (r *TransactionRepository) FindByAccountID(
+	ctx context.Context,
+	filter domain.TransactionFilter,
+) ([]domain.Transaction, error) {
+	var transactions = make([]domain.Transaction, 0)
+	r.store.read(ctx, func(t *tables) {
+		for _, transaction := range t.transactions {
+			if domain.TenantAllowed(ctx, transaction.TenantID()) && matches(transaction, filter) {
+				transactions = append(transactions, transaction)
+			}
+		}
+	})
+
+	sort.Slice(transactions, func(i, j int) bool {
+		return newer(domain.NewTransactionCursor(transactions[i]), domain.NewTransactionCursor(transactions[j]))
+	})
+
+	if filter.Limit > 0 && len(transactions) > filter.Limit {
+		transactions = transactions[:filter.Limit]
+	}
+
+	return transactions, nil
+}
+
+// FindReversedAmount sums the reversals of the transaction
+func (r *TransactionRepository) FindReversedAmount(ctx context.Context, ID string) (int64, error) {
+	var reversed int64
+	r.store.read(ctx, func(t *tables) {
+		for _, transaction := range t.transactions {
+			if transaction.OriginalTransactionID() == ID && domain.TenantAllowed(ctx, transaction.TenantID()) {
+				reversed += transaction.Amount()
+			}
+		}
+	})
+
+	return reversed, nil
+}
+
+// FindOpenByAccountID returns the transactions of the account with open balance, oldest first
+func (r *TransactionRepository) FindOpenByAccountID(ctx context.Context, accountID string) ([]domain.Transaction, error) {
+	var transactions = make([]domain.Transaction, 0)
+	r.store.read(ctx, func(t *tables) {
+		for _, transaction := range t.transactions {
+			if transaction.AccountID() == accountID &&
+				transaction.Balance() != 0 &&
+				domain.TenantAllowed(ctx, transaction.TenantID()) {
+				transactions = append(transactions, transaction)
+			}
+		}
+	})
+
+	sort.Slice(transactions, func(i, j int) bool {
+		return newer(domain.NewTransactionCursor(transactions[j]), domain.NewTransactionCursor(transactions[i]))
+	})
+
+	return transactions, nil
+}
+
+// UpdateBalance stores the open balance of the transaction
+func (r *TransactionRepository) UpdateBalance(ctx context.Context, ID string, balance int64) error {
+	return r.store.write(ctx, func(t *tables) error {
+		transaction, ok := t.transactions[ID]
+		if !ok || !domain.TenantAllowed(ctx, transaction.TenantID()) {
+			return domain.ErrTransactionNotFound
+		}
+
+		t.transactions[ID] = transaction.WithBalance(balance)
+		return nil
+	})
+}
+
+// matches reports whether the transaction meets the criteria of the filter
+func matches(transaction domain.Transaction, filter domain.TransactionFilter) bool {
+	amount := transaction.Amount()
+	if amount < 0 {
+		amount = -amount
+	}
+
+	switch {
+	case transaction.AccountID() != filter.AccountID:
+		return false
+	case filter.OperationID != "" && transaction.Operation().ID() != filter.OperationID:
+		return false
+	case filter.Type == domain.Debit && transaction.Amount() >= 0:
+		return false
+	case filter.Type == domain.Credit && transaction.Amount() <= 0:
+		return false
+	case filter.MinAmount > 0 && amount < filter.MinAmount:
+		return false
+	case filter.MaxAmount > 0 && amount > filter.MaxAmount:
+		return false
+	case !filter.From.IsZero() && transaction.CreatedAt().Before(filter.From):
+		return false
+	case !filter.To.IsZero() && transaction.CreatedAt().After(filter.To):
+		return false
+	case !filter.After.IsZero() && !newer(filter.After, domain.NewTransactionCursor(transaction)):
+		return false
+	}
+
+	return true
+}
+
+// newer reports whether a comes before b in the newest first order, ties broken by id
+func newer(a domain.TransactionCursor, b domain.TransactionCursor) bool {
+	if !a.CreatedAt().Equal(b.CreatedAt()) {
+		return a.CreatedAt().After(b.CreatedAt())
+	}
+
+	return a.ID() > b.ID()
+}
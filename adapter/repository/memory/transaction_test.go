@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestTransactionRepository_FindByAccountID(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		pagamento, _    = domain.NewOperation(domain.Pagamento)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		ctx             = domain.WithAllTenants(context.Background())
+		repo            = NewTransactionRepository(NewStore())
+	)
+
+	for _, transaction := range []domain.Transaction{
+		domain.NewTransaction("a", "1", compraAVista, 100, -100, now.Add(-3*time.Hour)),
+		domain.NewTransaction("b", "1", pagamento, 300, 0, now.Add(-2*time.Hour)),
+		domain.NewTransaction("c", "1", compraAVista, 200, -200, now.Add(-time.Hour)),
+		domain.NewTransaction("d", "2", compraAVista, 100, -100, now),
+	} {
+		if _, err := repo.Create(ctx, transaction); err != nil {
+			t.Fatalf("[TestCase '%s'] Err: '%v'", "Create", err)
+		}
+	}
+
+	cursor, _ := repo.FindByID(ctx, "c")
+
+	tests := []struct {
+		name   string
+		filter domain.TransactionFilter
+		want   []string
+	}{
+		{
+			name:   "Newest first",
+			filter: domain.TransactionFilter{AccountID: "1"},
+			want:   []string{"c", "b", "a"},
+		},
+		{
+			name:   "Debits only",
+			filter: domain.TransactionFilter{AccountID: "1", Type: domain.Debit},
+			want:   []string{"c", "a"},
+		},
+		{
+			name:   "Amount range",
+			filter: domain.TransactionFilter{AccountID: "1", MinAmount: 150, MaxAmount: 250},
+			want:   []string{"c"},
+		},
+		{
+			name:   "After the cursor with limit",
+			filter: domain.TransactionFilter{AccountID: "1", After: domain.NewTransactionCursor(cursor), Limit: 1},
+			want:   []string{"b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactions, err := repo.FindByAccountID(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			var got = make([]string, 0)
+			for _, transaction := range transactions {
+				got = append(got, transaction.ID())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransactionRepository_UpdateBalance(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		ctx             = domain.WithAllTenants(context.Background())
+		repo            = NewTransactionRepository(NewStore())
+	)
+
+	for _, transaction := range []domain.Transaction{
+		domain.NewTransaction("b", "1", compraAVista, 100, -100, now),
+		domain.NewTransaction("a", "1", compraAVista, 100, -100, now.Add(-time.Hour)),
+	} {
+		if _, err := repo.Create(ctx, transaction); err != nil {
+			t.Fatalf("[TestCase '%s'] Err: '%v'", "Create", err)
+		}
+	}
+
+	if err := repo.UpdateBalance(ctx, "a", 0); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Settle the oldest", err)
+	}
+
+	open, err := repo.FindOpenByAccountID(ctx, "1")
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Settle the oldest", err)
+	}
+
+	if len(open) != 1 || open[0].ID() != "b" {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Settle the oldest", open, "[b]")
+	}
+
+	if err := repo.UpdateBalance(ctx, "x", 0); err != domain.ErrTransactionNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Unknown transaction", err, domain.ErrTransactionNotFound)
+	}
+}
+
+func TestTransactionRepository_Tenant(t *testing.T) {
+	var (
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		tenantA         = domain.WithTenant(context.Background(), "tenant-a")
+		tenantB         = domain.WithTenant(context.Background(), "tenant-b")
+		repo            = NewTransactionRepository(NewStore())
+		transaction     = domain.NewTransaction("a", "1", compraAVista, 100, -100, now).WithTenant("tenant-a")
+	)
+
+	if _, err := repo.Create(tenantB, transaction); err != domain.ErrAccountNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Create in another tenant", err, domain.ErrAccountNotFound)
+	}
+
+	if _, err := repo.Create(tenantA, transaction); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create", err)
+	}
+
+	if _, err := repo.FindByID(tenantB, "a"); err != domain.ErrTransactionNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Find of another tenant", err, domain.ErrTransactionNotFound)
+	}
+
+	if err := repo.UpdateBalance(tenantB, "a", 0); err != domain.ErrTransactionNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Update of another tenant", err, domain.ErrTransactionNotFound)
+	}
+
+	transactions, err := repo.FindByAccountID(tenantB, domain.TransactionFilter{AccountID: "1"})
+	if err != nil || len(transactions) != 0 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Err: '%v'", "List of another tenant", transactions, err)
+	}
+
+	got, err := repo.FindByID(tenantA, "a")
+	if err != nil || got.TenantID() != "tenant-a" || got.Balance() != -100 {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Err: '%v'", "Find of the tenant", got, err)
+	}
+}
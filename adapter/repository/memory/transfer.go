@@ -0,0 +1,32 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// TransferRepository stores transfers in memory
+type TransferRepository struct {
+	store *Store
+}
+
+// NewTransferRepository creates new TransferRepository backed by the store
+func NewTransferRepository(store *Store) *TransferRepository {
+	return &TransferRepository{
+		store: store,
+	}
+}
+
+// Create stores the transfer
+func (r *TransferRepository) Create(ctx context.Context, transfer domain.Transfer) (domain.Transfer, error) {
+	err := r.store.write(ctx, func(t *tables) error {
+		t.transfers[transfer.ID()] = transfer
+		return nil
+	})
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+
+	return transfer, nil
+}
@@ -0,0 +1,210 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// WebhookRepository stores webhooks in memory
+type WebhookRepository struct {
+	store *Store
+}
+
+// NewWebhookRepository creates new WebhookRepository backed by the store
+func NewWebhookRepository(store *Store) *WebhookRepository {
+	return &WebhookRepository{
+		store: store,
+	}
+}
+
+// Create stores the webhook
+func (r *WebhookRepository) Create(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	err := r.store.write(ctx, func(t *tables) error {
+		t.webhooks[webhook.ID()] = webhook
+		return nil
+	})
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+// FindByID returns the webhook
+func (r *WebhookRepository) FindByID(ctx context.Context, ID string) (domain.Webhook, error) {
+	var (
+		webhook domain.Webhook
+		ok      bool
+	)
+
+	r.store.read(ctx, func(t *tables) {
+		webhook, ok = t.webhooks[ID]
+	})
+	if !ok || !domain.TenantAllowed(ctx, webhook.TenantID()) {
+		return domain.Webhook{}, domain.ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
+
+// FindAll returns the webhooks of the tenant for the account, or all of them when it is empty, the oldest first
+func (r *WebhookRepository) FindAll(ctx context.Context, accountID string) ([]domain.Webhook, error) {
+	return r.filter(ctx, func(webhook domain.Webhook) bool {
+		return domain.TenantAllowed(ctx, webhook.TenantID()) && (accountID == "" || webhook.AccountID() == accountID)
+	}), nil
+}
+
+// FindSubscribers returns the active webhooks of the tenant of the event subscribed to it, for its account
+// or global
+func (r *WebhookRepository) FindSubscribers(ctx context.Context, event domain.Event) ([]domain.Webhook, error) {
+	return r.filter(ctx, func(webhook domain.Webhook) bool {
+		return webhook.Subscribes(event)
+	}), nil
+}
+
+// Update stores the webhook
+func (r *WebhookRepository) Update(ctx context.Context, webhook domain.Webhook) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if stored, ok := t.webhooks[webhook.ID()]; !ok || !domain.TenantAllowed(ctx, stored.TenantID()) {
+			return domain.ErrWebhookNotFound
+		}
+
+		t.webhooks[webhook.ID()] = webhook
+		return nil
+	})
+}
+
+// Delete removes the webhook and its deliveries
+func (r *WebhookRepository) Delete(ctx context.Context, ID string) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if webhook, ok := t.webhooks[ID]; !ok || !domain.TenantAllowed(ctx, webhook.TenantID()) {
+			return domain.ErrWebhookNotFound
+		}
+
+		delete(t.webhooks, ID)
+		for deliveryID, delivery := range t.deliveries {
+			if delivery.WebhookID() == ID {
+				delete(t.deliveries, deliveryID)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *WebhookRepository) filter(ctx context.Context, keep func(domain.Webhook) bool) []domain.Webhook {
+	var webhooks = make([]domain.Webhook, 0)
+	r.store.read(ctx, func(t *tables) {
+		for _, webhook := range t.webhooks {
+			if keep(webhook) {
+				webhooks = append(webhooks, webhook)
+			}
+		}
+	})
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		if !webhooks[i].CreatedAt().Equal(webhooks[j].CreatedAt()) {
+			return webhooks[i].CreatedAt().Before(webhooks[j].CreatedAt())
+		}
+
+		return webhooks[i].ID() < webhooks[j].ID()
+	})
+
+	return webhooks
+}
+
+// WebhookDeliveryRepository stores the delivery log of the webhooks in memory
+type WebhookDeliveryRepository struct {
+	store *Store
+}
+
+// NewWebhookDeliveryRepository creates new WebhookDeliveryRepository backed by the store
+func NewWebhookDeliveryRepository(store *Store) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		store: store,
+	}
+}
+
+// Create stores the delivery, ignoring an event already scheduled to the webhook
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, delivery domain.WebhookDelivery) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for _, scheduled := range t.deliveries {
+			if scheduled.WebhookID() == delivery.WebhookID() && scheduled.EventID() == delivery.EventID() {
+				return nil
+			}
+		}
+
+		t.deliveries[delivery.ID()] = delivery
+		return nil
+	})
+}
+
+// FindDue returns up to limit pending deliveries of active webhooks whose next attempt is due, the earliest first
+func (r *WebhookDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries = make([]domain.WebhookDelivery, 0)
+	r.store.read(ctx, func(t *tables) {
+		for _, delivery := range t.deliveries {
+			if domain.TenantAllowed(ctx, delivery.TenantID()) &&
+				delivery.Status() == domain.WebhookDeliveryPending &&
+				!delivery.NextAttemptAt().After(now) &&
+				t.webhooks[delivery.WebhookID()].Active() {
+				deliveries = append(deliveries, delivery)
+			}
+		}
+	})
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		if !deliveries[i].NextAttemptAt().Equal(deliveries[j].NextAttemptAt()) {
+			return deliveries[i].NextAttemptAt().Before(deliveries[j].NextAttemptAt())
+		}
+
+		return deliveries[i].CreatedAt().Before(deliveries[j].CreatedAt())
+	})
+
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+
+	return deliveries, nil
+}
+
+// FindByWebhookID returns up to limit deliveries of the webhook, the newest first
+func (r *WebhookDeliveryRepository) FindByWebhookID(ctx context.Context, webhookID string, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries = make([]domain.WebhookDelivery, 0)
+	r.store.read(ctx, func(t *tables) {
+		for _, delivery := range t.deliveries {
+			if delivery.WebhookID() == webhookID && domain.TenantAllowed(ctx, delivery.TenantID()) {
+				deliveries = append(deliveries, delivery)
+			}
+		}
+	})
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		if !deliveries[i].CreatedAt().Equal(deliveries[j].CreatedAt()) {
+			return deliveries[i].CreatedAt().After(deliveries[j].CreatedAt())
+		}
+
+		return deliveries[i].ID() > deliveries[j].ID()
+	})
+
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+
+	return deliveries, nil
+}
+
+// Update stores the delivery
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, delivery domain.WebhookDelivery) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if stored, ok := t.deliveries[delivery.ID()]; !ok || !domain.TenantAllowed(ctx, stored.TenantID()) {
+			return nil
+		}
+
+		t.deliveries[delivery.ID()] = delivery
+		return nil
+	})
+}
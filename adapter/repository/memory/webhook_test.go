@@ -0,0 +1,211 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestWebhookDeliveryRepository_FindDue(t *testing.T) {
+	var (
+		now    = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		events = []string{domain.EventTransactionCreated}
+	)
+
+	tests := []struct {
+		name     string
+		inactive bool
+		deleted  bool
+		limit    int
+		want     []string
+	}{
+		{
+			name:  "Due deliveries oldest first, each event once",
+			limit: 10,
+			want:  []string{"e1", "e2"},
+		},
+		{
+			name:  "Due deliveries up to the limit",
+			limit: 1,
+			want:  []string{"e1"},
+		},
+		{
+			name:     "Deliveries of inactive webhooks are skipped",
+			inactive: true,
+			limit:    10,
+			want:     []string{},
+		},
+		{
+			name:    "Deliveries deleted with the webhook",
+			deleted: true,
+			limit:   10,
+			want:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = domain.WithAllTenants(context.Background())
+				store      = NewStore()
+				webhooks   = NewWebhookRepository(store)
+				deliveries = NewWebhookDeliveryRepository(store)
+			)
+
+			webhook := domain.NewWebhook("w", "", "http://localhost", "secret", events, !tt.inactive, now)
+			_, _ = webhooks.Create(ctx, webhook)
+
+			_ = deliveries.Create(ctx, domain.NewWebhookDelivery(
+				"d1", "w", "e1", domain.EventTransactionCreated, nil, domain.WebhookDeliveryPending, 0, "", now, time.Time{}, now,
+			))
+			_ = deliveries.Create(ctx, domain.NewWebhookDelivery(
+				"d2", "w", "e2", domain.EventTransactionCreated, nil, domain.WebhookDeliveryPending, 0, "", now.Add(time.Second), time.Time{}, now,
+			))
+			// The same event scheduled again to the webhook is ignored
+			_ = deliveries.Create(ctx, domain.NewWebhookDelivery(
+				"d3", "w", "e1", domain.EventTransactionCreated, nil, domain.WebhookDeliveryPending, 0, "", now, time.Time{}, now,
+			))
+			// Not due yet
+			_ = deliveries.Create(ctx, domain.NewWebhookDelivery(
+				"d4", "w", "e3", domain.EventTransactionCreated, nil, domain.WebhookDeliveryPending, 0, "", now.Add(time.Hour), time.Time{}, now,
+			))
+
+			if tt.deleted {
+				_ = webhooks.Delete(ctx, "w")
+			}
+
+			due, err := deliveries.FindDue(ctx, now.Add(time.Minute), tt.limit)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			var got = make([]string, 0)
+			for _, delivery := range due {
+				got = append(got, delivery.EventID())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookRepository_Tenant(t *testing.T) {
+	var (
+		now        = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		events     = []string{domain.EventTransactionCreated}
+		tenantA    = domain.WithTenant(context.Background(), "tenant-a")
+		tenantB    = domain.WithTenant(context.Background(), "tenant-b")
+		store      = NewStore()
+		webhooks   = NewWebhookRepository(store)
+		deliveries = NewWebhookDeliveryRepository(store)
+		webhook    = domain.NewWebhook("w", "", "http://localhost", "secret", events, true, now).WithTenant("tenant-a")
+	)
+
+	if _, err := webhooks.Create(tenantA, webhook); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create", err)
+	}
+
+	event := domain.NewEvent("e", domain.EventTransactionCreated, "1", nil, now).WithTenant("tenant-a")
+	if err := deliveries.Create(tenantA, domain.ScheduleWebhookDelivery("d", webhook, event, now)); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create delivery", err)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		execute func(context.Context) (int, error)
+		want    int
+		wantErr error
+	}{
+		{
+			name: "Find webhook of the tenant",
+			ctx:  tenantA,
+			execute: func(ctx context.Context) (int, error) {
+				_, err := webhooks.FindByID(ctx, "w")
+				return 1, err
+			},
+			want: 1,
+		},
+		{
+			name: "Error find webhook of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) (int, error) {
+				_, err := webhooks.FindByID(ctx, "w")
+				return 0, err
+			},
+			wantErr: domain.ErrWebhookNotFound,
+		},
+		{
+			name: "List webhooks of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) (int, error) {
+				found, err := webhooks.FindAll(ctx, "")
+				return len(found), err
+			},
+		},
+		{
+			name: "List deliveries of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) (int, error) {
+				found, err := deliveries.FindByWebhookID(ctx, "w", 10)
+				return len(found), err
+			},
+		},
+		{
+			name: "Error update webhook of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) (int, error) {
+				return 0, webhooks.Update(ctx, webhook)
+			},
+			wantErr: domain.ErrWebhookNotFound,
+		},
+		{
+			name: "Error delete webhook of another tenant",
+			ctx:  tenantB,
+			execute: func(ctx context.Context) (int, error) {
+				return 0, webhooks.Delete(ctx, "w")
+			},
+			wantErr: domain.ErrWebhookNotFound,
+		},
+		{
+			name: "Subscribers of an event of the tenant",
+			ctx:  domain.WithAllTenants(context.Background()),
+			execute: func(ctx context.Context) (int, error) {
+				found, err := webhooks.FindSubscribers(ctx, event)
+				return len(found), err
+			},
+			want: 1,
+		},
+		{
+			name: "Subscribers of an event of another tenant",
+			ctx:  domain.WithAllTenants(context.Background()),
+			execute: func(ctx context.Context) (int, error) {
+				found, err := webhooks.FindSubscribers(ctx, event.WithTenant("tenant-b"))
+				return len(found), err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.execute(tt.ctx)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	found, err := deliveries.FindByWebhookID(tenantA, "w", 10)
+	if err != nil || len(found) != 1 || found[0].TenantID() != "tenant-a" {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Err: '%v'", "Deliveries of the tenant", found, err)
+	}
+}
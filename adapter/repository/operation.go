@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+)
+
+type operationRepository struct {
+	db *sql.DB
+}
+
+// NewOperationRepository creates new operationRepository with its dependencies
+func NewOperationRepository(db *sql.DB) domain.OperationRepository {
+	return operationRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database
+func (o operationRepository) Create(ctx context.Context, operation domain.Operation) (domain.Operation, error) {
+	ctx, span := startSpan(ctx, "CreateOperation", "")
+	defer span.End()
+
+	if _, err := traced(o.db).ExecContext(
+		ctx,
+		`INSERT INTO operations (id, description, type, enabled) VALUES (?, ?, ?, ?)`,
+		operation.ID(),
+		operation.Description(),
+		operation.Type(),
+		operation.Enabled(),
+	); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			if mysqlErr.Number == errDupEntry {
+				return domain.Operation{}, domain.ErrOperationAlreadyExists
+			}
+		}
+
+		return domain.Operation{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return operation, nil
+}
+
+// FindByID performs select into the database
+func (o operationRepository) FindByID(ctx context.Context, ID string) (domain.Operation, error) {
+	ctx, span := startSpan(ctx, "FindOperationByID", "")
+	defer span.End()
+
+	operation, err := scanOperation(traced(o.db).QueryRowContext(
+		ctx,
+		`SELECT id, description, type, enabled FROM operations WHERE id = ?`,
+		ID,
+	))
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.Operation{}, domain.ErrOperationNotFound
+	case err != nil:
+		return domain.Operation{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return operation, nil
+}
+
+// FindAll performs select into the database, disabled operations included
+func (o operationRepository) FindAll(ctx context.Context) ([]domain.Operation, error) {
+	ctx, span := startSpan(ctx, "FindAllOperations", "")
+	defer span.End()
+
+	rows, err := traced(o.db).QueryContext(ctx, `SELECT id, description, type, enabled FROM operations ORDER BY id`)
+	if err != nil {
+		return []domain.Operation{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var operations = make([]domain.Operation, 0)
+	for rows.Next() {
+		operation, err := scanOperation(rows)
+		if err != nil {
+			return []domain.Operation{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		operations = append(operations, operation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Operation{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return operations, nil
+}
+
+// Update performs update into the database
+func (o operationRepository) Update(ctx context.Context, operation domain.Operation) error {
+	ctx, span := startSpan(ctx, "UpdateOperation", "")
+	defer span.End()
+
+	if _, err := traced(o.db).ExecContext(
+		ctx,
+		`UPDATE operations SET description = ?, type = ?, enabled = ? WHERE id = ?`,
+		operation.Description(),
+		operation.Type(),
+		operation.Enabled(),
+		operation.ID(),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+func scanOperation(row scanner) (domain.Operation, error) {
+	var (
+		id          string
+		description string
+		opType      string
+		enabled     bool
+	)
+
+	if err := row.Scan(&id, &description, &opType, &enabled); err != nil {
+		return domain.Operation{}, err
+	}
+
+	return domain.NewCatalogOperation(id, description, opType, enabled)
+}
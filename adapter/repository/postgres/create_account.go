@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type createAccountRepository struct {
+	db *sql.DB
+}
+
+// NewCreateAccountRepository creates new createAccountRepository with its dependencies
+func NewCreateAccountRepository(db *sql.DB) domain.AccountCreator {
+	return createAccountRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database, along with the journal entry of the initial credit limit
+// and the AccountCreated event
+func (c createAccountRepository) Create(ctx context.Context, account domain.Account) (domain.Account, error) {
+	ctx, span := startSpan(ctx, "CreateAccount", account.ID())
+	defer span.End()
+
+	db := executorFrom(ctx, c.db)
+
+	if _, err := db.ExecContext(
+		ctx,
+		`INSERT INTO accounts (id, tenant_id, document_number, available_credit_limit, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		account.ID(),
+		account.TenantID(),
+		account.Document().Number(),
+		account.AvailableCreditLimit(),
+		account.CreatedAt(),
+	); err != nil {
+		if isUniqueViolation(err) {
+			return domain.Account{}, domain.ErrAccountAlreadyExists
+		}
+
+		return domain.Account{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	if err := createJournalEntry(ctx, db, domain.NewOpeningJournalEntry(uuid.New().String(), account)); err != nil {
+		return domain.Account{}, err
+	}
+
+	if err := createOutboxEvent(ctx, db, domain.NewAccountCreatedEvent(uuid.New().String(), account)); err != nil {
+		return domain.Account{}, err
+	}
+
+	return account, nil
+}
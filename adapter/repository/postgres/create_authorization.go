@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type createAuthorizationRepository struct {
+	db *sql.DB
+}
+
+// NewCreateAuthorizationRepository creates new createAuthorizationRepository with its dependencies
+func NewCreateAuthorizationRepository(db *sql.DB) domain.AuthorizationCreator {
+	return createAuthorizationRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database
+func (c createAuthorizationRepository) Create(ctx context.Context, authorization domain.Authorization) (domain.Authorization, error) {
+	ctx, span := startSpan(ctx, "CreateAuthorization", authorization.AccountID())
+	defer span.End()
+
+	db := executorFrom(ctx, c.db)
+
+	if _, err := db.ExecContext(
+		ctx,
+		`INSERT INTO authorizations (id, account_id, operation_id, amount, captured_amount, status, expires_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		authorization.ID(),
+		authorization.AccountID(),
+		authorization.Operation().ID(),
+		authorization.Amount(),
+		authorization.CapturedAmount(),
+		authorization.Status(),
+		authorization.ExpiresAt(),
+		authorization.CreatedAt(),
+	); err != nil {
+		return domain.Authorization{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return authorization, nil
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type createCashInRepository struct {
+	db *sql.DB
+}
+
+// NewCreateCashInRepository creates new createCashInRepository with its dependencies
+func NewCreateCashInRepository(db *sql.DB) domain.CashInCreator {
+	return createCashInRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database, the source reference unique in the tenant detects duplicated cash-ins
+func (c createCashInRepository) Create(ctx context.Context, cashIn domain.CashIn) (domain.CashIn, error) {
+	ctx, span := startSpan(ctx, "CreateCashIn", cashIn.AccountID())
+	defer span.End()
+
+	db := executorFrom(ctx, c.db)
+
+	if _, err := db.ExecContext(
+		ctx,
+		`INSERT INTO cash_ins (id, account_id, tenant_id, source_type, source_reference, amount, transaction_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		cashIn.ID(),
+		cashIn.AccountID(),
+		cashIn.TenantID(),
+		cashIn.Source().Type(),
+		cashIn.Source().Reference(),
+		cashIn.Amount(),
+		cashIn.Transaction().ID(),
+		cashIn.CreatedAt(),
+	); err != nil {
+		if isUniqueViolation(err) {
+			return domain.CashIn{}, domain.ErrCashInAlreadyProcessed
+		}
+
+		return domain.CashIn{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return cashIn, nil
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type createOutboxEventRepository struct {
+	db *sql.DB
+}
+
+// NewCreateOutboxEventRepository creates new createOutboxEventRepository with its dependencies
+func NewCreateOutboxEventRepository(db *sql.DB) domain.OutboxCreator {
+	return createOutboxEventRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database, for the events not caused by another insert
+func (c createOutboxEventRepository) Create(ctx context.Context, event domain.Event) error {
+	ctx, span := startSpan(ctx, "CreateOutboxEvent", event.AccountID())
+	defer span.End()
+
+	return createOutboxEvent(ctx, executorFrom(ctx, c.db), event)
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type createTransactionRepository struct {
+	db *sql.DB
+}
+
+// NewCreateTransactionRepository creates new createTransactionRepository with its dependencies
+func NewCreateTransactionRepository(db *sql.DB) domain.TransactionCreator {
+	return createTransactionRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database, along with the journal entry
+// of the transaction and the TransactionCreated event
+func (c createTransactionRepository) Create(ctx context.Context, transaction domain.Transaction) (domain.Transaction, error) {
+	ctx, span := startSpan(ctx, "CreateTransaction", transaction.AccountID())
+	defer span.End()
+
+	if !domain.TenantAllowed(ctx, transaction.TenantID()) {
+		return domain.Transaction{}, domain.ErrAccountNotFound
+	}
+
+	db := executorFrom(ctx, c.db)
+
+	if _, err := db.ExecContext(
+		ctx,
+		`INSERT INTO transactions (id, tenant_id, account_id, operation_id, amount, balance, original_transaction_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		transaction.ID(),
+		transaction.TenantID(),
+		transaction.AccountID(),
+		transaction.Operation().ID(),
+		transaction.Amount(),
+		transaction.Balance(),
+		sql.NullString{
+			String: transaction.OriginalTransactionID(),
+			Valid:  transaction.OriginalTransactionID() != "",
+		},
+		transaction.CreatedAt(),
+	); err != nil {
+		return domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	for _, installment := range transaction.InstallmentPlan().Installments() {
+		if _, err := db.ExecContext(
+			ctx,
+			`INSERT INTO installments (transaction_id, number, amount, due_date) VALUES ($1, $2, $3, $4)`,
+			transaction.ID(),
+			installment.Number(),
+			installment.Amount(),
+			installment.DueDate(),
+		); err != nil {
+			return domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+		}
+	}
+
+	if err := createJournalEntry(ctx, db, domain.NewTransactionJournalEntry(uuid.New().String(), transaction)); err != nil {
+		return domain.Transaction{}, err
+	}
+
+	if err := createOutboxEvent(ctx, db, domain.NewTransactionCreatedEvent(uuid.New().String(), transaction)); err != nil {
+		return domain.Transaction{}, err
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type createTransferRepository struct {
+	db *sql.DB
+}
+
+// NewCreateTransferRepository creates new createTransferRepository with its dependencies
+func NewCreateTransferRepository(db *sql.DB) domain.TransferCreator {
+	return createTransferRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database
+func (c createTransferRepository) Create(ctx context.Context, transfer domain.Transfer) (domain.Transfer, error) {
+	ctx, span := startSpan(ctx, "CreateTransfer", transfer.PayerID())
+	defer span.End()
+
+	db := executorFrom(ctx, c.db)
+
+	if _, err := db.ExecContext(
+		ctx,
+		`INSERT INTO transfers (id, payer_account_id, payee_account_id, amount, debit_transaction_id, credit_transaction_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		transfer.ID(),
+		transfer.PayerID(),
+		transfer.PayeeID(),
+		transfer.Amount(),
+		transfer.Debit().ID(),
+		transfer.Credit().ID(),
+		transfer.CreatedAt(),
+	); err != nil {
+		return domain.Transfer{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return transfer, nil
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type deleteIdempotencyKeyRepository struct {
+	db *sql.DB
+}
+
+// NewDeleteIdempotencyKeyRepository creates new deleteIdempotencyKeyRepository with its dependencies
+func NewDeleteIdempotencyKeyRepository(db *sql.DB) domain.IdempotencyKeyDeleter {
+	return deleteIdempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// Delete performs delete into the database for the key of the tenant
+func (d deleteIdempotencyKeyRepository) Delete(ctx context.Context, key string) error {
+	ctx, span := startSpan(ctx, "DeleteIdempotencyKey", "")
+	defer span.End()
+
+	if _, err := traced(d.db).ExecContext(
+		ctx,
+		`DELETE FROM idempotency_keys WHERE tenant_id = $1 AND id = $2`,
+		domain.TenantFrom(ctx),
+		key,
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	errUniqueViolation      = "23505"
+	errSerializationFailure = "40001"
+	errDeadlockDetected     = "40P01"
+	errLockNotAvailable     = "55P03"
+)
+
+var (
+	errUnknown = errors.New("unknown error")
+)
+
+// isUniqueViolation reports whether the statement was rejected by a primary key or unique constraint
+func isUniqueViolation(err error) bool {
+	return hasCode(err, errUniqueViolation)
+}
+
+// isRetryable reports whether the error aborted the database transaction because of a conflict
+// with a concurrent transaction, in which case running it again may succeed
+func isRetryable(err error) bool {
+	return hasCode(err, errSerializationFailure, errDeadlockDetected, errLockNotAvailable)
+}
+
+func hasCode(err error, codes ...pq.ErrorCode) bool {
+	pqErr, ok := pkgerrors.Cause(err).(*pq.Error)
+	if !ok {
+		return false
+	}
+
+	for _, code := range codes {
+		if pqErr.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
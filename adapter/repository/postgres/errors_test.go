@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func Test_isUniqueViolation(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "Unique violation",
+			err:  &pq.Error{Code: errUniqueViolation},
+			want: true,
+		},
+		{
+			name: "Foreign key violation",
+			err:  &pq.Error{Code: "23503"},
+			want: false,
+		},
+		{
+			name: "Not a postgres error",
+			err:  errors.New("account already exists"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isUniqueViolation(tt.err); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "Deadlock",
+			err:  &pq.Error{Code: errDeadlockDetected},
+			want: true,
+		},
+		{
+			name: "Serialization failure wrapped by a repository",
+			err:  pkgerrors.Wrap(&pq.Error{Code: errSerializationFailure}, errUnknown.Error()),
+			want: true,
+		},
+		{
+			name: "Lock timeout",
+			err:  &pq.Error{Code: errLockNotAvailable},
+			want: true,
+		},
+		{
+			name: "Unique violation",
+			err:  &pq.Error{Code: errUniqueViolation},
+			want: false,
+		},
+		{
+			name: "Not a postgres error",
+			err:  errors.New("credit limit insufficient"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
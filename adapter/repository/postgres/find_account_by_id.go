@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findAccountByIDRepository struct {
+	db *sql.DB
+}
+
+// NewAccountByIDRepository NewCreateAccountRepository creates new findAccountByIDRepository with its dependencies
+func NewAccountByIDRepository(db *sql.DB) domain.AccountFinder {
+	return findAccountByIDRepository{
+		db: db,
+	}
+}
+
+// FindByID performs select into the database
+func (f findAccountByIDRepository) FindByID(ctx context.Context, ID string) (domain.Account, error) {
+	ctx, span := startSpan(ctx, "FindAccountByID", ID)
+	defer span.End()
+
+	db := executorFrom(ctx, f.db)
+
+	var (
+		id            string
+		tenantID      string
+		docNumber     string
+		avCreditLimit int64
+		createdAt     time.Time
+	)
+
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT id, tenant_id, document_number, available_credit_limit, created_at FROM accounts WHERE id = $1 AND ($2 OR tenant_id = $3)",
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	).Scan(&id, &tenantID, &docNumber, &avCreditLimit, &createdAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.Account{}, domain.ErrAccountNotFound
+	default:
+		return domain.NewStoredAccount(id, docNumber, avCreditLimit, createdAt).WithTenant(tenantID), errors.Wrap(err, errUnknown.Error())
+	}
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findAuthorizationByIDRepository struct {
+	db         *sql.DB
+	operations domain.OperationFinder
+}
+
+// NewFindAuthorizationByIDRepository creates new findAuthorizationByIDRepository with its dependencies
+func NewFindAuthorizationByIDRepository(db *sql.DB, operations domain.OperationFinder) domain.AuthorizationFinder {
+	return findAuthorizationByIDRepository{
+		db:         db,
+		operations: operations,
+	}
+}
+
+// FindByID performs select into the database for an authorization of an account of the tenant, locking
+// its row until the end of the transaction. The account is read without a lock, as the callers lock it next
+func (f findAuthorizationByIDRepository) FindByID(ctx context.Context, ID string) (domain.Authorization, error) {
+	ctx, span := startSpan(ctx, "FindAuthorizationByID", "")
+	defer span.End()
+
+	db := executorFrom(ctx, f.db)
+
+	authorization, err := scanAuthorization(ctx, db.QueryRowContext(
+		ctx,
+		`SELECT id, account_id, operation_id, amount, captured_amount, status, transaction_id, expires_at, created_at
+			FROM authorizations
+			WHERE id = $1 AND account_id IN (SELECT id FROM accounts WHERE $2 OR tenant_id = $3) FOR UPDATE`,
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	), f.operations)
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.Authorization{}, domain.ErrAuthorizationNotFound
+	case err != nil:
+		return domain.Authorization{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return authorization, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAuthorization(ctx context.Context, row scanner, operations domain.OperationFinder) (domain.Authorization, error) {
+	var (
+		id             string
+		accID          string
+		operationID    string
+		amount         int64
+		capturedAmount int64
+		status         string
+		transactionID  sql.NullString
+		expiresAt      time.Time
+		createdAt      time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&accID,
+		&operationID,
+		&amount,
+		&capturedAmount,
+		&status,
+		&transactionID,
+		&expiresAt,
+		&createdAt,
+	); err != nil {
+		return domain.Authorization{}, err
+	}
+
+	op, err := operations.FindByID(ctx, operationID)
+	if err != nil {
+		return domain.Authorization{}, err
+	}
+
+	return domain.NewAuthorization(
+		id,
+		accID,
+		op,
+		amount,
+		capturedAmount,
+		status,
+		transactionID.String,
+		expiresAt,
+		createdAt,
+	), nil
+}
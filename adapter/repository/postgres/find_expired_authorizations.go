@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findExpiredAuthorizationsRepository struct {
+	db         *sql.DB
+	operations domain.OperationFinder
+}
+
+// NewFindExpiredAuthorizationsRepository creates new findExpiredAuthorizationsRepository with its dependencies
+func NewFindExpiredAuthorizationsRepository(db *sql.DB, operations domain.OperationFinder) domain.AuthorizationExpiredFinder {
+	return findExpiredAuthorizationsRepository{
+		db:         db,
+		operations: operations,
+	}
+}
+
+// FindExpired performs select into the database of the pending authorizations past their expiration
+func (f findExpiredAuthorizationsRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]domain.Authorization, error) {
+	ctx, span := startSpan(ctx, "FindExpiredAuthorizations", "")
+	defer span.End()
+
+	db := executorFrom(ctx, f.db)
+
+	rows, err := db.QueryContext(
+		ctx,
+		`SELECT id, account_id, operation_id, amount, captured_amount, status, transaction_id, expires_at, created_at
+			FROM authorizations WHERE status = $1 AND expires_at <= $2 ORDER BY expires_at LIMIT $3`,
+		domain.AuthorizationPending,
+		now,
+		limit,
+	)
+	if err != nil {
+		return []domain.Authorization{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var authorizations = make([]domain.Authorization, 0)
+	for rows.Next() {
+		authorization, err := scanAuthorization(ctx, rows, f.operations)
+		if err != nil {
+			return []domain.Authorization{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		authorizations = append(authorizations, authorization)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Authorization{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return authorizations, nil
+}
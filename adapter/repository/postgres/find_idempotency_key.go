@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findIdempotencyKeyRepository struct {
+	db *sql.DB
+}
+
+// NewFindIdempotencyKeyRepository creates new findIdempotencyKeyRepository with its dependencies
+func NewFindIdempotencyKeyRepository(db *sql.DB) domain.IdempotencyKeyFinder {
+	return findIdempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// FindByKey performs select into the database for the key of the tenant
+func (f findIdempotencyKeyRepository) FindByKey(ctx context.Context, key string) (domain.IdempotencyKey, error) {
+	ctx, span := startSpan(ctx, "FindIdempotencyKey", "")
+	defer span.End()
+
+	var (
+		id          string
+		fingerprint string
+		statusCode  int
+		body        []byte
+		createdAt   time.Time
+	)
+
+	err := traced(f.db).QueryRowContext(
+		ctx,
+		`SELECT id, fingerprint, status_code, response_body, created_at FROM idempotency_keys WHERE tenant_id = $1 AND id = $2`,
+		domain.TenantFrom(ctx),
+		key,
+	).Scan(&id, &fingerprint, &statusCode, &body, &createdAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.IdempotencyKey{}, domain.ErrIdempotencyKeyNotFound
+	default:
+		return domain.NewIdempotencyKey(id, fingerprint, statusCode, body, createdAt).WithTenant(domain.TenantFrom(ctx)),
+			errors.Wrap(err, errUnknown.Error())
+	}
+}
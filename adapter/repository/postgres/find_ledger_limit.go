@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findLedgerLimitRepository struct {
+	db *sql.DB
+}
+
+// NewFindLedgerLimitRepository creates new findLedgerLimitRepository with its dependencies
+func NewFindLedgerLimitRepository(db *sql.DB) domain.LedgerLimitFinder {
+	return findLedgerLimitRepository{
+		db: db,
+	}
+}
+
+// FindLimitBefore performs select into the database summing the postings of the customer ledger account
+// recorded in journal entries created before the moment
+func (f findLedgerLimitRepository) FindLimitBefore(ctx context.Context, accountID string, before time.Time) (int64, error) {
+	ctx, span := startSpan(ctx, "FindLedgerLimit", accountID)
+	defer span.End()
+
+	var limit int64
+	if err := executorFrom(ctx, f.db).QueryRowContext(
+		ctx,
+		`SELECT COALESCE(SUM(p.amount), 0)
+		FROM postings p
+		JOIN journal_entries j ON j.id = p.journal_entry_id
+		JOIN accounts a ON a.id = j.account_id
+		WHERE p.ledger_account = $1 AND j.account_id = $2 AND j.created_at < $3 AND ($4 OR a.tenant_id = $5)`,
+		domain.CustomerLedgerAccount(accountID),
+		accountID,
+		before,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	).Scan(&limit); err != nil {
+		return 0, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return limit, nil
+}
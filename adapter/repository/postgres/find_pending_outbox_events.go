@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findPendingOutboxEventsRepository struct {
+	db *sql.DB
+}
+
+// NewFindPendingOutboxEventsRepository creates new findPendingOutboxEventsRepository with its dependencies
+func NewFindPendingOutboxEventsRepository(db *sql.DB) domain.OutboxFinder {
+	return findPendingOutboxEventsRepository{
+		db: db,
+	}
+}
+
+// FindPending performs select into the database, locking the oldest events not yet published. The events of
+// an account are written while its row is locked, so their sequence follows the order in which they committed.
+// The accounts with an event postponed past now are left out, so that their events don't fill the batch
+func (f findPendingOutboxEventsRepository) FindPending(ctx context.Context, now time.Time, limit int) ([]domain.Event, error) {
+	ctx, span := startSpan(ctx, "FindPendingOutboxEvents", "")
+	defer span.End()
+
+	rows, err := executorFrom(ctx, f.db).QueryContext(
+		ctx,
+		`SELECT id, type, account_id, tenant_id, payload, occurred_at
+			FROM outbox_events
+			WHERE published_at IS NULL AND account_id NOT IN (
+				SELECT account_id FROM outbox_events WHERE published_at IS NULL AND next_attempt_at > $1
+			)
+			ORDER BY seq LIMIT $2 FOR UPDATE`,
+		now,
+		limit,
+	)
+	if err != nil {
+		return []domain.Event{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var events = make([]domain.Event, 0)
+	for rows.Next() {
+		var (
+			id         string
+			eventType  string
+			accountID  string
+			tenantID   string
+			payload    string
+			occurredAt time.Time
+		)
+
+		if err = rows.Scan(&id, &eventType, &accountID, &tenantID, &payload, &occurredAt); err != nil {
+			return []domain.Event{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		events = append(events, domain.NewEvent(id, eventType, accountID, []byte(payload), occurredAt).WithTenant(tenantID))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Event{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return events, nil
+}
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type findTransactionsByAccountIDRepository struct {
+	db         *sql.DB
+	operations domain.OperationFinder
+}
+
+// NewFindTransactionsByAccountIDRepository creates new findTransactionsByAccountIDRepository with its dependencies
+func NewFindTransactionsByAccountIDRepository(db *sql.DB, operations domain.OperationFinder) domain.TransactionFinder {
+	return findTransactionsByAccountIDRepository{
+		db:         db,
+		operations: operations,
+	}
+}
+
+// FindByAccountID performs select into the database, newest transactions first
+func (f findTransactionsByAccountIDRepository) FindByAccountID(
+	ctx context.Context,
+	filter domain.TransactionFilter,
+) ([]domain.Transaction, error) {
+	ctx, span := startSpan(ctx, "FindTransactionsByAccountID", filter.AccountID)
+	defer span.End()
+
+	var (
+		conditions = []string{"account_id = $1"}
+		args       = []interface{}{filter.AccountID}
+	)
+
+	if !domain.AllTenants(ctx) {
+		args = append(args, domain.TenantFrom(ctx))
+		conditions = append(conditions, "tenant_id = "+placeholder(len(args)))
+	}
+
+	if filter.OperationID != "" {
+		args = append(args, filter.OperationID)
+		conditions = append(conditions, "operation_id = "+placeholder(len(args)))
+	}
+
+	switch filter.Type {
+	case domain.Debit:
+		conditions = append(conditions, "amount < 0")
+	case domain.Credit:
+		conditions = append(conditions, "amount > 0")
+	}
+
+	if filter.MinAmount > 0 {
+		args = append(args, filter.MinAmount)
+		conditions = append(conditions, "ABS(amount) >= "+placeholder(len(args)))
+	}
+
+	if filter.MaxAmount > 0 {
+		args = append(args, filter.MaxAmount)
+		conditions = append(conditions, "ABS(amount) <= "+placeholder(len(args)))
+	}
+
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		conditions = append(conditions, "created_at >= "+placeholder(len(args)))
+	}
+
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		conditions = append(conditions, "created_at <= "+placeholder(len(args)))
+	}
+
+	if !filter.After.IsZero() {
+		args = append(args, filter.After.CreatedAt(), filter.After.ID())
+		conditions = append(conditions, fmt.Sprintf(
+			"(created_at < %[1]s OR (created_at = %[1]s AND id < %[2]s))",
+			placeholder(len(args)-1),
+			placeholder(len(args)),
+		))
+	}
+
+	args = append(args, filter.Limit)
+
+	rows, err := executorFrom(ctx, f.db).QueryContext(
+		ctx,
+		`SELECT id, tenant_id, account_id, operation_id, amount, balance, created_at FROM transactions WHERE `+
+			strings.Join(conditions, " AND ")+
+			` ORDER BY created_at DESC, id DESC LIMIT `+placeholder(len(args)),
+		args...,
+	)
+	if err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var transactions = make([]domain.Transaction, 0)
+	for rows.Next() {
+		var (
+			id          string
+			tenantID    string
+			accID       string
+			operationID string
+			amount      int64
+			balance     int64
+			createdAt   time.Time
+		)
+
+		if err = rows.Scan(&id, &tenantID, &accID, &operationID, &amount, &balance, &createdAt); err != nil {
+			return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		op, err := f.operations.FindByID(ctx, operationID)
+		if err != nil {
+			return []domain.Transaction{}, err
+		}
+
+		transactions = append(transactions, domain.NewTransaction(
+			id,
+			accID,
+			op,
+			absAmount(amount),
+			balance,
+			createdAt,
+		).WithTenant(tenantID))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return f.withInstallmentPlans(ctx, transactions)
+}
+
+// withInstallmentPlans loads the installments of the COMPRA PARCELADA transactions
+func (f findTransactionsByAccountIDRepository) withInstallmentPlans(
+	ctx context.Context,
+	transactions []domain.Transaction,
+) ([]domain.Transaction, error) {
+	var (
+		placeholders []string
+		args         []interface{}
+	)
+
+	for _, transaction := range transactions {
+		if transaction.Operation().ID() == domain.CompraParcelada {
+			args = append(args, transaction.ID())
+			placeholders = append(placeholders, placeholder(len(args)))
+		}
+	}
+
+	if len(args) == 0 {
+		return transactions, nil
+	}
+
+	rows, err := executorFrom(ctx, f.db).QueryContext(
+		ctx,
+		`SELECT transaction_id, number, amount, due_date FROM installments WHERE transaction_id IN (`+
+			strings.Join(placeholders, ", ")+
+			`) ORDER BY transaction_id, number`,
+		args...,
+	)
+	if err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var installments = make(map[string][]domain.Installment)
+	for rows.Next() {
+		var (
+			transactionID string
+			number        int
+			amount        int64
+			dueDate       time.Time
+		)
+
+		if err = rows.Scan(&transactionID, &number, &amount, &dueDate); err != nil {
+			return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		installments[transactionID] = append(installments[transactionID], domain.NewInstallment(number, amount, dueDate))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Transaction{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	for i, transaction := range transactions {
+		if plan, ok := installments[transaction.ID()]; ok {
+			transactions[i] = transaction.WithInstallmentPlan(domain.NewInstallmentPlan(plan))
+		}
+	}
+
+	return transactions, nil
+}
+
+// placeholder returns the positional parameter of the n-th argument of the query
+func placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
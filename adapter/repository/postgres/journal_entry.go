@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+// createJournalEntry records the entry and its postings in the ledger. It must run on the
+// executor of the insert that caused the entry, so that both commit or roll back together
+func createJournalEntry(ctx context.Context, db executor, entry domain.JournalEntry) error {
+	if !entry.Balanced() {
+		return domain.ErrJournalEntryUnbalanced
+	}
+
+	if _, err := db.ExecContext(
+		ctx,
+		`INSERT INTO journal_entries (id, account_id, transaction_id, description, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		entry.ID(),
+		entry.AccountID(),
+		sql.NullString{
+			String: entry.TransactionID(),
+			Valid:  entry.TransactionID() != "",
+		},
+		entry.Description(),
+		entry.CreatedAt(),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	for _, posting := range entry.Postings() {
+		if _, err := db.ExecContext(
+			ctx,
+			`INSERT INTO postings (journal_entry_id, ledger_account, amount) VALUES ($1, $2, $3)`,
+			entry.ID(),
+			posting.LedgerAccount(),
+			posting.Amount(),
+		); err != nil {
+			return errors.Wrap(err, errUnknown.Error())
+		}
+	}
+
+	return nil
+}
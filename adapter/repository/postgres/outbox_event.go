@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+// createOutboxEvent records the event in the outbox. It must run on the executor of the insert
+// that caused the event, so that the event is published if and only if the change commits
+func createOutboxEvent(ctx context.Context, db executor, event domain.Event) error {
+	if _, err := db.ExecContext(
+		ctx,
+		`INSERT INTO outbox_events (id, type, account_id, tenant_id, payload, occurred_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+		event.ID(),
+		event.Type(),
+		event.AccountID(),
+		event.TenantID(),
+		string(event.Payload()),
+		event.OccurredAt(),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
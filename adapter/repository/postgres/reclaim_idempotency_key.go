@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type reclaimIdempotencyKeyRepository struct {
+	db *sql.DB
+}
+
+// NewReclaimIdempotencyKeyRepository creates new reclaimIdempotencyKeyRepository with its dependencies
+func NewReclaimIdempotencyKeyRepository(db *sql.DB) domain.IdempotencyKeyReclaimer {
+	return reclaimIdempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// Reclaim performs update into the database, renewing the key only while it is in progress since before
+// staleBefore, so that a single request takes it over
+func (r reclaimIdempotencyKeyRepository) Reclaim(ctx context.Context, key domain.IdempotencyKey, staleBefore time.Time) error {
+	ctx, span := startSpan(ctx, "ReclaimIdempotencyKey", "")
+	defer span.End()
+
+	result, err := traced(r.db).ExecContext(
+		ctx,
+		`UPDATE idempotency_keys SET created_at = $1
+			WHERE tenant_id = $2 AND id = $3 AND fingerprint = $4 AND status_code = 0 AND created_at < $5`,
+		key.CreatedAt(),
+		key.TenantID(),
+		key.Key(),
+		key.Fingerprint(),
+		staleBefore,
+	)
+	if err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	reclaimed, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	if reclaimed == 0 {
+		return domain.ErrIdempotencyKeyInProgress
+	}
+
+	return nil
+}
@@ -0,0 +1,709 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/adapter/presenter"
+	"github.com/GSabadini/go-transactions/adapter/repository/memory"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/migration"
+	"github.com/GSabadini/go-transactions/usecase"
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	_ "github.com/lib/pq"
+)
+
+// The integration tests run against the database of POSTGRES_DSN or, when it is not set, against an
+// embedded Postgres started for the test run. Run them with: go test -tags integration ./adapter/repository/postgres/
+const embeddedPort = 54329
+
+var testDB *sql.DB
+
+func TestMain(m *testing.M) {
+	dsn := os.Getenv("POSTGRES_DSN")
+	if dsn == "" {
+		pg := embeddedpostgres.NewDatabase(embeddedpostgres.DefaultConfig().Port(embeddedPort))
+		if err := pg.Start(); err != nil {
+			log.Fatal(err)
+		}
+
+		dsn = "host=localhost port=54329 user=postgres password=postgres dbname=postgres sslmode=disable"
+		code := run(m, dsn)
+		_ = pg.Stop()
+		os.Exit(code)
+	}
+
+	os.Exit(run(m, dsn))
+}
+
+func run(m *testing.M, dsn string) int {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err = db.Exec(`DROP SCHEMA public CASCADE; CREATE SCHEMA public;`); err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err = migration.NewMigrator(db, migration.Postgres).Up(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+
+	testDB = db
+	return m.Run()
+}
+
+func assertNoLeak(t *testing.T, name string) {
+	t.Helper()
+
+	if got := testDB.Stats().InUse; got != 0 {
+		t.Errorf("[TestCase '%s'] Got connections in use: '%v' | Want: '%v'", name, got, 0)
+	}
+}
+
+func TestAccountRepositories(t *testing.T) {
+	var (
+		ctx     = domain.WithAllTenants(context.Background())
+		account = domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a01", "11111111111", 1000, time.Now().UTC())
+	)
+
+	if _, err := NewCreateAccountRepository(testDB).Create(ctx, account); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create account", err)
+	}
+
+	duplicated := domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a02", "11111111111", 1000, time.Now().UTC())
+	if _, err := NewCreateAccountRepository(testDB).Create(ctx, duplicated); err != domain.ErrAccountAlreadyExists {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Duplicated document", err, domain.ErrAccountAlreadyExists)
+	}
+
+	if err := NewUpdateAccountCreditLimitRepository(testDB).UpdateCreditLimit(ctx, account.ID(), 500); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Update credit limit", err)
+	}
+
+	got, err := NewAccountByIDRepository(testDB).FindByID(ctx, account.ID())
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Find account", err)
+	}
+
+	if got.AvailableCreditLimit() != 500 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Find account", got.AvailableCreditLimit(), 500)
+	}
+
+	if _, err = NewAccountByIDRepository(testDB).FindByID(ctx, "unknown"); err != domain.ErrAccountNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Unknown account", err, domain.ErrAccountNotFound)
+	}
+
+	assertNoLeak(t, "Account repositories")
+}
+
+func TestAccountRepositories_Tenant(t *testing.T) {
+	var (
+		tenantA = domain.WithTenant(context.Background(), "tenant-a")
+		tenantB = domain.WithTenant(context.Background(), "tenant-b")
+		account = domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a06", "55555555555", 1000, time.Now().UTC()).WithTenant("tenant-a")
+	)
+
+	if _, err := NewCreateAccountRepository(testDB).Create(tenantA, account); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create account", err)
+	}
+
+	sameDocument := domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a07", "55555555555", 1000, time.Now().UTC()).WithTenant("tenant-b")
+	if _, err := NewCreateAccountRepository(testDB).Create(tenantB, sameDocument); err != nil {
+		t.Errorf("[TestCase '%s'] Err: '%v'", "Same document in another tenant", err)
+	}
+
+	if _, err := NewAccountByIDRepository(testDB).FindByID(tenantB, account.ID()); err != domain.ErrAccountNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Find account of another tenant", err, domain.ErrAccountNotFound)
+	}
+
+	if _, err := NewAccountByIDRepository(testDB).FindByID(context.Background(), account.ID()); err != domain.ErrAccountNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Find account without tenant", err, domain.ErrAccountNotFound)
+	}
+
+	if err := NewUpdateAccountCreditLimitRepository(testDB).UpdateCreditLimit(tenantB, account.ID(), 0); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Update credit limit of another tenant", err)
+	}
+
+	compraAVista, _ := domain.NewOperation(domain.CompraAVista)
+	transaction := domain.NewTransaction("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0b06", account.ID(), compraAVista, 100, -100, time.Now().UTC()).
+		WithTenant(account.TenantID())
+	if _, err := NewCreateTransactionRepository(testDB).Create(tenantB, transaction); err != domain.ErrAccountNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Create transaction of another tenant", err, domain.ErrAccountNotFound)
+	}
+
+	got, err := NewAccountByIDRepository(testDB).FindByID(tenantA, account.ID())
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Find account of the tenant", err)
+	}
+
+	if got.TenantID() != "tenant-a" || got.AvailableCreditLimit() != 1000 {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%v'", "Find account of the tenant", got, "tenant-a with limit 1000")
+	}
+
+	assertNoLeak(t, "Account repositories tenant")
+}
+
+func TestWebhookRepositories_Tenant(t *testing.T) {
+	var (
+		now      = time.Now().UTC()
+		tenantA  = domain.WithTenant(context.Background(), "tenant-a")
+		tenantB  = domain.WithTenant(context.Background(), "tenant-b")
+		webhooks = NewWebhookRepository(testDB)
+		webhook  = domain.NewWebhook(
+			"b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0c06",
+			"",
+			"https://partner.example.com",
+			"secret",
+			[]string{domain.EventTransactionCreated},
+			true,
+			now,
+		).WithTenant("tenant-a")
+		event = domain.NewEvent("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0d06", domain.EventTransactionCreated, "1", []byte(`{}`), now)
+	)
+
+	if _, err := webhooks.Create(tenantA, webhook); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create webhook", err)
+	}
+
+	delivery := domain.ScheduleWebhookDelivery("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0e06", webhook, event.WithTenant("tenant-a"), now)
+	if err := NewWebhookDeliveryRepository(testDB).Create(tenantA, delivery); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create delivery", err)
+	}
+
+	if _, err := webhooks.FindByID(tenantB, webhook.ID()); err != domain.ErrWebhookNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Find webhook of another tenant", err, domain.ErrWebhookNotFound)
+	}
+
+	if err := webhooks.Delete(tenantB, webhook.ID()); err != domain.ErrWebhookNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Delete webhook of another tenant", err, domain.ErrWebhookNotFound)
+	}
+
+	if found, err := webhooks.FindAll(tenantB, ""); err != nil || len(found) != 0 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Err: '%v'", "List webhooks of another tenant", found, err)
+	}
+
+	if found, err := NewWebhookDeliveryRepository(testDB).FindByWebhookID(tenantB, webhook.ID(), 10); err != nil || len(found) != 0 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Err: '%v'", "List deliveries of another tenant", found, err)
+	}
+
+	if found, err := webhooks.FindSubscribers(context.Background(), event.WithTenant("tenant-b")); err != nil || len(found) != 0 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Err: '%v'", "Subscribers of an event of another tenant", found, err)
+	}
+
+	found, err := webhooks.FindSubscribers(context.Background(), event.WithTenant("tenant-a"))
+	if err != nil || len(found) != 1 || found[0].TenantID() != "tenant-a" {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Err: '%v'", "Subscribers of an event of the tenant", found, err)
+	}
+
+	assertNoLeak(t, "Webhook repositories tenant")
+}
+
+func TestTransactionRepositories(t *testing.T) {
+	var (
+		ctx                = domain.WithAllTenants(context.Background())
+		uow                = NewUnitOfWork(testDB, sql.TxOptions{Isolation: sql.LevelReadCommitted})
+		operations         = memory.NewCachedOperationRepository(NewOperationRepository(testDB), time.Minute, time.Second)
+		compraParcelada, _ = domain.NewOperation(domain.CompraParcelada)
+		pagamento, _       = domain.NewOperation(domain.Pagamento)
+		now                = time.Now().UTC().Truncate(time.Second)
+		account            = domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a03", "22222222222", 1000, now)
+	)
+
+	if _, err := NewCreateAccountRepository(testDB).Create(ctx, account); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create account", err)
+	}
+
+	purchase := domain.NewTransaction("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0b01", account.ID(), compraParcelada, 300, -300, now)
+	if err := purchase.SplitInstallments(3, domain.RemainderOnFirst); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Split installments", err)
+	}
+
+	err := uow.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := NewLockAccountByIDRepository(testDB).LockByID(ctx, account.ID()); err != nil {
+			return err
+		}
+
+		_, err := NewCreateTransactionRepository(testDB).Create(ctx, purchase)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create purchase", err)
+	}
+
+	errRollback := errors.New("rollback")
+	err = uow.WithTransaction(ctx, func(ctx context.Context) error {
+		payment := domain.NewTransaction("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0b02", account.ID(), pagamento, 100, 0, now.Add(time.Second))
+		if _, err := NewCreateTransactionRepository(testDB).Create(ctx, payment); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if err != errRollback {
+		t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Rolled back payment", err, errRollback)
+	}
+
+	transactions, err := NewFindTransactionsByAccountIDRepository(testDB, operations).FindByAccountID(ctx, domain.TransactionFilter{
+		AccountID: account.ID(),
+		Type:      domain.Debit,
+		MinAmount: 100,
+		Limit:     10,
+	})
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Find transactions", err)
+	}
+
+	if len(transactions) != 1 || len(transactions[0].InstallmentPlan().Installments()) != 3 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Find transactions", transactions, "the purchase with 3 installments")
+	}
+
+	if err = NewUpdateTransactionBalanceRepository(testDB).UpdateBalance(ctx, purchase.ID(), -200); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Update balance", err)
+	}
+
+	open, err := NewFindOpenTransactionsRepository(testDB, operations).FindOpenByAccountID(ctx, account.ID())
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Find open transactions", err)
+	}
+
+	if len(open) != 1 || open[0].Balance() != -200 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Find open transactions", open, "the purchase with balance -200")
+	}
+
+	reversed, err := NewFindReversedAmountRepository(testDB).FindReversedAmount(ctx, purchase.ID())
+	if err != nil || reversed != 0 {
+		t.Errorf("[TestCase '%s'] Got: '%v' err: '%v' | Want: '%v'", "Find reversed amount", reversed, err, 0)
+	}
+
+	assertNoLeak(t, "Transaction repositories")
+}
+
+func TestCashInRepository_Duplicated(t *testing.T) {
+	var (
+		ctx         = domain.WithAllTenants(context.Background())
+		deposito, _ = domain.NewOperation(domain.Deposito)
+		source, _   = domain.NewCashInSource(domain.CashInSourcePix, "E1234567820261018120012345678901")
+		now         = time.Now().UTC()
+		account     = domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a04", "33333333333", 1000, now)
+	)
+
+	if _, err := NewCreateAccountRepository(testDB).Create(ctx, account); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create account", err)
+	}
+
+	for i, ID := range []string{"b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0c01", "b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0c02"} {
+		transaction := domain.NewTransaction(ID, account.ID(), deposito, 100, 100, now)
+		if _, err := NewCreateTransactionRepository(testDB).Create(ctx, transaction); err != nil {
+			t.Fatalf("[TestCase '%s'] Err: '%v'", "Create deposit", err)
+		}
+
+		cashIn := domain.NewCashIn(ID, &account, transaction.ID(), source, 100, now)
+		_, err := NewCreateCashInRepository(testDB).Create(ctx, cashIn.WithTransaction(transaction))
+		if i == 0 && err != nil {
+			t.Fatalf("[TestCase '%s'] Err: '%v'", "Create cash-in", err)
+		}
+
+		if i == 1 && err != domain.ErrCashInAlreadyProcessed {
+			t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Duplicated source", err, domain.ErrCashInAlreadyProcessed)
+		}
+	}
+
+	other := domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a10", "77777777777", 1000, now).WithTenant("tenant-b")
+	if _, err := NewCreateAccountRepository(testDB).Create(ctx, other); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create account of another tenant", err)
+	}
+
+	transaction := domain.NewTransaction("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0c03", other.ID(), deposito, 100, 100, now).
+		WithTenant(other.TenantID())
+	if _, err := NewCreateTransactionRepository(testDB).Create(ctx, transaction); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create deposit of another tenant", err)
+	}
+
+	cashIn := domain.NewCashIn(transaction.ID(), &other, transaction.ID(), source, 100, now)
+	if _, err := NewCreateCashInRepository(testDB).Create(ctx, cashIn.WithTransaction(transaction)); err != nil {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Same source in another tenant", err, nil)
+	}
+
+	assertNoLeak(t, "Cash-in repository")
+}
+
+func TestAuthorizationRepositories(t *testing.T) {
+	var (
+		ctx             = domain.WithAllTenants(context.Background())
+		operations      = memory.NewCachedOperationRepository(NewOperationRepository(testDB), time.Minute, time.Second)
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Now().UTC().Truncate(time.Second)
+		account         = domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a05", "44444444444", 1000, now)
+		authorization   = domain.NewAuthorization(
+			"b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0d01",
+			account.ID(),
+			compraAVista,
+			100,
+			0,
+			domain.AuthorizationPending,
+			"",
+			now.Add(-time.Minute),
+			now.Add(-time.Hour),
+		)
+	)
+
+	if _, err := NewCreateAccountRepository(testDB).Create(ctx, account); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create account", err)
+	}
+
+	if _, err := NewCreateAuthorizationRepository(testDB).Create(ctx, authorization); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create authorization", err)
+	}
+
+	err := NewUnitOfWork(testDB, sql.TxOptions{}).WithTransaction(ctx, func(ctx context.Context) error {
+		expired, err := NewFindExpiredAuthorizationsRepository(testDB, operations).FindExpired(ctx, now, 10)
+		if err != nil {
+			return err
+		}
+
+		if len(expired) != 1 || expired[0].ID() != authorization.ID() {
+			t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Find expired", expired, authorization.ID())
+		}
+
+		return NewUpdateAuthorizationRepository(testDB).Update(ctx, expired[0])
+	})
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Find expired", err)
+	}
+
+	got, err := NewFindAuthorizationByIDRepository(testDB, operations).FindByID(ctx, authorization.ID())
+	if err != nil || got.Status() != domain.AuthorizationPending {
+		t.Errorf("[TestCase '%s'] Got: '%v' err: '%v' | Want: '%v'", "Find authorization", got.Status(), err, domain.AuthorizationPending)
+	}
+
+	otherTenant := domain.WithTenant(context.Background(), "tenant-b")
+	if _, err = NewFindAuthorizationByIDRepository(testDB, operations).FindByID(otherTenant, authorization.ID()); err != domain.ErrAuthorizationNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Authorization of another tenant", err, domain.ErrAuthorizationNotFound)
+	}
+
+	assertNoLeak(t, "Authorization repositories")
+}
+
+func TestIdempotencyKeyRepositories(t *testing.T) {
+	var (
+		ctx = domain.WithAllTenants(context.Background())
+		key = domain.NewIdempotencyKey("integration-key", "fingerprint", 0, nil, time.Now().UTC())
+	)
+
+	if _, err := NewCreateIdempotencyKeyRepository(testDB).Create(ctx, key); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create key", err)
+	}
+
+	if _, err := NewCreateIdempotencyKeyRepository(testDB).Create(ctx, key); err != domain.ErrIdempotencyKeyAlreadyExists {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Duplicated key", err, domain.ErrIdempotencyKeyAlreadyExists)
+	}
+
+	completed := domain.NewIdempotencyKey(key.Key(), key.Fingerprint(), 201, []byte(`{"id":"1"}`), key.CreatedAt())
+	if err := NewCompleteIdempotencyKeyRepository(testDB).Complete(ctx, completed); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Complete key", err)
+	}
+
+	got, err := NewFindIdempotencyKeyRepository(testDB).FindByKey(ctx, key.Key())
+	if err != nil || got.StatusCode() != 201 {
+		t.Errorf("[TestCase '%s'] Got: '%v' err: '%v' | Want: '%v'", "Find key", got.StatusCode(), err, 201)
+	}
+
+	if err = NewDeleteIdempotencyKeyRepository(testDB).Delete(ctx, key.Key()); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Delete key", err)
+	}
+
+	if _, err = NewFindIdempotencyKeyRepository(testDB).FindByKey(ctx, key.Key()); err != domain.ErrIdempotencyKeyNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Deleted key", err, domain.ErrIdempotencyKeyNotFound)
+	}
+
+	assertNoLeak(t, "Idempotency key repositories")
+}
+
+func TestAPIKeyRepository(t *testing.T) {
+	var (
+		ctx    = domain.WithTenant(context.Background(), "tenant-a")
+		repo   = NewAPIKeyRepository(testDB)
+		scopes = []string{domain.ScopeAccountsRead, domain.ScopeTransactionsWrite}
+	)
+
+	apiKey, err := domain.IssueAPIKey("c3d1f6a2-7b8e-4f0a-9c1d-2e3f4a5b6c01", "tenant-a", "integration", "gtk_integration", scopes, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Issue API key", err)
+	}
+
+	if _, err = repo.Create(ctx, apiKey); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create API key", err)
+	}
+
+	got, err := repo.FindByHash(ctx, domain.HashAPIKey("gtk_integration"))
+	if err != nil || got.ID() != apiKey.ID() || !reflect.DeepEqual(got.Scopes(), scopes) || got.Revoked() {
+		t.Errorf("[TestCase '%s'] Got: '%+v' err: '%v'", "Find API key by hash", got, err)
+	}
+
+	got.Revoke(time.Now().UTC())
+	if err = repo.Update(ctx, got); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Revoke API key", err)
+	}
+
+	if got, err = repo.FindByID(ctx, apiKey.ID()); err != nil || !got.Revoked() {
+		t.Errorf("[TestCase '%s'] Got revoked: '%v' err: '%v'", "Find revoked API key", got.Revoked(), err)
+	}
+
+	if _, err = repo.FindByHash(ctx, domain.HashAPIKey("gtk_unknown")); err != domain.ErrAPIKeyNotFound {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Unknown API key", err, domain.ErrAPIKeyNotFound)
+	}
+
+	assertNoLeak(t, "API key repository")
+}
+
+func TestFindLedgerBalancesRepository(t *testing.T) {
+	var (
+		ctx             = domain.WithAllTenants(context.Background())
+		uow             = NewUnitOfWork(testDB, sql.TxOptions{Isolation: sql.LevelReadCommitted})
+		compraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		now             = time.Now().UTC().Truncate(time.Second)
+		account         = domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a09", "99999999999", 1000, now)
+	)
+
+	drift := func(name string) int64 {
+		balances, err := NewFindLedgerBalancesRepository(testDB).FindAccountBalances(ctx)
+		if err != nil {
+			t.Fatalf("[TestCase '%s'] Err: '%v'", name, err)
+		}
+
+		for _, balance := range balances {
+			if balance.AccountID() == account.ID() {
+				return balance.Drift()
+			}
+		}
+
+		t.Fatalf("[TestCase '%s'] Got: '%v' | Want: '%v'", name, balances, account.ID())
+		return 0
+	}
+
+	err := uow.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := NewCreateAccountRepository(testDB).Create(ctx, account); err != nil {
+			return err
+		}
+
+		if err := NewUpdateAccountCreditLimitRepository(testDB).UpdateCreditLimit(ctx, account.ID(), 900); err != nil {
+			return err
+		}
+
+		_, err := NewCreateTransactionRepository(testDB).Create(
+			ctx,
+			domain.NewTransaction("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0b09", account.ID(), compraAVista, 100, -100, now),
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Debit with journal entry", err)
+	}
+
+	if got := drift("Debit with journal entry"); got != 0 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Debit with journal entry", got, 0)
+	}
+
+	if err = NewUpdateAccountCreditLimitRepository(testDB).UpdateCreditLimit(ctx, account.ID(), 950); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Limit changed without a transaction", err)
+	}
+
+	if got := drift("Limit changed without a transaction"); got != 50 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Limit changed without a transaction", got, 50)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		before time.Time
+		want   int64
+	}{
+		{name: "Limit before the account was opened", before: now, want: 0},
+		{name: "Limit after the debit", before: now.Add(time.Second), want: 900},
+	} {
+		got, err := NewFindLedgerLimitRepository(testDB).FindLimitBefore(ctx, account.ID(), tt.before)
+		if err != nil || got != tt.want {
+			t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v' | Err: '%v'", tt.name, got, tt.want, err)
+		}
+	}
+
+	assertNoLeak(t, "Ledger repositories")
+}
+
+// TestCreateTransaction_ConcurrentDebits fires more debits at once than the limit of the account covers,
+// through the use case and the repositories of the service, so that only the row lock of the account
+// keeps them from spending the same limit
+func TestCreateTransaction_ConcurrentDebits(t *testing.T) {
+	const (
+		debits = 50
+		amount = 30
+		limit  = 1000
+	)
+
+	var (
+		ctx        = domain.WithAllTenants(context.Background())
+		operations = memory.NewCachedOperationRepository(NewOperationRepository(testDB), time.Minute, time.Second)
+		account    = domain.NewStoredAccount("b9c5e9a4-2a9f-4bc4-a5a1-6d3a7e6f0a08", "66666666666", limit, time.Now().UTC())
+		uc         = usecase.NewCreateTransactionInteractor(
+			NewUnitOfWork(testDB, sql.TxOptions{Isolation: sql.LevelReadCommitted}),
+			NewCreateTransactionRepository(testDB),
+			NewFindOpenTransactionsRepository(testDB, operations),
+			NewUpdateTransactionBalanceRepository(testDB),
+			NewLockAccountByIDRepository(testDB),
+			NewUpdateAccountCreditLimitRepository(testDB),
+			operations,
+			NewCreateOutboxEventRepository(testDB),
+			presenter.NewCreateTransactionPresenter(),
+			usecase.NewNoopTransactionMetrics(),
+			30*time.Second,
+		)
+	)
+
+	if _, err := NewCreateAccountRepository(testDB).Create(ctx, account); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create account", err)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		start   = make(chan struct{})
+		results = make(chan error, debits)
+	)
+
+	for i := 0; i < debits; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+
+			_, err := uc.Execute(ctx, usecase.CreateTransactionInput{
+				AccountID:   account.ID(),
+				OperationID: domain.CompraAVista,
+				Amount:      amount,
+			})
+			results <- err
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+	close(results)
+
+	var created, declined int64
+	for err := range results {
+		switch {
+		case err == nil:
+			created++
+		case errors.Is(err, domain.ErrAccountInsufficientCreditLimit):
+			declined++
+		default:
+			t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Concurrent debit", err, domain.ErrAccountInsufficientCreditLimit)
+		}
+	}
+
+	got, err := NewAccountByIDRepository(testDB).FindByID(ctx, account.ID())
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Find account", err)
+	}
+
+	if got.AvailableCreditLimit() < 0 || got.AvailableCreditLimit() != limit-created*amount {
+		t.Errorf(
+			"[TestCase '%s'] Got limit: '%v' | Want: '%v'",
+			"Final limit",
+			got.AvailableCreditLimit(),
+			limit-created*amount,
+		)
+	}
+
+	if created != limit/amount || declined != debits-limit/amount {
+		t.Errorf(
+			"[TestCase '%s'] Got created, declined: '%v', '%v' | Want: '%v', '%v'",
+			"Debits covered by the limit",
+			created,
+			declined,
+			limit/amount,
+			debits-limit/amount,
+		)
+	}
+
+	var spent int64
+	if err = testDB.QueryRow(`SELECT COALESCE(-SUM(amount), 0) FROM transactions WHERE account_id = $1`, account.ID()).
+		Scan(&spent); err != nil || spent != created*amount {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v' | Err: '%v'", "Amount of the transactions", spent, created*amount, err)
+	}
+
+	assertNoLeak(t, "Concurrent debits")
+}
+
+func TestOutboxRepositories_Postpone(t *testing.T) {
+	var (
+		ctx     = domain.WithAllTenants(context.Background())
+		creator = NewCreateOutboxEventRepository(testDB)
+		finder  = NewFindPendingOutboxEventsRepository(testDB)
+		now     = time.Now().UTC().Truncate(time.Second)
+		blocked = "c7d1f3a2-5b6e-4c8d-9e0f-1a2b3c4d5e01"
+		other   = "c7d1f3a2-5b6e-4c8d-9e0f-1a2b3c4d5e02"
+	)
+
+	for _, event := range []domain.Event{
+		domain.NewEvent("c7d1f3a2-5b6e-4c8d-9e0f-1a2b3c4d5f01", domain.EventTransactionCreated, blocked, []byte(`{}`), now),
+		domain.NewEvent("c7d1f3a2-5b6e-4c8d-9e0f-1a2b3c4d5f02", domain.EventTransactionCreated, blocked, []byte(`{}`), now),
+		domain.NewEvent("c7d1f3a2-5b6e-4c8d-9e0f-1a2b3c4d5f03", domain.EventTransactionCreated, blocked, []byte(`{}`), now),
+		domain.NewEvent("c7d1f3a2-5b6e-4c8d-9e0f-1a2b3c4d5f04", domain.EventTransactionCreated, other, []byte(`{}`), now),
+	} {
+		if err := creator.Create(ctx, event.WithTenant("tenant-a")); err != nil {
+			t.Fatalf("[TestCase '%s'] Err: '%v'", "Create event", err)
+		}
+	}
+
+	if err := NewPostponeOutboxEventRepository(testDB).Postpone(ctx, "c7d1f3a2-5b6e-4c8d-9e0f-1a2b3c4d5f01", now.Add(time.Minute)); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Postpone event", err)
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want []string
+	}{
+		{
+			name: "Events of the postponed account are left out of the batch",
+			now:  now,
+			want: []string{other},
+		},
+		{
+			name: "Events of the account are found again once it is due",
+			now:  now.Add(time.Minute),
+			want: []string{blocked, blocked, blocked, other},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := finder.FindPending(ctx, tt.now, 1000)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			var got = make([]string, 0)
+			for _, event := range events {
+				if event.AccountID() == blocked || event.AccountID() == other {
+					got = append(got, event.AccountID())
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	assertNoLeak(t, "Outbox repositories postpone")
+}
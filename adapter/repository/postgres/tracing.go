@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName names the tracer of the repositories
+	instrumentationName = "github.com/GSabadini/go-transactions/adapter/repository/postgres"
+	// queryNameKey is the span attribute naming the query of the repository call
+	queryNameKey = attribute.Key("db.query.name")
+	// accountIDKey is the span attribute identifying the account the repository call concerns
+	accountIDKey = attribute.Key("account.id")
+)
+
+// tracedExecutor records the statements that fail on the span carried by their context
+type tracedExecutor struct {
+	executor
+}
+
+// startSpan starts the span of a repository call named by its query, carrying the account when it is known
+func startSpan(ctx context.Context, query string, accountID string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{semconv.DBSystemPostgreSQL, queryNameKey.String(query)}
+	if accountID != "" {
+		attrs = append(attrs, accountIDKey.String(accountID))
+	}
+
+	return otel.Tracer(instrumentationName).Start(
+		ctx,
+		"repository."+query,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// traced wraps the executor, recording the statements that fail on the span of the repository call
+func traced(e executor) executor {
+	return tracedExecutor{e}
+}
+
+func (t tracedExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	result, err := t.executor.ExecContext(ctx, query, args...)
+	recordError(ctx, err)
+	return result, err
+}
+
+func (t tracedExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := t.executor.QueryContext(ctx, query, args...)
+	recordError(ctx, err)
+	return rows, err
+}
+
+func (t tracedExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	row := t.executor.QueryRowContext(ctx, query, args...)
+	recordError(ctx, row.Err())
+	return row
+}
+
+func recordError(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
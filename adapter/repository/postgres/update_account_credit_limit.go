@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type updateAccountCreditLimitRepository struct {
+	db *sql.DB
+}
+
+func NewUpdateAccountCreditLimitRepository(db *sql.DB) domain.AccountUpdater {
+	return updateAccountCreditLimitRepository{
+		db: db,
+	}
+}
+
+func (u updateAccountCreditLimitRepository) UpdateCreditLimit(ctx context.Context, ID string, amount int64) error {
+	ctx, span := startSpan(ctx, "UpdateAccountCreditLimit", ID)
+	defer span.End()
+
+	db := executorFrom(ctx, u.db)
+
+	if _, err := db.ExecContext(
+		ctx,
+		`UPDATE accounts SET available_credit_limit = $1 WHERE id = $2 AND ($3 OR tenant_id = $4)`,
+		amount,
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
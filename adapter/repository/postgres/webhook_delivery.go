@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+// webhookDeliveryColumns are the columns read by scanWebhookDelivery, in order
+const webhookDeliveryColumns = `d.id, d.webhook_id, d.tenant_id, d.event_id, d.event_type, d.body, d.status, d.attempts,
+	d.last_error, d.next_attempt_at, d.delivered_at, d.created_at`
+
+type webhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookDeliveryRepository creates new webhookDeliveryRepository with its dependencies
+func NewWebhookDeliveryRepository(db *sql.DB) domain.WebhookDeliveryRepository {
+	return webhookDeliveryRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database, ignoring an event already scheduled to the webhook
+func (w webhookDeliveryRepository) Create(ctx context.Context, delivery domain.WebhookDelivery) error {
+	ctx, span := startSpan(ctx, "CreateWebhookDelivery", "")
+	defer span.End()
+
+	if _, err := executorFrom(ctx, w.db).ExecContext(
+		ctx,
+		`INSERT INTO webhook_deliveries
+			(id, webhook_id, tenant_id, event_id, event_type, body, status, attempts, last_error, next_attempt_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ON CONFLICT (webhook_id, event_id) DO NOTHING`,
+		delivery.ID(),
+		delivery.WebhookID(),
+		delivery.TenantID(),
+		delivery.EventID(),
+		delivery.EventType(),
+		string(delivery.Body()),
+		delivery.Status(),
+		delivery.Attempts(),
+		delivery.LastError(),
+		delivery.NextAttemptAt(),
+		delivery.CreatedAt(),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+// FindDue performs select into the database, locking the due deliveries of the active webhooks
+func (w webhookDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "FindDueWebhookDeliveries", "")
+	defer span.End()
+
+	return w.query(
+		ctx,
+		`SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries d
+			JOIN webhooks w ON w.id = d.webhook_id
+			WHERE d.status = $1 AND d.next_attempt_at <= $2 AND w.active = TRUE AND ($3 OR d.tenant_id = $4)
+			ORDER BY d.next_attempt_at, d.created_at LIMIT $5 FOR UPDATE OF d`,
+		domain.WebhookDeliveryPending,
+		now,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+		limit,
+	)
+}
+
+// FindByWebhookID performs select into the database, newest first
+func (w webhookDeliveryRepository) FindByWebhookID(ctx context.Context, webhookID string, limit int) ([]domain.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "FindWebhookDeliveries", "")
+	defer span.End()
+
+	return w.query(
+		ctx,
+		`SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries d
+			WHERE d.webhook_id = $1 AND ($2 OR d.tenant_id = $3) ORDER BY d.created_at DESC, d.id DESC LIMIT $4`,
+		webhookID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+		limit,
+	)
+}
+
+// Update performs update into the database
+func (w webhookDeliveryRepository) Update(ctx context.Context, delivery domain.WebhookDelivery) error {
+	ctx, span := startSpan(ctx, "UpdateWebhookDelivery", "")
+	defer span.End()
+
+	if _, err := executorFrom(ctx, w.db).ExecContext(
+		ctx,
+		`UPDATE webhook_deliveries
+			SET status = $1, attempts = $2, last_error = $3, next_attempt_at = $4, delivered_at = $5
+			WHERE id = $6 AND ($7 OR tenant_id = $8)`,
+		delivery.Status(),
+		delivery.Attempts(),
+		delivery.LastError(),
+		delivery.NextAttemptAt(),
+		sql.NullTime{
+			Time:  delivery.DeliveredAt(),
+			Valid: !delivery.DeliveredAt().IsZero(),
+		},
+		delivery.ID(),
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+func (w webhookDeliveryRepository) query(ctx context.Context, query string, args ...interface{}) ([]domain.WebhookDelivery, error) {
+	rows, err := executorFrom(ctx, w.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return []domain.WebhookDelivery{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var deliveries = make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return []domain.WebhookDelivery{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.WebhookDelivery{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return deliveries, nil
+}
+
+func scanWebhookDelivery(row scanner) (domain.WebhookDelivery, error) {
+	var (
+		id            string
+		webhookID     string
+		tenantID      string
+		eventID       string
+		eventType     string
+		body          string
+		status        string
+		attempts      int
+		lastError     string
+		nextAttemptAt time.Time
+		deliveredAt   sql.NullTime
+		createdAt     time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&webhookID,
+		&tenantID,
+		&eventID,
+		&eventType,
+		&body,
+		&status,
+		&attempts,
+		&lastError,
+		&nextAttemptAt,
+		&deliveredAt,
+		&createdAt,
+	); err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+
+	return domain.NewWebhookDelivery(
+		id,
+		webhookID,
+		eventID,
+		eventType,
+		[]byte(body),
+		status,
+		attempts,
+		lastError,
+		nextAttemptAt,
+		deliveredAt.Time,
+		createdAt,
+	).WithTenant(tenantID), nil
+}
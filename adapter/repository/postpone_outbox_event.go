@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type postponeOutboxEventRepository struct {
+	db *sql.DB
+}
+
+// NewPostponeOutboxEventRepository creates new postponeOutboxEventRepository with its dependencies
+func NewPostponeOutboxEventRepository(db *sql.DB) domain.OutboxPostponer {
+	return postponeOutboxEventRepository{
+		db: db,
+	}
+}
+
+// Postpone performs update into the database
+func (p postponeOutboxEventRepository) Postpone(ctx context.Context, ID string, until time.Time) error {
+	ctx, span := startSpan(ctx, "PostponeOutboxEvent", "")
+	defer span.End()
+
+	if _, err := executorFrom(ctx, p.db).ExecContext(
+		ctx,
+		`UPDATE outbox_events SET next_attempt_at = ? WHERE id = ?`,
+		until,
+		ID,
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
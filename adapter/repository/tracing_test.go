@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test_startSpan(t *testing.T) {
+	const accountID = "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8"
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+	}{
+		{
+			name:       "Span of the repository call",
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "Span of the repository call with a failed statement",
+			err:        errors.New("connection refused"),
+			wantStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				recorder  = tracetest.NewSpanRecorder()
+				previous  = otel.GetTracerProvider()
+				connector = &fakeConnector{err: tt.err}
+				db        = sql.OpenDB(connector)
+			)
+			defer db.Close()
+
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+			defer otel.SetTracerProvider(previous)
+
+			_ = NewUpdateAccountCreditLimitRepository(db).UpdateCreditLimit(context.Background(), accountID, 100)
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("[TestCase '%s'] Got spans: '%v' | Want: '%v'", tt.name, len(spans), 1)
+			}
+
+			var attrs = make(map[string]string)
+			for _, attr := range spans[0].Attributes() {
+				attrs[string(attr.Key)] = attr.Value.Emit()
+			}
+
+			want := map[string]string{
+				"db.system":     "mysql",
+				"db.query.name": "UpdateAccountCreditLimit",
+				"account.id":    accountID,
+			}
+			for key, value := range want {
+				if attrs[key] != value {
+					t.Errorf("[TestCase '%s'] Got '%s': '%v' | Want: '%v'", tt.name, key, attrs[key], value)
+				}
+			}
+
+			if got := spans[0].Status().Code; got != tt.wantStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want: '%v'", tt.name, got, tt.wantStatus)
+			}
+		})
+	}
+}
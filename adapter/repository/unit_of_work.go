@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxTxAttempts  = 3
+	txRetryBackoff = 50 * time.Millisecond
+)
+
+type (
+	// txKey is the context key under which the unit of work keeps its database transaction
+	txKey struct{}
+
+	// txState holds the database transaction of a unit of work and the depth of its nested savepoints
+	txState struct {
+		tx    *sql.Tx
+		depth int
+	}
+
+	// executor defines the statements shared by *sql.DB and *sql.Tx
+	executor interface {
+		ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+		QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+		QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+	}
+
+	unitOfWork struct {
+		db   *sql.DB
+		opts sql.TxOptions
+	}
+)
+
+// NewUnitOfWork creates new unitOfWork with its dependencies, beginning database transactions with opts
+func NewUnitOfWork(db *sql.DB, opts sql.TxOptions) domain.UnitOfWork {
+	return unitOfWork{
+		db:   db,
+		opts: opts,
+	}
+}
+
+// WithTransaction runs fn inside a database transaction, committing on success and rolling back on error.
+// When the transaction is aborted by a deadlock or a lock wait timeout, fn runs again from scratch.
+// When ctx already carries a transaction, fn runs inside a savepoint of it instead, which is rolled back
+// on error without aborting the enclosing unit of work
+func (u unitOfWork) WithTransaction(ctx context.Context, fn func(ctxFn context.Context) error) error {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return u.withSavepoint(ctx, state, fn)
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxTxAttempts; attempt++ {
+		if err = u.withTransaction(ctx, fn); err == nil || !isRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt) * txRetryBackoff):
+		}
+	}
+
+	return err
+}
+
+func (u unitOfWork) withTransaction(ctx context.Context, fn func(ctxFn context.Context) error) error {
+	opts := u.opts
+	tx, err := u.db.BeginTx(ctx, &opts)
+	if err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, txKey{}, &txState{tx: tx})); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Wrap(err, "rollback error")
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+func (u unitOfWork) withSavepoint(ctx context.Context, state *txState, fn func(ctxFn context.Context) error) error {
+	state.depth++
+	defer func() { state.depth-- }()
+
+	savepoint := fmt.Sprintf("sp_%d", state.depth)
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	if err := fn(ctx); err != nil {
+		if _, rbErr := state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+savepoint); rbErr != nil {
+			return errors.Wrap(err, "rollback error")
+		}
+		return err
+	}
+
+	if _, err := state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+savepoint); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+// executorFrom returns the database transaction of the unit of work carried by ctx. Outside a unit of work
+// it returns the connection pool itself, so each statement borrows a connection and gives it back when done.
+// Statements that fail are recorded on the span of the repository call
+func executorFrom(ctx context.Context, db *sql.DB) executor {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return traced(state.tx)
+	}
+
+	return traced(db)
+}
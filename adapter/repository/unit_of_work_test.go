@@ -0,0 +1,404 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/go-sql-driver/mysql"
+)
+
+// fakeConnector is a database/sql driver recording the statements and transaction boundaries it receives.
+// Statements run inside a transaction are recorded with the "tx:" prefix, and fail with err when it is set
+type fakeConnector struct {
+	err        error
+	mu         sync.Mutex
+	statements []string
+	isolations []driver.IsolationLevel
+	begins     int
+	commits    int
+	rollbacks  int
+}
+
+func (f *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: f}, nil
+}
+
+func (f *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (f *fakeConnector) record(statement string, inTx bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if inTx {
+		statement = "tx:" + statement
+	}
+	f.statements = append(f.statements, statement)
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+	inTx      bool
+}
+
+func (f *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements not supported")
+}
+
+func (f *fakeConn) Close() error {
+	return nil
+}
+
+func (f *fakeConn) Begin() (driver.Tx, error) {
+	return f.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (f *fakeConn) BeginTx(_ context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	f.connector.mu.Lock()
+	defer f.connector.mu.Unlock()
+
+	f.connector.begins++
+	f.connector.isolations = append(f.connector.isolations, opts.Isolation)
+	f.inTx = true
+	return fakeTx{conn: f}, nil
+}
+
+func (f *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	f.connector.record(query, f.inTx)
+	if f.connector.err != nil {
+		return nil, f.connector.err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (f *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	f.connector.record(query, f.inTx)
+	if f.connector.err != nil {
+		return nil, f.connector.err
+	}
+	return &fakeRows{values: []driver.Value{int64(150)}}, nil
+}
+
+type fakeTx struct {
+	conn *fakeConn
+}
+
+func (f fakeTx) Commit() error {
+	f.conn.connector.mu.Lock()
+	defer f.conn.connector.mu.Unlock()
+
+	f.conn.connector.commits++
+	f.conn.inTx = false
+	return nil
+}
+
+func (f fakeTx) Rollback() error {
+	f.conn.connector.mu.Lock()
+	defer f.conn.connector.mu.Unlock()
+
+	f.conn.connector.rollbacks++
+	f.conn.inTx = false
+	return nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+}
+
+func (f *fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (f *fakeRows) Close() error {
+	return nil
+}
+
+func (f *fakeRows) Next(dest []driver.Value) error {
+	if len(f.values) == 0 {
+		return io.EOF
+	}
+
+	dest[0], f.values = f.values[0], f.values[1:]
+	return nil
+}
+
+// assertNoLeak fails the test when a connection is still checked out of the pool or a transaction was left open
+func assertNoLeak(t *testing.T, name string, db *sql.DB, connector *fakeConnector) {
+	t.Helper()
+
+	if got := db.Stats().InUse; got != 0 {
+		t.Errorf("[TestCase '%s'] Got connections in use: '%v' | Want: '%v'", name, got, 0)
+	}
+
+	if got, want := connector.commits+connector.rollbacks, connector.begins; got != want {
+		t.Errorf("[TestCase '%s'] Got finished transactions: '%v' | Want: '%v'", name, got, want)
+	}
+}
+
+func Test_executorFrom_OutsideUnitOfWork(t *testing.T) {
+	var (
+		connector = &fakeConnector{}
+		db        = sql.OpenDB(connector)
+		repo      = NewFindReversedAmountRepository(db)
+	)
+	defer db.Close()
+
+	for i := 0; i < 10; i++ {
+		got, err := repo.FindReversedAmount(context.Background(), "1")
+		if err != nil {
+			t.Fatalf("[TestCase '%s'] Got err: '%v'", "Read-only call", err)
+		}
+
+		if got != 150 {
+			t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Read-only call", got, 150)
+		}
+	}
+
+	if connector.begins != 0 {
+		t.Errorf("[TestCase '%s'] Got transactions: '%v' | Want: '%v'", "Read-only call", connector.begins, 0)
+	}
+
+	assertNoLeak(t, "Read-only call", db, connector)
+}
+
+func Test_unitOfWork_WithTransaction(t *testing.T) {
+	var (
+		errFn       = errors.New("failed")
+		errDeadlock = &mysql.MySQLError{Number: errDeadlock}
+	)
+
+	type want struct {
+		err        error
+		statements []string
+		begins     int
+		commits    int
+		rollbacks  int
+	}
+
+	tests := []struct {
+		name string
+		fn   func(uow domain.UnitOfWork, db *sql.DB) func(context.Context) error
+		want want
+	}{
+		{
+			name: "Commit on success",
+			fn: func(_ domain.UnitOfWork, db *sql.DB) func(context.Context) error {
+				return func(ctx context.Context) error {
+					return NewUpdateAccountCreditLimitRepository(db).UpdateCreditLimit(ctx, "1", 100)
+				}
+			},
+			want: want{
+				statements: []string{"tx:UPDATE accounts"},
+				begins:     1,
+				commits:    1,
+			},
+		},
+		{
+			name: "Rollback on error",
+			fn: func(_ domain.UnitOfWork, db *sql.DB) func(context.Context) error {
+				return func(ctx context.Context) error {
+					if err := NewUpdateAccountCreditLimitRepository(db).UpdateCreditLimit(ctx, "1", 100); err != nil {
+						return err
+					}
+					return errFn
+				}
+			},
+			want: want{
+				err:        errFn,
+				statements: []string{"tx:UPDATE accounts"},
+				begins:     1,
+				rollbacks:  1,
+			},
+		},
+		{
+			name: "Nested unit of work released on success",
+			fn: func(uow domain.UnitOfWork, db *sql.DB) func(context.Context) error {
+				return func(ctx context.Context) error {
+					return uow.WithTransaction(ctx, func(ctx context.Context) error {
+						return NewUpdateAccountCreditLimitRepository(db).UpdateCreditLimit(ctx, "1", 100)
+					})
+				}
+			},
+			want: want{
+				statements: []string{"tx:SAVEPOINT sp_1", "tx:UPDATE accounts", "tx:RELEASE SAVEPOINT sp_1"},
+				begins:     1,
+				commits:    1,
+			},
+		},
+		{
+			name: "Nested unit of work rolled back to its savepoint on error",
+			fn: func(uow domain.UnitOfWork, db *sql.DB) func(context.Context) error {
+				return func(ctx context.Context) error {
+					err := uow.WithTransaction(ctx, func(ctx context.Context) error {
+						return uow.WithTransaction(ctx, func(ctx context.Context) error {
+							return errFn
+						})
+					})
+					if err != errFn {
+						return err
+					}
+
+					return NewUpdateAccountCreditLimitRepository(db).UpdateCreditLimit(ctx, "1", 100)
+				}
+			},
+			want: want{
+				statements: []string{
+					"tx:SAVEPOINT sp_1",
+					"tx:SAVEPOINT sp_2",
+					"tx:ROLLBACK TO SAVEPOINT sp_2",
+					"tx:ROLLBACK TO SAVEPOINT sp_1",
+					"tx:UPDATE accounts",
+				},
+				begins:  1,
+				commits: 1,
+			},
+		},
+		{
+			name: "Retry after a deadlock",
+			fn: func(_ domain.UnitOfWork, _ *sql.DB) func(context.Context) error {
+				var attempts int
+				return func(context.Context) error {
+					if attempts++; attempts == 1 {
+						return errDeadlock
+					}
+					return nil
+				}
+			},
+			want: want{
+				statements: []string{},
+				begins:     2,
+				commits:    1,
+				rollbacks:  1,
+			},
+		},
+		{
+			name: "Give up after the maximum attempts",
+			fn: func(_ domain.UnitOfWork, _ *sql.DB) func(context.Context) error {
+				return func(context.Context) error {
+					return errDeadlock
+				}
+			},
+			want: want{
+				err:        errDeadlock,
+				statements: []string{},
+				begins:     maxTxAttempts,
+				rollbacks:  maxTxAttempts,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				connector = &fakeConnector{statements: []string{}}
+				db        = sql.OpenDB(connector)
+				uow       = NewUnitOfWork(db, sql.TxOptions{})
+			)
+			defer db.Close()
+
+			err := uow.WithTransaction(context.Background(), tt.fn(uow, db))
+			if err != tt.want.err {
+				t.Errorf("[TestCase '%s'] Got err: '%v' | Want: '%v'", tt.name, err, tt.want.err)
+			}
+
+			if !reflect.DeepEqual(firstWords(connector.statements), tt.want.statements) {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v' | Want: '%v'",
+					tt.name,
+					firstWords(connector.statements),
+					tt.want.statements,
+				)
+			}
+
+			if connector.begins != tt.want.begins {
+				t.Errorf("[TestCase '%s'] Got begins: '%v' | Want: '%v'", tt.name, connector.begins, tt.want.begins)
+			}
+
+			if connector.commits != tt.want.commits {
+				t.Errorf("[TestCase '%s'] Got commits: '%v' | Want: '%v'", tt.name, connector.commits, tt.want.commits)
+			}
+
+			if connector.rollbacks != tt.want.rollbacks {
+				t.Errorf("[TestCase '%s'] Got rollbacks: '%v' | Want: '%v'", tt.name, connector.rollbacks, tt.want.rollbacks)
+			}
+
+			assertNoLeak(t, tt.name, db, connector)
+		})
+	}
+}
+
+func Test_unitOfWork_WithTransaction_Panic(t *testing.T) {
+	var (
+		connector = &fakeConnector{}
+		db        = sql.OpenDB(connector)
+		uow       = NewUnitOfWork(db, sql.TxOptions{})
+	)
+	defer db.Close()
+
+	func() {
+		defer func() {
+			if p := recover(); p == nil {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Panic", p, "panic propagated")
+			}
+		}()
+
+		_ = uow.WithTransaction(context.Background(), func(context.Context) error {
+			panic("boom")
+		})
+	}()
+
+	if connector.rollbacks != 1 {
+		t.Errorf("[TestCase '%s'] Got rollbacks: '%v' | Want: '%v'", "Panic", connector.rollbacks, 1)
+	}
+
+	assertNoLeak(t, "Panic", db, connector)
+}
+
+func Test_unitOfWork_WithTransaction_Isolation(t *testing.T) {
+	var (
+		connector = &fakeConnector{}
+		db        = sql.OpenDB(connector)
+		uow       = NewUnitOfWork(db, sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	)
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := uow.WithTransaction(ctx, func(context.Context) error { return nil }); err != nil {
+		t.Fatalf("[TestCase '%s'] Got err: '%v'", "Isolation", err)
+	}
+
+	want := []driver.IsolationLevel{driver.IsolationLevel(sql.LevelReadCommitted)}
+	if !reflect.DeepEqual(connector.isolations, want) {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Isolation", connector.isolations, want)
+	}
+
+	assertNoLeak(t, "Isolation", db, connector)
+}
+
+// firstWords reduces the statements to their leading keywords, enough to tell them apart in the assertions
+func firstWords(statements []string) []string {
+	var words = make([]string, 0, len(statements))
+	for _, statement := range statements {
+		fields := strings.Fields(statement)
+		switch {
+		case strings.HasPrefix(statement, "tx:UPDATE"), strings.HasPrefix(statement, "UPDATE"):
+			words = append(words, strings.Join(fields[:2], " "))
+		default:
+			words = append(words, statement)
+		}
+	}
+
+	return words
+}
@@ -19,20 +19,18 @@ func NewUpdateAccountCreditLimitRepository(db *sql.DB) domain.AccountUpdater {
 }
 
 func (u updateAccountCreditLimitRepository) UpdateCreditLimit(ctx context.Context, ID string, amount int64) error {
-	tx, ok := ctx.Value("TxKey").(*sql.Tx)
-	if !ok {
-		var err error
-		tx, err = u.db.BeginTx(ctx, &sql.TxOptions{})
-		if err != nil {
-			return errors.Wrap(err, errUnknown.Error())
-		}
-	}
+	ctx, span := startSpan(ctx, "UpdateAccountCreditLimit", ID)
+	defer span.End()
+
+	db := executorFrom(ctx, u.db)
 
-	if _, err := tx.ExecContext(
+	if _, err := db.ExecContext(
 		ctx,
-		`UPDATE accounts SET available_credit_limit = ? WHERE id = ?`,
+		`UPDATE accounts SET available_credit_limit = ? WHERE id = ? AND (? OR tenant_id = ?)`,
 		amount,
 		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
 	); err != nil {
 		return errors.Wrap(err, errUnknown.Error())
 	}
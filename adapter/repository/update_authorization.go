@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type updateAuthorizationRepository struct {
+	db *sql.DB
+}
+
+// NewUpdateAuthorizationRepository creates new updateAuthorizationRepository with its dependencies
+func NewUpdateAuthorizationRepository(db *sql.DB) domain.AuthorizationUpdater {
+	return updateAuthorizationRepository{
+		db: db,
+	}
+}
+
+// Update performs update into the database
+func (u updateAuthorizationRepository) Update(ctx context.Context, authorization domain.Authorization) error {
+	ctx, span := startSpan(ctx, "UpdateAuthorization", authorization.AccountID())
+	defer span.End()
+
+	db := executorFrom(ctx, u.db)
+
+	if _, err := db.ExecContext(
+		ctx,
+		`UPDATE authorizations SET captured_amount = ?, status = ?, transaction_id = ? WHERE id = ?`,
+		authorization.CapturedAmount(),
+		authorization.Status(),
+		sql.NullString{
+			String: authorization.TransactionID(),
+			Valid:  authorization.TransactionID() != "",
+		},
+		authorization.ID(),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
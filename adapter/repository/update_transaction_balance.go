@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+type updateTransactionBalanceRepository struct {
+	db *sql.DB
+}
+
+// NewUpdateTransactionBalanceRepository creates new updateTransactionBalanceRepository with its dependencies
+func NewUpdateTransactionBalanceRepository(db *sql.DB) domain.TransactionBalanceUpdater {
+	return updateTransactionBalanceRepository{
+		db: db,
+	}
+}
+
+// UpdateBalance performs update into the database
+func (u updateTransactionBalanceRepository) UpdateBalance(ctx context.Context, ID string, balance int64) error {
+	ctx, span := startSpan(ctx, "UpdateTransactionBalance", "")
+	defer span.End()
+
+	db := executorFrom(ctx, u.db)
+
+	if _, err := db.ExecContext(
+		ctx,
+		`UPDATE transactions SET balance = ? WHERE id = ? AND (? OR tenant_id = ?)`,
+		balance,
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+// webhookColumns are the columns read by scanWebhook, in order
+const webhookColumns = `id, account_id, tenant_id, url, secret, events, active, created_at`
+
+type webhookRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookRepository creates new webhookRepository with its dependencies
+func NewWebhookRepository(db *sql.DB) domain.WebhookRepository {
+	return webhookRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database. The events are stored comma separated
+func (w webhookRepository) Create(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	ctx, span := startSpan(ctx, "CreateWebhook", webhook.AccountID())
+	defer span.End()
+
+	if _, err := executorFrom(ctx, w.db).ExecContext(
+		ctx,
+		`INSERT INTO webhooks (id, account_id, tenant_id, url, secret, events, active, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		webhook.ID(),
+		sql.NullString{
+			String: webhook.AccountID(),
+			Valid:  webhook.AccountID() != "",
+		},
+		webhook.TenantID(),
+		webhook.URL(),
+		webhook.Secret(),
+		strings.Join(webhook.Events(), ","),
+		webhook.Active(),
+		webhook.CreatedAt(),
+	); err != nil {
+		return domain.Webhook{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return webhook, nil
+}
+
+// FindByID performs select into the database
+func (w webhookRepository) FindByID(ctx context.Context, ID string) (domain.Webhook, error) {
+	ctx, span := startSpan(ctx, "FindWebhookByID", "")
+	defer span.End()
+
+	webhook, err := scanWebhook(executorFrom(ctx, w.db).QueryRowContext(
+		ctx,
+		`SELECT `+webhookColumns+` FROM webhooks WHERE id = ? AND (? OR tenant_id = ?)`,
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	))
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.Webhook{}, domain.ErrWebhookNotFound
+	case err != nil:
+		return domain.Webhook{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return webhook, nil
+}
+
+// FindAll performs select into the database for the webhooks of the tenant, filtering by the account when it is given
+func (w webhookRepository) FindAll(ctx context.Context, accountID string) ([]domain.Webhook, error) {
+	ctx, span := startSpan(ctx, "FindAllWebhooks", accountID)
+	defer span.End()
+
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if !domain.AllTenants(ctx) {
+		conditions = append(conditions, "tenant_id = ?")
+		args = append(args, domain.TenantFrom(ctx))
+	}
+
+	if accountID != "" {
+		conditions = append(conditions, "account_id = ?")
+		args = append(args, accountID)
+	}
+
+	query := `SELECT ` + webhookColumns + ` FROM webhooks`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY created_at, id`
+
+	return w.query(ctx, query, args...)
+}
+
+// FindSubscribers performs select into the database for the active webhooks of the tenant of the event,
+// for its account or global, keeping those subscribed to the event
+func (w webhookRepository) FindSubscribers(ctx context.Context, event domain.Event) ([]domain.Webhook, error) {
+	ctx, span := startSpan(ctx, "FindWebhookSubscribers", event.AccountID())
+	defer span.End()
+
+	webhooks, err := w.query(
+		ctx,
+		`SELECT `+webhookColumns+` FROM webhooks
+			WHERE active = TRUE AND tenant_id = ? AND (account_id IS NULL OR account_id = ?) ORDER BY created_at, id`,
+		event.TenantID(),
+		event.AccountID(),
+	)
+	if err != nil {
+		return []domain.Webhook{}, err
+	}
+
+	var subscribers = make([]domain.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhook.Subscribes(event) {
+			subscribers = append(subscribers, webhook)
+		}
+	}
+
+	return subscribers, nil
+}
+
+// Update performs update into the database
+func (w webhookRepository) Update(ctx context.Context, webhook domain.Webhook) error {
+	ctx, span := startSpan(ctx, "UpdateWebhook", webhook.AccountID())
+	defer span.End()
+
+	if _, err := executorFrom(ctx, w.db).ExecContext(
+		ctx,
+		`UPDATE webhooks SET url = ?, events = ?, active = ? WHERE id = ? AND (? OR tenant_id = ?)`,
+		webhook.URL(),
+		strings.Join(webhook.Events(), ","),
+		webhook.Active(),
+		webhook.ID(),
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+// Delete performs delete into the database, the deliveries of the webhook deleted in cascade
+func (w webhookRepository) Delete(ctx context.Context, ID string) error {
+	ctx, span := startSpan(ctx, "DeleteWebhook", "")
+	defer span.End()
+
+	result, err := executorFrom(ctx, w.db).ExecContext(
+		ctx,
+		`DELETE FROM webhooks WHERE id = ? AND (? OR tenant_id = ?)`,
+		ID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	)
+	if err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	if deleted == 0 {
+		return domain.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (w webhookRepository) query(ctx context.Context, query string, args ...interface{}) ([]domain.Webhook, error) {
+	rows, err := executorFrom(ctx, w.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return []domain.Webhook{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var webhooks = make([]domain.Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return []domain.Webhook{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Webhook{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return webhooks, nil
+}
+
+func scanWebhook(row scanner) (domain.Webhook, error) {
+	var (
+		id        string
+		accountID sql.NullString
+		tenantID  string
+		url       string
+		secret    string
+		events    string
+		active    bool
+		createdAt time.Time
+	)
+
+	if err := row.Scan(&id, &accountID, &tenantID, &url, &secret, &events, &active, &createdAt); err != nil {
+		return domain.Webhook{}, err
+	}
+
+	return domain.NewWebhook(id, accountID.String, url, secret, strings.Split(events, ","), active, createdAt).
+		WithTenant(tenantID), nil
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/pkg/errors"
+)
+
+// webhookDeliveryColumns are the columns read by scanWebhookDelivery, in order
+const webhookDeliveryColumns = `d.id, d.webhook_id, d.tenant_id, d.event_id, d.event_type, d.body, d.status, d.attempts,
+	d.last_error, d.next_attempt_at, d.delivered_at, d.created_at`
+
+type webhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookDeliveryRepository creates new webhookDeliveryRepository with its dependencies
+func NewWebhookDeliveryRepository(db *sql.DB) domain.WebhookDeliveryRepository {
+	return webhookDeliveryRepository{
+		db: db,
+	}
+}
+
+// Create performs insert into the database, ignoring an event already scheduled to the webhook
+func (w webhookDeliveryRepository) Create(ctx context.Context, delivery domain.WebhookDelivery) error {
+	ctx, span := startSpan(ctx, "CreateWebhookDelivery", "")
+	defer span.End()
+
+	if _, err := executorFrom(ctx, w.db).ExecContext(
+		ctx,
+		`INSERT INTO webhook_deliveries
+			(id, webhook_id, tenant_id, event_id, event_type, body, status, attempts, last_error, next_attempt_at, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE id = id`,
+		delivery.ID(),
+		delivery.WebhookID(),
+		delivery.TenantID(),
+		delivery.EventID(),
+		delivery.EventType(),
+		string(delivery.Body()),
+		delivery.Status(),
+		delivery.Attempts(),
+		delivery.LastError(),
+		delivery.NextAttemptAt(),
+		delivery.CreatedAt(),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+// FindDue performs select into the database, locking the due deliveries of the active webhooks
+func (w webhookDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "FindDueWebhookDeliveries", "")
+	defer span.End()
+
+	return w.query(
+		ctx,
+		`SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries d
+			JOIN webhooks w ON w.id = d.webhook_id
+			WHERE d.status = ? AND d.next_attempt_at <= ? AND w.active = TRUE AND (? OR d.tenant_id = ?)
+			ORDER BY d.next_attempt_at, d.created_at LIMIT ? FOR UPDATE`,
+		domain.WebhookDeliveryPending,
+		now,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+		limit,
+	)
+}
+
+// FindByWebhookID performs select into the database, newest first
+func (w webhookDeliveryRepository) FindByWebhookID(ctx context.Context, webhookID string, limit int) ([]domain.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "FindWebhookDeliveries", "")
+	defer span.End()
+
+	return w.query(
+		ctx,
+		`SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries d
+			WHERE d.webhook_id = ? AND (? OR d.tenant_id = ?) ORDER BY d.created_at DESC, d.id DESC LIMIT ?`,
+		webhookID,
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+		limit,
+	)
+}
+
+// Update performs update into the database
+func (w webhookDeliveryRepository) Update(ctx context.Context, delivery domain.WebhookDelivery) error {
+	ctx, span := startSpan(ctx, "UpdateWebhookDelivery", "")
+	defer span.End()
+
+	if _, err := executorFrom(ctx, w.db).ExecContext(
+		ctx,
+		`UPDATE webhook_deliveries
+			SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, delivered_at = ?
+			WHERE id = ? AND (? OR tenant_id = ?)`,
+		delivery.Status(),
+		delivery.Attempts(),
+		delivery.LastError(),
+		delivery.NextAttemptAt(),
+		sql.NullTime{
+			Time:  delivery.DeliveredAt(),
+			Valid: !delivery.DeliveredAt().IsZero(),
+		},
+		delivery.ID(),
+		domain.AllTenants(ctx),
+		domain.TenantFrom(ctx),
+	); err != nil {
+		return errors.Wrap(err, errUnknown.Error())
+	}
+
+	return nil
+}
+
+func (w webhookDeliveryRepository) query(ctx context.Context, query string, args ...interface{}) ([]domain.WebhookDelivery, error) {
+	rows, err := executorFrom(ctx, w.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return []domain.WebhookDelivery{}, errors.Wrap(err, errUnknown.Error())
+	}
+	defer rows.Close()
+
+	var deliveries = make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return []domain.WebhookDelivery{}, errors.Wrap(err, errUnknown.Error())
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.WebhookDelivery{}, errors.Wrap(err, errUnknown.Error())
+	}
+
+	return deliveries, nil
+}
+
+func scanWebhookDelivery(row scanner) (domain.WebhookDelivery, error) {
+	var (
+		id            string
+		webhookID     string
+		tenantID      string
+		eventID       string
+		eventType     string
+		body          string
+		status        string
+		attempts      int
+		lastError     string
+		nextAttemptAt time.Time
+		deliveredAt   sql.NullTime
+		createdAt     time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&webhookID,
+		&tenantID,
+		&eventID,
+		&eventType,
+		&body,
+		&status,
+		&attempts,
+		&lastError,
+		&nextAttemptAt,
+		&deliveredAt,
+		&createdAt,
+	); err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+
+	return domain.NewWebhookDelivery(
+		id,
+		webhookID,
+		eventID,
+		eventType,
+		[]byte(body),
+		status,
+		attempts,
+		lastError,
+		nextAttemptAt,
+		deliveredAt.Time,
+		createdAt,
+	).WithTenant(tenantID), nil
+}
@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/domain"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// authorizationKey and apiKeyKey are the metadata keys of the credentials, the gRPC counterparts
+	// of the Authorization and X-API-Key headers
+	authorizationKey = "authorization"
+	apiKeyKey        = "x-api-key"
+)
+
+// methodScopes are the scopes required by the methods of the service. Methods missing here are refused
+var methodScopes = map[string]string{
+	pb.Transactions_CreateAccount_FullMethodName:     domain.ScopeAccountsWrite,
+	pb.Transactions_FindAccountByID_FullMethodName:   domain.ScopeAccountsRead,
+	pb.Transactions_CreateTransaction_FullMethodName: domain.ScopeTransactionsWrite,
+}
+
+type (
+	// TokenVerifier authenticates the principal of a bearer token
+	TokenVerifier interface {
+		Verify(context.Context, string) (domain.Principal, error)
+	}
+
+	// APIKeyAuthenticator authenticates the principal of an API key
+	APIKeyAuthenticator interface {
+		Execute(context.Context, string) (domain.Principal, error)
+	}
+)
+
+// Authentication authenticates the calls like the HTTP middleware, by a bearer token in the authorization
+// metadata or an API key in the x-api-key metadata, and checks the scope of the called method
+type Authentication struct {
+	tokens  TokenVerifier
+	apiKeys APIKeyAuthenticator
+}
+
+// NewAuthentication creates new Authentication with its dependencies
+func NewAuthentication(tokens TokenVerifier, apiKeys APIKeyAuthenticator) *Authentication {
+	return &Authentication{
+		tokens:  tokens,
+		apiKeys: apiKeys,
+	}
+}
+
+// Execute refuses the calls without valid credentials with Unauthenticated, and the ones whose principal
+// was not granted the scope of the method with PermissionDenied. The call is scoped to the tenant of the principal
+func (a Authentication) Execute(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	principal, err := a.authenticate(ctx)
+	switch err {
+	case nil:
+	case domain.ErrCredentialsMissing, domain.ErrCredentialsInvalid:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return nil, statusError(err)
+	}
+
+	scope, ok := methodScopes[info.FullMethod]
+	if !ok || !principal.HasScope(scope) {
+		return nil, status.Error(codes.PermissionDenied, domain.ErrScopeMissing.Error())
+	}
+
+	ctx = domain.WithPrincipal(ctx, principal)
+	ctx = domain.WithTenant(ctx, principal.TenantID())
+
+	return handler(ctx, req)
+}
+
+func (a Authentication) authenticate(ctx context.Context) (domain.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(authorizationKey); len(values) > 0 {
+		scheme, token, ok := strings.Cut(values[0], " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			return domain.Principal{}, domain.ErrCredentialsInvalid
+		}
+
+		return a.tokens.Verify(ctx, token)
+	}
+
+	if values := md.Get(apiKeyKey); len(values) > 0 && values[0] != "" {
+		return a.apiKeys.Execute(ctx, values[0])
+	}
+
+	return domain.Principal{}, domain.ErrCredentialsMissing
+}
@@ -0,0 +1,158 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type stubCredentials struct {
+	credential string
+	principal  domain.Principal
+	err        error
+}
+
+func (s stubCredentials) Verify(ctx context.Context, credential string) (domain.Principal, error) {
+	return s.Execute(ctx, credential)
+}
+
+func (s stubCredentials) Execute(_ context.Context, credential string) (domain.Principal, error) {
+	if s.err != nil {
+		return domain.Principal{}, s.err
+	}
+
+	if credential != s.credential {
+		return domain.Principal{}, domain.ErrCredentialsInvalid
+	}
+
+	return s.principal, nil
+}
+
+func TestAuthentication_Execute(t *testing.T) {
+	var (
+		reader = domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", []string{domain.ScopeAccountsRead})
+		tokens = stubCredentials{credential: "jwt", principal: reader}
+		keys   = stubCredentials{
+			credential: "gtk_key",
+			principal:  domain.NewPrincipal("k", domain.PrincipalAPIKey, "tenant-a", []string{domain.ScopeAccountsRead}),
+		}
+	)
+
+	tests := []struct {
+		name     string
+		metadata metadata.MD
+		apiKeys  stubCredentials
+		wantCode codes.Code
+	}{
+		{
+			name:     "Authenticate bearer token",
+			metadata: metadata.Pairs(authorizationKey, "Bearer jwt"),
+			apiKeys:  keys,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Authenticate API key",
+			metadata: metadata.Pairs(apiKeyKey, "gtk_key"),
+			apiKeys:  keys,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Error credentials missing",
+			metadata: metadata.MD{},
+			apiKeys:  keys,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Error bearer token invalid",
+			metadata: metadata.Pairs(authorizationKey, "Bearer other"),
+			apiKeys:  keys,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Error API key invalid",
+			metadata: metadata.Pairs(apiKeyKey, "gtk_other"),
+			apiKeys:  keys,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Repository error when authenticate API key",
+			metadata: metadata.Pairs(apiKeyKey, "gtk_key"),
+			apiKeys:  stubCredentials{err: errors.New("db_error")},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				uc     = stubFindAccountByIDUseCase{result: usecase.FindAccountByIDOutput{ID: "1"}}
+				client = newTestClient(
+					t,
+					newTestServer(stubCreateAccountUseCase{}, uc, stubCreateTransactionUseCase{}),
+					NewAuthentication(tokens, tt.apiKeys).Execute,
+				)
+				ctx = metadata.NewOutgoingContext(context.Background(), tt.metadata)
+			)
+
+			_, err := client.FindAccountByID(ctx, &pb.FindAccountByIDRequest{Id: "1"})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("[TestCase '%s'] Got code: '%v' | Want: '%v'", tt.name, code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAuthentication_Execute_Scope(t *testing.T) {
+	var (
+		reader = domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", []string{domain.ScopeAccountsRead})
+		client = newTestClient(
+			t,
+			newTestServer(stubCreateAccountUseCase{}, stubFindAccountByIDUseCase{}, stubCreateTransactionUseCase{}),
+			NewAuthentication(stubCredentials{credential: "jwt", principal: reader}, stubCredentials{}).Execute,
+		)
+		ctx = metadata.NewOutgoingContext(context.Background(), metadata.Pairs(authorizationKey, "Bearer jwt"))
+	)
+
+	_, err := client.CreateTransaction(
+		ctx,
+		&pb.CreateTransactionRequest{AccountId: "1", OperationId: domain.CompraAVista, Amount: 100},
+	)
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Errorf("[TestCase 'Error scope missing'] Got code: '%v' | Want: '%v'", code, codes.PermissionDenied)
+	}
+}
+
+func TestAuthentication_Execute_Tenant(t *testing.T) {
+	var (
+		reader = domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", []string{domain.ScopeAccountsRead})
+		auth   = NewAuthentication(stubCredentials{credential: "jwt", principal: reader}, stubCredentials{})
+		ctx    = metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, "Bearer jwt"))
+		got    string
+	)
+
+	_, err := auth.Execute(
+		ctx,
+		&pb.FindAccountByIDRequest{Id: "1"},
+		&grpc.UnaryServerInfo{FullMethod: pb.Transactions_FindAccountByID_FullMethodName},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			got = domain.TenantFrom(ctx)
+			return nil, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("[TestCase 'Scope call to tenant'] Err: '%v'", err)
+	}
+
+	if got != "tenant-a" {
+		t.Errorf("[TestCase 'Scope call to tenant'] Got: '%v' | Want: '%v'", got, "tenant-a")
+	}
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// correlationIDKey is the metadata key of the correlation id, the gRPC counterpart of the X-Correlation-Id header
+const correlationIDKey = "x-correlation-id"
+
+// CorrelationID reads the correlation id from the incoming metadata, or generates one, stores it in the
+// context like the HTTP middleware, together with the called method as the route, and returns it in the
+// response header metadata
+func CorrelationID(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(correlationIDKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(correlationIDKey, id))
+
+	ctx = logger.WithCorrelationID(ctx, id)
+	ctx = logger.WithRoute(ctx, info.FullMethod)
+
+	return handler(ctx, req)
+}
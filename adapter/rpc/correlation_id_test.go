@@ -0,0 +1,60 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/domain"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCorrelationID(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata metadata.MD
+		want     string
+	}{
+		{
+			name:     "Correlation id from the metadata",
+			metadata: metadata.Pairs(correlationIDKey, "f9882930-1914-47d7-8b58-18bff092e081"),
+			want:     "f9882930-1914-47d7-8b58-18bff092e081",
+		},
+		{
+			name:     "Correlation id generated",
+			metadata: metadata.MD{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				received string
+				header   metadata.MD
+				uc       = stubCreateTransactionUseCase{correlationID: &received}
+				client   = newTestClient(t, newTestServer(stubCreateAccountUseCase{}, stubFindAccountByIDUseCase{}, uc))
+				ctx      = metadata.NewOutgoingContext(context.Background(), tt.metadata)
+			)
+
+			_, err := client.CreateTransaction(
+				ctx,
+				&pb.CreateTransactionRequest{AccountId: "1", OperationId: domain.CompraAVista, Amount: 100},
+				grpc.Header(&header),
+			)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			var got = header.Get(correlationIDKey)
+			if len(got) != 1 || got[0] == "" || got[0] != received {
+				t.Fatalf("[TestCase '%s'] Got header: '%v' | Got in the use case: '%v'", tt.name, got, received)
+			}
+
+			if tt.want != "" && received != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, received, tt.want)
+			}
+		})
+	}
+}
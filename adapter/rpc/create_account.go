@@ -0,0 +1,35 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// CreateAccount handles the gRPC call of the create account use case
+func (t TransactionsServer) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.Account, error) {
+	var input usecase.CreateAccountInput
+	input.Document.Number = req.GetDocument().GetNumber()
+	input.AvailableCreditLimit = req.GetAvailableCreditLimit()
+
+	if err := t.validator.Struct(input); err != nil {
+		t.log.Error(ctx, "invalid input", err)
+		return nil, invalidArgument(err)
+	}
+
+	output, err := t.createAccount.Execute(ctx, input)
+	if err != nil {
+		t.log.Error(ctx, "failed to creating account", err)
+		return nil, statusError(err)
+	}
+
+	t.log.Info(ctx, "success to creating account", logger.AccountID(output.ID))
+	return &pb.Account{
+		Id:                   output.ID,
+		AvailableCreditLimit: output.AvailableCreditLimit,
+		Document:             &pb.Document{Number: output.Document.Number},
+		CreatedAt:            output.CreatedAt,
+	}, nil
+}
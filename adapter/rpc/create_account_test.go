@@ -0,0 +1,110 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransactionsServer_CreateAccount(t *testing.T) {
+	var validRequest = &pb.CreateAccountRequest{
+		Document:             &pb.Document{Number: "12345678909"},
+		AvailableCreditLimit: 1000,
+	}
+
+	tests := []struct {
+		name     string
+		uc       usecase.CreateAccountUseCase
+		req      *pb.CreateAccountRequest
+		wantID   string
+		wantCode codes.Code
+	}{
+		{
+			name: "Create account successfully",
+			uc: stubCreateAccountUseCase{
+				result: usecase.CreateAccountOutput{
+					ID:                   "fc95c5b6-8a3b-4b2f-8b8e-2ecb5e8a1b3d",
+					AvailableCreditLimit: 1000,
+					Document:             usecase.CreateAccountDocumentOutput{Number: "12345678909"},
+					CreatedAt:            "2020-10-16T17:50:39Z",
+				},
+			},
+			req:      validRequest,
+			wantID:   "fc95c5b6-8a3b-4b2f-8b8e-2ecb5e8a1b3d",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Error document missing",
+			uc:       stubCreateAccountUseCase{},
+			req:      &pb.CreateAccountRequest{AvailableCreditLimit: 1000},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error account already exists",
+			uc:       stubCreateAccountUseCase{err: domain.ErrAccountAlreadyExists},
+			req:      validRequest,
+			wantCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, newTestServer(tt.uc, stubFindAccountByIDUseCase{}, stubCreateTransactionUseCase{}))
+
+			got, err := client.CreateAccount(context.Background(), tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("[TestCase '%s'] Got code: '%v' | Want: '%v'", tt.name, code, tt.wantCode)
+			}
+
+			if got.GetId() != tt.wantID {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.GetId(), tt.wantID)
+			}
+		})
+	}
+}
+
+func TestTransactionsServer_FindAccountByID(t *testing.T) {
+	tests := []struct {
+		name     string
+		uc       usecase.FindAccountByIDUseCase
+		id       string
+		wantCode codes.Code
+	}{
+		{
+			name: "Find account successfully",
+			uc: stubFindAccountByIDUseCase{
+				result: usecase.FindAccountByIDOutput{ID: "1", AvailableCreditLimit: 1000},
+			},
+			id:       "1",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Error empty id",
+			uc:       stubFindAccountByIDUseCase{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error account not found",
+			uc:       stubFindAccountByIDUseCase{err: domain.ErrAccountNotFound},
+			id:       "1",
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, newTestServer(stubCreateAccountUseCase{}, tt.uc, stubCreateTransactionUseCase{}))
+
+			_, err := client.FindAccountByID(context.Background(), &pb.FindAccountByIDRequest{Id: tt.id})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("[TestCase '%s'] Got code: '%v' | Want: '%v'", tt.name, code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// CreateTransaction handles the gRPC call of the create transaction use case
+func (t TransactionsServer) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.Transaction, error) {
+	input := usecase.CreateTransactionInput{
+		AccountID:    req.GetAccountId(),
+		OperationID:  req.GetOperationId(),
+		Amount:       req.GetAmount(),
+		Installments: int(req.GetInstallments()),
+	}
+
+	if err := t.validator.Struct(input); err != nil {
+		t.log.Error(ctx, "invalid input", err, logger.AccountID(input.AccountID))
+		return nil, invalidArgument(err)
+	}
+
+	output, err := t.createTransaction.Execute(ctx, input)
+	if err != nil {
+		t.log.Error(ctx, "failed to creating transaction", err, logger.AccountID(input.AccountID))
+		return nil, statusError(err)
+	}
+
+	var installments = make([]*pb.Installment, 0, len(output.Installments))
+	for _, installment := range output.Installments {
+		installments = append(installments, &pb.Installment{
+			Number:  int32(installment.Number),
+			Amount:  installment.Amount,
+			DueDate: installment.DueDate,
+		})
+	}
+
+	t.log.Info(ctx, "success to creating transaction", logger.AccountID(input.AccountID))
+	return &pb.Transaction{
+		Id:        output.ID,
+		AccountId: output.AccountID,
+		Operation: &pb.Operation{
+			Id:          output.Operation.ID,
+			Description: output.Operation.Description,
+			Type:        output.Operation.Type,
+		},
+		Amount:       output.Amount,
+		Balance:      output.Balance,
+		Installments: installments,
+		CreatedAt:    output.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransactionsServer_CreateTransaction(t *testing.T) {
+	var validRequest = &pb.CreateTransactionRequest{
+		AccountId:   "92c82203-cdba-4932-9860-bce2e6140267",
+		OperationId: domain.CompraAVista,
+		Amount:      1074,
+	}
+
+	tests := []struct {
+		name       string
+		uc         usecase.CreateTransactionUseCase
+		req        *pb.CreateTransactionRequest
+		wantAmount int64
+		wantCode   codes.Code
+	}{
+		{
+			name: "Create transaction successfully",
+			uc: stubCreateTransactionUseCase{
+				result: usecase.CreateTransactionOutput{
+					ID:        "aef3836b-5ea4-4890-80ad-e13337ccf47f",
+					AccountID: "92c82203-cdba-4932-9860-bce2e6140267",
+					Operation: usecase.CreateTransactionOperationOutput{
+						ID:          domain.CompraAVista,
+						Description: "COMPRA A VISTA",
+						Type:        domain.Debit,
+					},
+					Amount:    -1074,
+					CreatedAt: "2020-10-16T17:50:39Z",
+				},
+			},
+			req:        validRequest,
+			wantAmount: -1074,
+			wantCode:   codes.OK,
+		},
+		{
+			name:     "Error invalid amount",
+			uc:       stubCreateTransactionUseCase{},
+			req:      &pb.CreateTransactionRequest{AccountId: "1", OperationId: domain.CompraAVista},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error operation type invalid",
+			uc:       stubCreateTransactionUseCase{err: domain.ErrOperationInvalid},
+			req:      validRequest,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error account not found",
+			uc:       stubCreateTransactionUseCase{err: domain.ErrAccountNotFound},
+			req:      validRequest,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "Error insufficient credit limit",
+			uc:       stubCreateTransactionUseCase{err: domain.ErrAccountInsufficientCreditLimit},
+			req:      validRequest,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "Error operation disabled",
+			uc:       stubCreateTransactionUseCase{err: domain.ErrOperationDisabled},
+			req:      validRequest,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "Error timeout",
+			uc:       stubCreateTransactionUseCase{err: context.DeadlineExceeded},
+			req:      validRequest,
+			wantCode: codes.DeadlineExceeded,
+		},
+		{
+			name:     "Error unknown",
+			uc:       stubCreateTransactionUseCase{err: errors.New("db error")},
+			req:      validRequest,
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, newTestServer(stubCreateAccountUseCase{}, stubFindAccountByIDUseCase{}, tt.uc))
+
+			got, err := client.CreateTransaction(context.Background(), tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("[TestCase '%s'] Got code: '%v' | Want: '%v'", tt.name, code, tt.wantCode)
+			}
+
+			if err == nil && (got.GetAmount() != tt.wantAmount || got.GetOperation().GetType() != domain.Debit) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want amount: '%v'", tt.name, got, tt.wantAmount)
+			}
+		})
+	}
+}
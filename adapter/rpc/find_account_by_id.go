@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FindAccountByID handles the gRPC call of the find account by id use case
+func (t TransactionsServer) FindAccountByID(ctx context.Context, req *pb.FindAccountByIDRequest) (*pb.Account, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid account id")
+	}
+
+	output, err := t.findAccountByID.Execute(ctx, usecase.FindAccountByIDInput{ID: req.GetId()})
+	if err != nil {
+		t.log.Error(ctx, "failed to find account", err, logger.AccountID(req.GetId()))
+		return nil, statusError(err)
+	}
+
+	t.log.Info(ctx, "success to find account", logger.AccountID(req.GetId()))
+	return &pb.Account{
+		Id:                   output.ID,
+		AvailableCreditLimit: output.AvailableCreditLimit,
+		Document:             &pb.Document{Number: output.Document.Number},
+		CreatedAt:            output.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusError maps the error of a use case to the gRPC status code of the same meaning
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAccountNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrAccountAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrOperationInvalid),
+		errors.Is(err, domain.ErrInstallmentsInvalid),
+		errors.Is(err, domain.ErrDocumentInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrOperationDisabled),
+		errors.Is(err, domain.ErrAccountInsufficientCreditLimit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// invalidArgument returns the InvalidArgument status with the messages of the failed validations
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, strings.Join(validation.ErrMessages(err), "; "))
+}
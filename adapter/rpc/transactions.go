@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TransactionsServer defines the dependencies of the gRPC service, which calls the same use cases as the HTTP handlers
+type TransactionsServer struct {
+	pb.UnimplementedTransactionsServer
+
+	createAccount     usecase.CreateAccountUseCase
+	findAccountByID   usecase.FindAccountByIDUseCase
+	createTransaction usecase.CreateTransactionUseCase
+	log               logger.Logger
+	validator         *validator.Validate
+}
+
+// NewTransactionsServer creates new TransactionsServer with its dependencies
+func NewTransactionsServer(
+	createAccount usecase.CreateAccountUseCase,
+	findAccountByID usecase.FindAccountByIDUseCase,
+	createTransaction usecase.CreateTransactionUseCase,
+	log logger.Logger,
+	v *validator.Validate,
+) TransactionsServer {
+	return TransactionsServer{
+		createAccount:     createAccount,
+		findAccountByID:   findAccountByID,
+		createTransaction: createTransaction,
+		log:               log,
+		validator:         v,
+	}
+}
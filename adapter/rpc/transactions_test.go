@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type stubCreateAccountUseCase struct {
+	result usecase.CreateAccountOutput
+	err    error
+}
+
+func (s stubCreateAccountUseCase) Execute(_ context.Context, _ usecase.CreateAccountInput) (usecase.CreateAccountOutput, error) {
+	return s.result, s.err
+}
+
+type stubFindAccountByIDUseCase struct {
+	result usecase.FindAccountByIDOutput
+	err    error
+}
+
+func (s stubFindAccountByIDUseCase) Execute(_ context.Context, _ usecase.FindAccountByIDInput) (usecase.FindAccountByIDOutput, error) {
+	return s.result, s.err
+}
+
+// stubCreateTransactionUseCase records the correlation id of the context it is called with
+type stubCreateTransactionUseCase struct {
+	result        usecase.CreateTransactionOutput
+	err           error
+	correlationID *string
+}
+
+func (s stubCreateTransactionUseCase) Execute(ctx context.Context, _ usecase.CreateTransactionInput) (usecase.CreateTransactionOutput, error) {
+	if s.correlationID != nil {
+		*s.correlationID = logger.CorrelationID(ctx)
+	}
+
+	return s.result, s.err
+}
+
+// newTestClient serves the use cases in memory through the correlation id interceptor of the application,
+// followed by the given interceptors
+func newTestClient(
+	t *testing.T,
+	server TransactionsServer,
+	interceptors ...grpc.UnaryServerInterceptor,
+) pb.TransactionsClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{CorrelationID}, interceptors...)...))
+	pb.RegisterTransactionsServer(s, server)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		s.Stop()
+	})
+
+	return pb.NewTransactionsClient(conn)
+}
+
+func newTestServer(
+	createAccount usecase.CreateAccountUseCase,
+	findAccountByID usecase.FindAccountByIDUseCase,
+	createTransaction usecase.CreateTransactionUseCase,
+) TransactionsServer {
+	return NewTransactionsServer(
+		createAccount,
+		findAccountByID,
+		createTransaction,
+		logger.NewLogFake(),
+		validation.NewValidator(),
+	)
+}
@@ -23,6 +23,11 @@ type (
 		FindByID(context.Context, string) (Account, error)
 	}
 
+	// AccountLocker defines the search operation for an account entity locking it until the end of the transaction
+	AccountLocker interface {
+		LockByID(context.Context, string) (Account, error)
+	}
+
 	// AccountUpdater defines the update operation for an account entity
 	AccountUpdater interface {
 		UpdateCreditLimit(context.Context, string, int64) error
@@ -31,27 +36,48 @@ type (
 	// Account defines the account entity
 	Account struct {
 		id                   string
+		tenantID             string
 		document             Document
 		availableCreditLimit int64
 		createdAt            time.Time
 	}
+)
 
-	// Document defines document property
-	Document struct {
-		number string
+// NewAccount creates new Account, rejecting a document that isn't a valid CPF or CNPJ
+func NewAccount(ID string, docNumber string, avCreditLimit int64, createdAt time.Time) (Account, error) {
+	document, err := NewDocument(docNumber)
+	if err != nil {
+		return Account{}, err
 	}
-)
 
-// NewAccount creates new Account
-func NewAccount(ID string, docNumber string, avCreditLimit int64, createdAt time.Time) Account {
 	return Account{
-		id: ID,
-		document: Document{
-			number: docNumber,
-		},
+		id:                   ID,
+		document:             document,
 		availableCreditLimit: avCreditLimit,
 		createdAt:            createdAt,
+	}, nil
+}
+
+// NewStoredAccount creates the Account of a stored row. Accounts created before the documents were
+// validated keep the number as stored, without type
+func NewStoredAccount(ID string, docNumber string, avCreditLimit int64, createdAt time.Time) Account {
+	document, err := NewDocument(docNumber)
+	if err != nil {
+		document = Document{number: docNumber}
 	}
+
+	return Account{
+		id:                   ID,
+		document:             document,
+		availableCreditLimit: avCreditLimit,
+		createdAt:            createdAt,
+	}
+}
+
+// WithTenant returns a copy of the account owned by the tenant
+func (a Account) WithTenant(tenantID string) Account {
+	a.tenantID = tenantID
+	return a
 }
 
 // PaymentOperation
@@ -83,6 +109,11 @@ func (a Account) ID() string {
 	return a.id
 }
 
+// TenantID returns the tenantID property
+func (a Account) TenantID() string {
+	return a.tenantID
+}
+
 // Document returns the document property
 func (a Account) Document() Document {
 	return a.document
@@ -97,8 +128,3 @@ func (a Account) CreatedAt() time.Time {
 func (a Account) AvailableCreditLimit() int64 {
 	return a.availableCreditLimit
 }
-
-// Number returns the number property
-func (d Document) Number() string {
-	return d.number
-}
@@ -39,7 +39,7 @@ func TestAccount_Deposit(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			account := NewAccount(
+			account := NewStoredAccount(
 				tt.fields.id,
 				tt.fields.document.number,
 				tt.fields.availableCreditLimit,
@@ -113,7 +113,7 @@ func TestAccount_PaymentOperation(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			account := NewAccount(
+			account := NewStoredAccount(
 				tt.fields.id,
 				tt.fields.document.number,
 				tt.fields.availableCreditLimit,
@@ -190,7 +190,7 @@ func TestAccount_Withdraw(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			account := NewAccount(
+			account := NewStoredAccount(
 				tt.fields.id,
 				tt.fields.document.number,
 				tt.fields.availableCreditLimit,
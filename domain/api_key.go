@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+)
+
+type (
+	// APIKeyCreator defines the operation of creating an API key entity
+	APIKeyCreator interface {
+		Create(context.Context, APIKey) (APIKey, error)
+	}
+
+	// APIKeyFinder defines the search operation for an API key entity
+	APIKeyFinder interface {
+		FindByID(context.Context, string) (APIKey, error)
+	}
+
+	// APIKeyHashFinder defines the search operation for the API key with the hash
+	APIKeyHashFinder interface {
+		FindByHash(context.Context, string) (APIKey, error)
+	}
+
+	// APIKeyUpdater defines the update operation for an API key entity
+	APIKeyUpdater interface {
+		Update(context.Context, APIKey) error
+	}
+
+	// APIKeyRepository defines the API keys
+	APIKeyRepository interface {
+		APIKeyCreator
+		APIKeyFinder
+		APIKeyHashFinder
+		APIKeyUpdater
+	}
+
+	// APIKey defines the API key entity. Only the hash of the key is stored, the key itself
+	// is known only when the API key is issued
+	APIKey struct {
+		id        string
+		tenantID  string
+		name      string
+		key       string
+		hash      string
+		scopes    []string
+		createdAt time.Time
+		revokedAt time.Time
+	}
+)
+
+// NewAPIKey creates new APIKey. An API key without revocation time is active
+func NewAPIKey(
+	id string,
+	tenantID string,
+	name string,
+	hash string,
+	scopes []string,
+	createdAt time.Time,
+	revokedAt time.Time,
+) APIKey {
+	return APIKey{
+		id:        id,
+		tenantID:  tenantID,
+		name:      name,
+		hash:      hash,
+		scopes:    scopes,
+		createdAt: createdAt,
+		revokedAt: revokedAt,
+	}
+}
+
+// IssueAPIKey creates new active APIKey of the tenant for the key, checking the scopes it is granted
+func IssueAPIKey(id string, tenantID string, name string, key string, scopes []string, createdAt time.Time) (APIKey, error) {
+	if tenantID == "" {
+		return APIKey{}, ErrTenantMissing
+	}
+
+	if len(scopes) == 0 {
+		return APIKey{}, ErrScopeInvalid
+	}
+
+	if err := validScopes(scopes); err != nil {
+		return APIKey{}, err
+	}
+
+	apiKey := NewAPIKey(id, tenantID, name, HashAPIKey(key), scopes, createdAt, time.Time{})
+	apiKey.key = key
+
+	return apiKey, nil
+}
+
+// HashAPIKey returns the SHA-256 of the key in hexadecimal. API keys are random, so a fast hash
+// is enough to keep them from being read from the storage
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Revoke disables the API key, keeping the time of the first revocation
+func (a *APIKey) Revoke(now time.Time) {
+	if a.Revoked() {
+		return
+	}
+
+	a.revokedAt = now
+}
+
+// Revoked reports whether the API key was revoked
+func (a APIKey) Revoked() bool {
+	return !a.revokedAt.IsZero()
+}
+
+// Principal returns the principal authenticated by the API key
+func (a APIKey) Principal() Principal {
+	return NewPrincipal(a.id, PrincipalAPIKey, a.tenantID, a.scopes)
+}
+
+// ID returns the id property
+func (a APIKey) ID() string {
+	return a.id
+}
+
+// TenantID returns the tenantID property
+func (a APIKey) TenantID() string {
+	return a.tenantID
+}
+
+// Name returns the name property
+func (a APIKey) Name() string {
+	return a.name
+}
+
+// Key returns the key, empty unless the API key was just issued
+func (a APIKey) Key() string {
+	return a.key
+}
+
+// Hash returns the hash property
+func (a APIKey) Hash() string {
+	return a.hash
+}
+
+// Scopes returns the scopes property
+func (a APIKey) Scopes() []string {
+	return a.scopes
+}
+
+// CreatedAt returns the createdAt property
+func (a APIKey) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// RevokedAt returns the revokedAt property, zero while the API key is active
+func (a APIKey) RevokedAt() time.Time {
+	return a.revokedAt
+}
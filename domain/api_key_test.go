@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIssueAPIKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenantID string
+		scopes   []string
+		wantErr  error
+	}{
+		{
+			name:     "Issue API key",
+			tenantID: "tenant-a",
+			scopes:   []string{ScopeAccountsRead, ScopeTransactionsWrite},
+		},
+		{
+			name:     "Issue API key without tenant",
+			tenantID: "",
+			scopes:   []string{ScopeAccountsRead},
+			wantErr:  ErrTenantMissing,
+		},
+		{
+			name:     "Issue API key without scopes",
+			tenantID: "tenant-a",
+			scopes:   []string{},
+			wantErr:  ErrScopeInvalid,
+		},
+		{
+			name:     "Issue API key with unknown scope",
+			tenantID: "tenant-a",
+			scopes:   []string{ScopeAccountsRead, "accounts:delete"},
+			wantErr:  ErrScopeInvalid,
+		},
+		{
+			name:     "Issue API key with system scope",
+			tenantID: "tenant-a",
+			scopes:   []string{ScopeAdmin, ScopeSystem},
+			wantErr:  ErrScopeInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IssueAPIKey("1", tt.tenantID, "backoffice", "gtk_secret", tt.scopes, time.Time{})
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Key() != "gtk_secret" || got.Hash() != HashAPIKey("gtk_secret") || got.Hash() == got.Key() {
+				t.Errorf("[TestCase '%s'] Got key: '%v' hash: '%v'", tt.name, got.Key(), got.Hash())
+			}
+
+			principal := got.Principal()
+			if principal.ID() != "1" ||
+				principal.Kind() != PrincipalAPIKey ||
+				principal.TenantID() != tt.tenantID ||
+				!reflect.DeepEqual(principal.Scopes(), tt.scopes) {
+				t.Errorf("[TestCase '%s'] Got principal: '%+v'", tt.name, principal)
+			}
+		})
+	}
+}
+
+func TestAPIKey_Revoke(t *testing.T) {
+	var (
+		first  = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		second = first.Add(time.Hour)
+	)
+
+	apiKey := NewAPIKey("1", "tenant-a", "backoffice", HashAPIKey("gtk_secret"), []string{ScopeAdmin}, first, time.Time{})
+	if apiKey.Revoked() {
+		t.Fatalf("[TestCase '%s'] Got revoked: '%v' | Want: '%v'", "Active API key", true, false)
+	}
+
+	apiKey.Revoke(first)
+	apiKey.Revoke(second)
+
+	if !apiKey.Revoked() || !apiKey.RevokedAt().Equal(first) {
+		t.Errorf("[TestCase '%s'] Got revoked at: '%v' | Want: '%v'", "Revoked API key", apiKey.RevokedAt(), first)
+	}
+}
@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	AuthorizationPending  string = "PENDING"
+	AuthorizationCaptured string = "CAPTURED"
+	AuthorizationVoided   string = "VOIDED"
+	AuthorizationExpired  string = "EXPIRED"
+)
+
+var (
+	ErrAuthorizationNotFound             = errors.New("authorization not found")
+	ErrAuthorizationNotPending           = errors.New("authorization is not pending")
+	ErrAuthorizationExpired              = errors.New("authorization expired")
+	ErrAuthorizationNotExpired           = errors.New("authorization not expired")
+	ErrAuthorizationCaptureExceedsAmount = errors.New("capture amount exceeds the authorized amount")
+	ErrAuthorizationAccountMismatch      = errors.New("authorization does not belong to the account")
+)
+
+type (
+	// AuthorizationCreator defines the operation of creating an authorization entity
+	AuthorizationCreator interface {
+		Create(context.Context, Authorization) (Authorization, error)
+	}
+
+	// AuthorizationFinder defines the search operation for an authorization entity
+	AuthorizationFinder interface {
+		FindByID(context.Context, string) (Authorization, error)
+	}
+
+	// AuthorizationExpiredFinder defines the search operation for pending authorizations past their expiration
+	AuthorizationExpiredFinder interface {
+		FindExpired(context.Context, time.Time, int) ([]Authorization, error)
+	}
+
+	// AuthorizationUpdater defines the update operation for an authorization entity
+	AuthorizationUpdater interface {
+		Update(context.Context, Authorization) error
+	}
+
+	// Authorization defines the authorization entity, a hold placed on the available credit limit
+	Authorization struct {
+		id             string
+		accountID      string
+		operation      Operation
+		amount         int64
+		capturedAmount int64
+		status         string
+		transactionID  string
+		expiresAt      time.Time
+		createdAt      time.Time
+	}
+)
+
+// NewAuthorization creates new Authorization
+func NewAuthorization(
+	id string,
+	accID string,
+	op Operation,
+	amount int64,
+	capturedAmount int64,
+	status string,
+	transactionID string,
+	expiresAt time.Time,
+	createdAt time.Time,
+) Authorization {
+	return Authorization{
+		id:             id,
+		accountID:      accID,
+		operation:      op,
+		amount:         amount,
+		capturedAmount: capturedAmount,
+		status:         status,
+		transactionID:  transactionID,
+		expiresAt:      expiresAt,
+		createdAt:      createdAt,
+	}
+}
+
+// PlaceAuthorization holds the amount on the available credit limit of the account
+func PlaceAuthorization(
+	id string,
+	account *Account,
+	op Operation,
+	amount int64,
+	expiresAt time.Time,
+	createdAt time.Time,
+) (Authorization, error) {
+	if op.opType != Debit {
+		return Authorization{}, ErrOperationInvalid
+	}
+
+	if err := account.Withdraw(amount); err != nil {
+		return Authorization{}, err
+	}
+
+	return NewAuthorization(id, account.ID(), op, amount, 0, AuthorizationPending, "", expiresAt, createdAt), nil
+}
+
+// Capture turns the hold into a debit transaction. A zero amount captures everything,
+// a partial capture releases the remaining amount back to the account
+func (a *Authorization) Capture(account *Account, transactionID string, amount int64, now time.Time) (Transaction, error) {
+	if err := a.pending(account, now); err != nil {
+		return Transaction{}, err
+	}
+
+	if amount == 0 {
+		amount = a.amount
+	}
+
+	if amount > a.amount {
+		return Transaction{}, ErrAuthorizationCaptureExceedsAmount
+	}
+
+	account.Deposit(a.amount - amount)
+
+	a.capturedAmount = amount
+	a.status = AuthorizationCaptured
+	a.transactionID = transactionID
+
+	return NewTransaction(transactionID, a.accountID, a.operation, amount, 0, now).WithTenant(account.TenantID()), nil
+}
+
+// Void cancels the hold and releases the amount back to the account
+func (a *Authorization) Void(account *Account, now time.Time) error {
+	if err := a.pending(account, now); err != nil {
+		return err
+	}
+
+	account.Deposit(a.amount)
+	a.status = AuthorizationVoided
+
+	return nil
+}
+
+// Expire cancels a stale hold and releases the amount back to the account
+func (a *Authorization) Expire(account *Account, now time.Time) error {
+	if a.status != AuthorizationPending {
+		return ErrAuthorizationNotPending
+	}
+
+	if a.accountID != account.ID() {
+		return ErrAuthorizationAccountMismatch
+	}
+
+	if now.Before(a.expiresAt) {
+		return ErrAuthorizationNotExpired
+	}
+
+	account.Deposit(a.amount)
+	a.status = AuthorizationExpired
+
+	return nil
+}
+
+func (a Authorization) pending(account *Account, now time.Time) error {
+	if a.status != AuthorizationPending {
+		return ErrAuthorizationNotPending
+	}
+
+	if a.accountID != account.ID() {
+		return ErrAuthorizationAccountMismatch
+	}
+
+	if !now.Before(a.expiresAt) {
+		return ErrAuthorizationExpired
+	}
+
+	return nil
+}
+
+// ID returns the id property
+func (a Authorization) ID() string {
+	return a.id
+}
+
+// AccountID returns the accountID property
+func (a Authorization) AccountID() string {
+	return a.accountID
+}
+
+// Operation returns the operation property
+func (a Authorization) Operation() Operation {
+	return a.operation
+}
+
+// Amount returns the amount property
+func (a Authorization) Amount() int64 {
+	return a.amount
+}
+
+// CapturedAmount returns the capturedAmount property
+func (a Authorization) CapturedAmount() int64 {
+	return a.capturedAmount
+}
+
+// Status returns the status property
+func (a Authorization) Status() string {
+	return a.status
+}
+
+// TransactionID returns the transactionID property
+func (a Authorization) TransactionID() string {
+	return a.transactionID
+}
+
+// ExpiresAt returns the expiresAt property
+func (a Authorization) ExpiresAt() time.Time {
+	return a.expiresAt
+}
+
+// CreatedAt returns the createdAt property
+func (a Authorization) CreatedAt() time.Time {
+	return a.createdAt
+}
@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlaceAuthorization(t *testing.T) {
+	var (
+		compraAVista = Operation{id: CompraAVista, description: "COMPRA A VISTA", opType: Debit}
+		pagamento    = Operation{id: Pagamento, description: "PAGAMENTO", opType: Credit}
+	)
+
+	tests := []struct {
+		name      string
+		limit     int64
+		op        Operation
+		amount    int64
+		wantLimit int64
+		wantErr   error
+	}{
+		{
+			name:      "Hold amount on credit limit",
+			limit:     100,
+			op:        compraAVista,
+			amount:    30,
+			wantLimit: 70,
+		},
+		{
+			name:      "Error credit limit insufficient",
+			limit:     100,
+			op:        compraAVista,
+			amount:    150,
+			wantLimit: 100,
+			wantErr:   ErrAccountInsufficientCreditLimit,
+		},
+		{
+			name:      "Error credit operation",
+			limit:     100,
+			op:        pagamento,
+			amount:    30,
+			wantLimit: 100,
+			wantErr:   ErrOperationInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			account := NewStoredAccount("1", "123", tt.limit, time.Time{})
+
+			got, err := PlaceAuthorization("a", &account, tt.op, tt.amount, time.Time{}, time.Time{})
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if account.AvailableCreditLimit() != tt.wantLimit {
+				t.Errorf("[TestCase '%s'] Got limit: '%v' | Want limit: '%v'", tt.name, account.AvailableCreditLimit(), tt.wantLimit)
+			}
+
+			if err == nil && got.Status() != AuthorizationPending {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, got.Status(), AuthorizationPending)
+			}
+		})
+	}
+}
+
+func TestAuthorization_Capture(t *testing.T) {
+	var (
+		compraAVista = Operation{id: CompraAVista, description: "COMPRA A VISTA", opType: Debit}
+		expiresAt    = time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name          string
+		status        string
+		amount        int64
+		now           time.Time
+		wantLimit     int64
+		wantTxnAmount int64
+		wantErr       error
+	}{
+		{
+			name:          "Full capture",
+			status:        AuthorizationPending,
+			amount:        0,
+			now:           expiresAt.Add(-time.Hour),
+			wantLimit:     70,
+			wantTxnAmount: -30,
+		},
+		{
+			name:          "Partial capture releases the remaining amount",
+			status:        AuthorizationPending,
+			amount:        10,
+			now:           expiresAt.Add(-time.Hour),
+			wantLimit:     90,
+			wantTxnAmount: -10,
+		},
+		{
+			name:      "Error capture exceeds authorized amount",
+			status:    AuthorizationPending,
+			amount:    31,
+			now:       expiresAt.Add(-time.Hour),
+			wantLimit: 70,
+			wantErr:   ErrAuthorizationCaptureExceedsAmount,
+		},
+		{
+			name:      "Error capture expired authorization",
+			status:    AuthorizationPending,
+			amount:    0,
+			now:       expiresAt,
+			wantLimit: 70,
+			wantErr:   ErrAuthorizationExpired,
+		},
+		{
+			name:      "Error capture voided authorization",
+			status:    AuthorizationVoided,
+			amount:    0,
+			now:       expiresAt.Add(-time.Hour),
+			wantLimit: 70,
+			wantErr:   ErrAuthorizationNotPending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			var (
+				account       = NewStoredAccount("1", "123", 70, time.Time{})
+				authorization = NewAuthorization("a", "1", compraAVista, 30, 0, tt.status, "", expiresAt, time.Time{})
+			)
+
+			got, err := authorization.Capture(&account, "t", tt.amount, tt.now)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if account.AvailableCreditLimit() != tt.wantLimit {
+				t.Errorf("[TestCase '%s'] Got limit: '%v' | Want limit: '%v'", tt.name, account.AvailableCreditLimit(), tt.wantLimit)
+			}
+
+			if err == nil && (got.Amount() != tt.wantTxnAmount || authorization.Status() != AuthorizationCaptured) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want amount: '%v'", tt.name, got, tt.wantTxnAmount)
+			}
+		})
+	}
+}
+
+func TestAuthorization_Void(t *testing.T) {
+	var (
+		compraAVista = Operation{id: CompraAVista, description: "COMPRA A VISTA", opType: Debit}
+		expiresAt    = time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name      string
+		status    string
+		accountID string
+		wantLimit int64
+		wantErr   error
+	}{
+		{
+			name:      "Void releases the hold",
+			status:    AuthorizationPending,
+			accountID: "1",
+			wantLimit: 100,
+		},
+		{
+			name:      "Error void captured authorization",
+			status:    AuthorizationCaptured,
+			accountID: "1",
+			wantLimit: 70,
+			wantErr:   ErrAuthorizationNotPending,
+		},
+		{
+			name:      "Error void authorization of another account",
+			status:    AuthorizationPending,
+			accountID: "2",
+			wantLimit: 70,
+			wantErr:   ErrAuthorizationAccountMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			var (
+				account       = NewStoredAccount(tt.accountID, "123", 70, time.Time{})
+				authorization = NewAuthorization("a", "1", compraAVista, 30, 0, tt.status, "", expiresAt, time.Time{})
+			)
+
+			if err := authorization.Void(&account, expiresAt.Add(-time.Hour)); err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if account.AvailableCreditLimit() != tt.wantLimit {
+				t.Errorf("[TestCase '%s'] Got limit: '%v' | Want limit: '%v'", tt.name, account.AvailableCreditLimit(), tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestAuthorization_Expire(t *testing.T) {
+	var (
+		compraAVista = Operation{id: CompraAVista, description: "COMPRA A VISTA", opType: Debit}
+		expiresAt    = time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		wantLimit int64
+		wantErr   error
+	}{
+		{
+			name:      "Expire stale hold",
+			now:       expiresAt,
+			wantLimit: 100,
+		},
+		{
+			name:      "Error expire hold before expiration",
+			now:       expiresAt.Add(-time.Second),
+			wantLimit: 70,
+			wantErr:   ErrAuthorizationNotExpired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			var (
+				account       = NewStoredAccount("1", "123", 70, time.Time{})
+				authorization = NewAuthorization("a", "1", compraAVista, 30, 0, AuthorizationPending, "", expiresAt, time.Time{})
+			)
+
+			if err := authorization.Expire(&account, tt.now); err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if account.AvailableCreditLimit() != tt.wantLimit {
+				t.Errorf("[TestCase '%s'] Got limit: '%v' | Want limit: '%v'", tt.name, account.AvailableCreditLimit(), tt.wantLimit)
+			}
+		})
+	}
+}
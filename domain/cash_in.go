@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+)
+
+const (
+	CashInSourceBoleto string = "BOLETO"
+	CashInSourcePix    string = "PIX"
+)
+
+var (
+	ErrCashInAlreadyProcessed = errors.New("cash-in source already processed")
+	ErrCashInSourceInvalid    = errors.New("cash-in source invalid")
+
+	// boletoReference matches the barcode (44 digits) or the typeable line (47 or 48 digits) of a bank slip
+	boletoReference = regexp.MustCompile(`^([0-9]{44}|[0-9]{47,48})$`)
+	// pixReference matches the end-to-end id of a PIX: E + ISPB + yyyyMMddHHmm + 11 alphanumeric characters
+	pixReference = regexp.MustCompile(`^E[0-9]{8}[0-9]{12}[a-zA-Z0-9]{11}$`)
+)
+
+type (
+	// CashInCreator defines the operation of creating a cash-in entity
+	CashInCreator interface {
+		Create(context.Context, CashIn) (CashIn, error)
+	}
+
+	// CashIn defines the cash-in entity, a deposit identified by its external source
+	CashIn struct {
+		id          string
+		accountID   string
+		tenantID    string
+		source      CashInSource
+		amount      int64
+		transaction Transaction
+		createdAt   time.Time
+	}
+
+	// CashInSource defines the external source of the deposit
+	CashInSource struct {
+		sourceType string
+		reference  string
+	}
+)
+
+// NewCashInSource checks the reference against the source type and returns it
+func NewCashInSource(sourceType string, reference string) (CashInSource, error) {
+	switch {
+	case sourceType == CashInSourceBoleto && boletoReference.MatchString(reference):
+	case sourceType == CashInSourcePix && pixReference.MatchString(reference):
+	default:
+		return CashInSource{}, ErrCashInSourceInvalid
+	}
+
+	return CashInSource{
+		sourceType: sourceType,
+		reference:  reference,
+	}, nil
+}
+
+// NewCashIn deposits the amount on the account and returns the cash-in with its credit transaction
+func NewCashIn(
+	id string,
+	account *Account,
+	transactionID string,
+	source CashInSource,
+	amount int64,
+	createdAt time.Time,
+) CashIn {
+	account.Deposit(amount)
+
+	deposito, _ := NewOperation(Deposito)
+
+	return CashIn{
+		id:          id,
+		accountID:   account.ID(),
+		tenantID:    account.TenantID(),
+		source:      source,
+		amount:      amount,
+		transaction: NewTransaction(transactionID, account.ID(), deposito, amount, 0, createdAt).WithTenant(account.TenantID()),
+		createdAt:   createdAt,
+	}
+}
+
+// WithTransaction returns a copy of the cash-in with the credit transaction
+func (c CashIn) WithTransaction(transaction Transaction) CashIn {
+	c.transaction = transaction
+	return c
+}
+
+// ID returns the id property
+func (c CashIn) ID() string {
+	return c.id
+}
+
+// AccountID returns the accountID property
+func (c CashIn) AccountID() string {
+	return c.accountID
+}
+
+// TenantID returns the tenantID property
+func (c CashIn) TenantID() string {
+	return c.tenantID
+}
+
+// Source returns the source property
+func (c CashIn) Source() CashInSource {
+	return c.source
+}
+
+// Amount returns the amount property
+func (c CashIn) Amount() int64 {
+	return c.amount
+}
+
+// Transaction returns the transaction property
+func (c CashIn) Transaction() Transaction {
+	return c.transaction
+}
+
+// CreatedAt returns the createdAt property
+func (c CashIn) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// Type returns the sourceType property
+func (c CashInSource) Type() string {
+	return c.sourceType
+}
+
+// Reference returns the reference property
+func (c CashInSource) Reference() string {
+	return c.reference
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewCashInSource(t *testing.T) {
+	type args struct {
+		sourceType string
+		reference  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    CashInSource
+		wantErr error
+	}{
+		{
+			name: "Boleto typeable line",
+			args: args{
+				sourceType: CashInSourceBoleto,
+				reference:  "23793381286000000000300000000401584340000012345",
+			},
+			want: CashInSource{
+				sourceType: CashInSourceBoleto,
+				reference:  "23793381286000000000300000000401584340000012345",
+			},
+		},
+		{
+			name: "Boleto barcode",
+			args: args{
+				sourceType: CashInSourceBoleto,
+				reference:  "23798584300000123453381260000000000000000040",
+			},
+			want: CashInSource{
+				sourceType: CashInSourceBoleto,
+				reference:  "23798584300000123453381260000000000000000040",
+			},
+		},
+		{
+			name: "PIX end-to-end id",
+			args: args{
+				sourceType: CashInSourcePix,
+				reference:  "E00038166202010161750abcdefghijk",
+			},
+			want: CashInSource{
+				sourceType: CashInSourcePix,
+				reference:  "E00038166202010161750abcdefghijk",
+			},
+		},
+		{
+			name: "Error boleto with letters",
+			args: args{
+				sourceType: CashInSourceBoleto,
+				reference:  "2379338128600000000030000000040158434000001234A",
+			},
+			wantErr: ErrCashInSourceInvalid,
+		},
+		{
+			name: "Error PIX end-to-end id too short",
+			args: args{
+				sourceType: CashInSourcePix,
+				reference:  "E0003816620201016175",
+			},
+			wantErr: ErrCashInSourceInvalid,
+		},
+		{
+			name: "Error unknown source type",
+			args: args{
+				sourceType: "TED",
+				reference:  "E00038166202010161750abcdefghijk",
+			},
+			wantErr: ErrCashInSourceInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCashInSource(tt.args.sourceType, tt.args.reference)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCashIn(t *testing.T) {
+	var (
+		account   = NewStoredAccount("1", "12345678900", 1000, time.Time{})
+		source, _ = NewCashInSource(CashInSourcePix, "E00038166202010161750abcdefghijk")
+	)
+
+	got := NewCashIn("c", &account, "t", source, 250, time.Time{})
+
+	if account.AvailableCreditLimit() != 1250 {
+		t.Errorf("[TestCase '%s'] Got limit: '%v' | Want: '%v'", "Deposit on account", account.AvailableCreditLimit(), 1250)
+	}
+
+	if got.Transaction().Amount() != 250 || got.Transaction().Operation().ID() != Deposito {
+		t.Errorf("[TestCase '%s'] Got transaction: '%+v' | Want amount: '%v'", "Credit transaction", got.Transaction(), 250)
+	}
+}
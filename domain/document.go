@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	CPF  string = "CPF"
+	CNPJ string = "CNPJ"
+)
+
+var ErrDocumentInvalid = errors.New("document invalid")
+
+// Document defines document property
+type Document struct {
+	number  string
+	docType string
+}
+
+// NewDocument creates new Document from a CPF or CNPJ, with or without punctuation, detecting its type
+// by the number of digits and checking its check digits. The number is kept with its digits only
+func NewDocument(number string) (Document, error) {
+	var digits strings.Builder
+	for _, r := range strings.TrimSpace(number) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '.' || r == '-' || r == '/':
+		default:
+			return Document{}, ErrDocumentInvalid
+		}
+	}
+
+	var normalized = digits.String()
+	switch {
+	case len(normalized) == 11 && validCheckDigits(normalized, 10, 11):
+		return Document{number: normalized, docType: CPF}, nil
+	case len(normalized) == 14 && validCheckDigits(normalized, 5, 6):
+		return Document{number: normalized, docType: CNPJ}, nil
+	default:
+		return Document{}, ErrDocumentInvalid
+	}
+}
+
+// validCheckDigits checks the last two digits of a CPF or CNPJ, computed by the modulo 11 of the digits
+// before each one, weighted from the first weight down to 2 and then, for a CNPJ, again from 9
+func validCheckDigits(digits string, firstWeight int, secondWeight int) bool {
+	if strings.Count(digits, digits[:1]) == len(digits) {
+		return false
+	}
+
+	return checkDigit(digits[:len(digits)-2], firstWeight) == digits[len(digits)-2] &&
+		checkDigit(digits[:len(digits)-1], secondWeight) == digits[len(digits)-1]
+}
+
+func checkDigit(digits string, weight int) byte {
+	var sum int
+	for i := 0; i < len(digits); i++ {
+		sum += int(digits[i]-'0') * weight
+		if weight--; weight < 2 {
+			weight = 9
+		}
+	}
+
+	if rest := sum % 11; rest >= 2 {
+		return byte('0' + 11 - rest)
+	}
+
+	return '0'
+}
+
+// Number returns the number property
+func (d Document) Number() string {
+	return d.number
+}
+
+// Type returns the docType property, CPF or CNPJ, empty for the documents stored before they were validated
+func (d Document) Type() string {
+	return d.docType
+}
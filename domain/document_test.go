@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewDocument(t *testing.T) {
+	tests := []struct {
+		name       string
+		number     string
+		wantNumber string
+		wantType   string
+		wantErr    error
+	}{
+		{
+			name:       "CPF",
+			number:     "12345678909",
+			wantNumber: "12345678909",
+			wantType:   CPF,
+		},
+		{
+			name:       "CPF with punctuation",
+			number:     "123.456.789-09",
+			wantNumber: "12345678909",
+			wantType:   CPF,
+		},
+		{
+			name:       "CNPJ",
+			number:     "11222333000181",
+			wantNumber: "11222333000181",
+			wantType:   CNPJ,
+		},
+		{
+			name:       "CNPJ with punctuation",
+			number:     " 11.222.333/0001-81 ",
+			wantNumber: "11222333000181",
+			wantType:   CNPJ,
+		},
+		{
+			name:       "CNPJ with check digit zero",
+			number:     "00.000.000/0001-91",
+			wantNumber: "00000000000191",
+			wantType:   CNPJ,
+		},
+		{
+			name:    "Error CPF check digit",
+			number:  "123.456.789-00",
+			wantErr: ErrDocumentInvalid,
+		},
+		{
+			name:    "Error CNPJ check digit",
+			number:  "11.222.333/0001-80",
+			wantErr: ErrDocumentInvalid,
+		},
+		{
+			name:    "Error repeated digits",
+			number:  "111.111.111-11",
+			wantErr: ErrDocumentInvalid,
+		},
+		{
+			name:    "Error letters",
+			number:  "abc",
+			wantErr: ErrDocumentInvalid,
+		},
+		{
+			name:    "Error length",
+			number:  "1234567890",
+			wantErr: ErrDocumentInvalid,
+		},
+		{
+			name:    "Error empty",
+			number:  "",
+			wantErr: ErrDocumentInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDocument(tt.number)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if got.Number() != tt.wantNumber || got.Type() != tt.wantType {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%s %s' | Want: '%s %s'",
+					tt.name,
+					got.Type(),
+					got.Number(),
+					tt.wantType,
+					tt.wantNumber,
+				)
+			}
+		})
+	}
+}
+
+func TestNewAccount_Document(t *testing.T) {
+	account, err := NewAccount("1", "123.456.789-09", 0, time.Time{})
+	if got := account.Document(); err != nil || got.Number() != "12345678909" || got.Type() != CPF {
+		t.Errorf("[TestCase '%s'] Got: '%s %s' | Want: '%s %s'", "Valid document", got.Type(), got.Number(), CPF, "12345678909")
+	}
+
+	if _, err = NewAccount("1", "abc", 0, time.Time{}); err != ErrDocumentInvalid {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Error invalid document", err, ErrDocumentInvalid)
+	}
+
+	if got := NewStoredAccount("1", "123.456.789-09", 0, time.Time{}).Document(); got.Number() != "12345678909" || got.Type() != CPF {
+		t.Errorf("[TestCase '%s'] Got: '%s %s' | Want: '%s %s'", "Stored valid document", got.Type(), got.Number(), CPF, "12345678909")
+	}
+
+	if got := NewStoredAccount("1", "abc", 0, time.Time{}).Document(); got.Number() != "abc" || got.Type() != "" {
+		t.Errorf("[TestCase '%s'] Got: '%s %s' | Want: '%s %s'", "Stored document", got.Type(), got.Number(), "", "abc")
+	}
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const (
+	// EventAccountCreated is published when an account is opened
+	EventAccountCreated = "AccountCreated"
+	// EventTransactionCreated is published for every transaction, including reversals, transfers and deposits
+	EventTransactionCreated = "TransactionCreated"
+	// EventTransactionRejected is published when a transaction is refused for lack of available credit limit
+	EventTransactionRejected = "TransactionRejected"
+)
+
+type (
+	// EventPublisher defines the delivery of an event to the downstream consumers
+	EventPublisher interface {
+		Publish(context.Context, Event) error
+	}
+
+	// OutboxCreator defines the operation of recording an event in the outbox
+	OutboxCreator interface {
+		Create(context.Context, Event) error
+	}
+
+	// OutboxFinder defines the search operation for the events not yet published, oldest first,
+	// leaving out the accounts with an event postponed past the given time
+	OutboxFinder interface {
+		FindPending(context.Context, time.Time, int) ([]Event, error)
+	}
+
+	// OutboxUpdater defines the operation of marking an event as published
+	OutboxUpdater interface {
+		MarkPublished(context.Context, string, time.Time) error
+	}
+
+	// OutboxPostponer defines the operation of holding back an event, and the following events of its
+	// account, until the given time
+	OutboxPostponer interface {
+		Postpone(context.Context, string, time.Time) error
+	}
+
+	// Event defines a change of an account recorded in the outbox to be published
+	Event struct {
+		id         string
+		eventType  string
+		accountID  string
+		tenantID   string
+		payload    []byte
+		occurredAt time.Time
+	}
+
+	accountCreatedPayload struct {
+		ID                   string    `json:"id"`
+		DocumentNumber       string    `json:"document_number"`
+		AvailableCreditLimit int64     `json:"available_credit_limit"`
+		CreatedAt            time.Time `json:"created_at"`
+	}
+
+	transactionCreatedPayload struct {
+		ID                    string    `json:"id"`
+		AccountID             string    `json:"account_id"`
+		OperationID           string    `json:"operation_id"`
+		OperationType         string    `json:"operation_type"`
+		Amount                int64     `json:"amount"`
+		OriginalTransactionID string    `json:"original_transaction_id,omitempty"`
+		CreatedAt             time.Time `json:"created_at"`
+	}
+
+	transactionRejectedPayload struct {
+		AccountID     string    `json:"account_id"`
+		OperationID   string    `json:"operation_id"`
+		OperationType string    `json:"operation_type"`
+		Amount        int64     `json:"amount"`
+		Reason        string    `json:"reason"`
+		RejectedAt    time.Time `json:"rejected_at"`
+	}
+)
+
+// NewEvent creates new Event
+func NewEvent(id string, eventType string, accountID string, payload []byte, occurredAt time.Time) Event {
+	return Event{
+		id:         id,
+		eventType:  eventType,
+		accountID:  accountID,
+		payload:    payload,
+		occurredAt: occurredAt,
+	}
+}
+
+// NewAccountCreatedEvent creates new AccountCreated Event
+func NewAccountCreatedEvent(id string, account Account) Event {
+	payload, _ := json.Marshal(accountCreatedPayload{
+		ID:                   account.ID(),
+		DocumentNumber:       account.Document().Number(),
+		AvailableCreditLimit: account.AvailableCreditLimit(),
+		CreatedAt:            account.CreatedAt(),
+	})
+
+	return NewEvent(id, EventAccountCreated, account.ID(), payload, account.CreatedAt()).WithTenant(account.TenantID())
+}
+
+// NewTransactionCreatedEvent creates new TransactionCreated Event
+func NewTransactionCreatedEvent(id string, transaction Transaction) Event {
+	payload, _ := json.Marshal(transactionCreatedPayload{
+		ID:                    transaction.ID(),
+		AccountID:             transaction.AccountID(),
+		OperationID:           transaction.Operation().ID(),
+		OperationType:         transaction.Operation().Type(),
+		Amount:                transaction.Amount(),
+		OriginalTransactionID: transaction.OriginalTransactionID(),
+		CreatedAt:             transaction.CreatedAt(),
+	})
+
+	return NewEvent(id, EventTransactionCreated, transaction.AccountID(), payload, transaction.CreatedAt()).
+		WithTenant(transaction.TenantID())
+}
+
+// NewTransactionRejectedEvent creates new TransactionRejected Event for the amount refused to the account
+func NewTransactionRejectedEvent(
+	id string,
+	accountID string,
+	op Operation,
+	amount int64,
+	reason error,
+	rejectedAt time.Time,
+) Event {
+	payload, _ := json.Marshal(transactionRejectedPayload{
+		AccountID:     accountID,
+		OperationID:   op.ID(),
+		OperationType: op.Type(),
+		Amount:        amount,
+		Reason:        reason.Error(),
+		RejectedAt:    rejectedAt,
+	})
+
+	return NewEvent(id, EventTransactionRejected, accountID, payload, rejectedAt)
+}
+
+// WithTenant returns a copy of the event of an account owned by the tenant
+func (e Event) WithTenant(tenantID string) Event {
+	e.tenantID = tenantID
+	return e
+}
+
+// ID returns the id property
+func (e Event) ID() string {
+	return e.id
+}
+
+// Type returns the eventType property
+func (e Event) Type() string {
+	return e.eventType
+}
+
+// AccountID returns the accountID property, which orders the delivery of the events
+func (e Event) AccountID() string {
+	return e.accountID
+}
+
+// TenantID returns the tenantID property, the tenant of the account
+func (e Event) TenantID() string {
+	return e.tenantID
+}
+
+// Payload returns the JSON representation of the entity that changed
+func (e Event) Payload() []byte {
+	return e.payload
+}
+
+// OccurredAt returns the occurredAt property
+func (e Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewEvent(t *testing.T) {
+	var (
+		compraAVista, _ = NewOperation(CompraAVista)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name          string
+		event         Event
+		wantType      string
+		wantAccountID string
+		wantPayload   string
+	}{
+		{
+			name:          "Account created",
+			event:         NewAccountCreatedEvent("e1", NewStoredAccount("1", "12345678900", 1000, now)),
+			wantType:      EventAccountCreated,
+			wantAccountID: "1",
+			wantPayload:   `{"id":"1","document_number":"12345678900","available_credit_limit":1000,"created_at":"2026-10-18T12:00:00Z"}`,
+		},
+		{
+			name:          "Transaction created",
+			event:         NewTransactionCreatedEvent("e2", NewTransaction("t1", "1", compraAVista, 100, -100, now)),
+			wantType:      EventTransactionCreated,
+			wantAccountID: "1",
+			wantPayload:   `{"id":"t1","account_id":"1","operation_id":"1","operation_type":"DEBIT","amount":-100,"created_at":"2026-10-18T12:00:00Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.event.Type() != tt.wantType {
+				t.Errorf("[TestCase '%s'] Got type: '%v' | Want: '%v'", tt.name, tt.event.Type(), tt.wantType)
+			}
+
+			if tt.event.AccountID() != tt.wantAccountID {
+				t.Errorf("[TestCase '%s'] Got account: '%v' | Want: '%v'", tt.name, tt.event.AccountID(), tt.wantAccountID)
+			}
+
+			if !tt.event.OccurredAt().Equal(now) {
+				t.Errorf("[TestCase '%s'] Got occurred at: '%v' | Want: '%v'", tt.name, tt.event.OccurredAt(), now)
+			}
+
+			if string(tt.event.Payload()) != tt.wantPayload {
+				t.Errorf("[TestCase '%s'] Got payload: '%s' | Want: '%s'", tt.name, tt.event.Payload(), tt.wantPayload)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyAlreadyExists = errors.New("idempotency key already exists")
+	ErrIdempotencyKeyNotFound      = errors.New("idempotency key not found")
+	ErrIdempotencyKeyReused        = errors.New("idempotency key already used with a different request")
+	ErrIdempotencyKeyInProgress    = errors.New("request with the same idempotency key is in progress")
+)
+
+type (
+	// IdempotencyKeyCreator defines the operation of reserving an idempotency key
+	IdempotencyKeyCreator interface {
+		Create(context.Context, IdempotencyKey) (IdempotencyKey, error)
+	}
+
+	// IdempotencyKeyFinder defines the search operation for an idempotency key of the tenant
+	IdempotencyKeyFinder interface {
+		FindByKey(context.Context, string) (IdempotencyKey, error)
+	}
+
+	// IdempotencyKeyUpdater defines the operation of storing the response of an idempotency key
+	IdempotencyKeyUpdater interface {
+		Complete(context.Context, IdempotencyKey) error
+	}
+
+	// IdempotencyKeyReclaimer defines the operation of taking over a key of the same request still in
+	// progress since before the time, renewing its reservation. It fails with ErrIdempotencyKeyInProgress
+	// when the key was completed or renewed meanwhile
+	IdempotencyKeyReclaimer interface {
+		Reclaim(context.Context, IdempotencyKey, time.Time) error
+	}
+
+	// IdempotencyKeyDeleter defines the operation of releasing an idempotency key of the tenant
+	IdempotencyKeyDeleter interface {
+		Delete(context.Context, string) error
+	}
+
+	// IdempotencyKey defines the idempotency key entity, unique within its tenant
+	IdempotencyKey struct {
+		key         string
+		tenantID    string
+		fingerprint string
+		statusCode  int
+		body        []byte
+		createdAt   time.Time
+	}
+)
+
+// NewIdempotencyKey creates new IdempotencyKey. A key without status code is still in progress
+func NewIdempotencyKey(key string, fingerprint string, statusCode int, body []byte, createdAt time.Time) IdempotencyKey {
+	return IdempotencyKey{
+		key:         key,
+		fingerprint: fingerprint,
+		statusCode:  statusCode,
+		body:        body,
+		createdAt:   createdAt,
+	}
+}
+
+// WithTenant returns a copy of the key owned by the tenant
+func (k IdempotencyKey) WithTenant(tenantID string) IdempotencyKey {
+	k.tenantID = tenantID
+	return k
+}
+
+// Complete stores the response of the request
+func (k *IdempotencyKey) Complete(statusCode int, body []byte) {
+	k.statusCode = statusCode
+	k.body = body
+}
+
+// Match checks whether the stored response can be replayed for the request fingerprint
+func (k IdempotencyKey) Match(fingerprint string) error {
+	if k.fingerprint != fingerprint {
+		return ErrIdempotencyKeyReused
+	}
+
+	if !k.Completed() {
+		return ErrIdempotencyKeyInProgress
+	}
+
+	return nil
+}
+
+// Stale reports whether the request is still in progress after the ttl, most likely abandoned by a server
+// that stopped before storing its response
+func (k IdempotencyKey) Stale(now time.Time, ttl time.Duration) bool {
+	return !k.Completed() && !now.Before(k.createdAt.Add(ttl))
+}
+
+// Completed reports whether the response of the request was stored
+func (k IdempotencyKey) Completed() bool {
+	return k.statusCode != 0
+}
+
+// Key returns the key property
+func (k IdempotencyKey) Key() string {
+	return k.key
+}
+
+// TenantID returns the tenantID property
+func (k IdempotencyKey) TenantID() string {
+	return k.tenantID
+}
+
+// Fingerprint returns the fingerprint property
+func (k IdempotencyKey) Fingerprint() string {
+	return k.fingerprint
+}
+
+// StatusCode returns the statusCode property
+func (k IdempotencyKey) StatusCode() int {
+	return k.statusCode
+}
+
+// Body returns the body property
+func (k IdempotencyKey) Body() []byte {
+	return k.body
+}
+
+// CreatedAt returns the createdAt property
+func (k IdempotencyKey) CreatedAt() time.Time {
+	return k.createdAt
+}
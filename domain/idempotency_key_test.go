@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIdempotencyKey_Match(t *testing.T) {
+	type fields struct {
+		key         string
+		fingerprint string
+		statusCode  int
+		body        []byte
+	}
+	type args struct {
+		fingerprint string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "Replay completed request",
+			fields: fields{
+				key:         "6f1c5f4e-4f5a-4d36-a0b3-7a1f0d2a2c11",
+				fingerprint: "abc",
+				statusCode:  201,
+				body:        []byte(`{}`),
+			},
+			args: args{
+				fingerprint: "abc",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Error request in progress",
+			fields: fields{
+				key:         "6f1c5f4e-4f5a-4d36-a0b3-7a1f0d2a2c11",
+				fingerprint: "abc",
+			},
+			args: args{
+				fingerprint: "abc",
+			},
+			wantErr: ErrIdempotencyKeyInProgress,
+		},
+		{
+			name: "Error key reused with different request",
+			fields: fields{
+				key:         "6f1c5f4e-4f5a-4d36-a0b3-7a1f0d2a2c11",
+				fingerprint: "abc",
+				statusCode:  201,
+				body:        []byte(`{}`),
+			},
+			args: args{
+				fingerprint: "def",
+			},
+			wantErr: ErrIdempotencyKeyReused,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			key := NewIdempotencyKey(
+				tt.fields.key,
+				tt.fields.fingerprint,
+				tt.fields.statusCode,
+				tt.fields.body,
+				time.Time{},
+			)
+
+			if err := key.Match(tt.args.fingerprint); err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIdempotencyKey_Stale(t *testing.T) {
+	var now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		key  IdempotencyKey
+		want bool
+	}{
+		{
+			name: "In progress within the ttl",
+			key:  NewIdempotencyKey("1", "abc", 0, nil, now.Add(-time.Second)),
+			want: false,
+		},
+		{
+			name: "In progress after the ttl",
+			key:  NewIdempotencyKey("1", "abc", 0, nil, now.Add(-time.Minute)),
+			want: true,
+		},
+		{
+			name: "Completed after the ttl",
+			key:  NewIdempotencyKey("1", "abc", 201, []byte(`{}`), now.Add(-time.Hour)),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.key.Stale(now, time.Minute); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
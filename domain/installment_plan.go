@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	MinInstallments = 2
+	MaxInstallments = 24
+)
+
+const (
+	// RemainderOnFirst puts the leftover cents of the split on the first installment
+	RemainderOnFirst InstallmentRemainder = iota
+	// RemainderOnLast puts the leftover cents of the split on the last installment
+	RemainderOnLast
+)
+
+var (
+	ErrInstallmentsInvalid = errors.New("installments invalid")
+)
+
+type (
+	// InstallmentRemainder defines which installment receives the leftover cents of the split
+	InstallmentRemainder int
+
+	// InstallmentPlan defines the installment plan entity
+	InstallmentPlan struct {
+		installments []Installment
+	}
+
+	// Installment defines the installment property
+	Installment struct {
+		number  int
+		amount  int64
+		dueDate time.Time
+	}
+)
+
+// NewInstallmentPlan creates new InstallmentPlan
+func NewInstallmentPlan(installments []Installment) InstallmentPlan {
+	return InstallmentPlan{
+		installments: installments,
+	}
+}
+
+// NewInstallment creates new Installment
+func NewInstallment(number int, amount int64, dueDate time.Time) Installment {
+	return Installment{
+		number:  number,
+		amount:  amount,
+		dueDate: dueDate,
+	}
+}
+
+// SplitInstallments splits the amount into monthly installments, the first one due a month after the purchase
+func SplitInstallments(amount int64, count int, purchasedAt time.Time, remainder InstallmentRemainder) (InstallmentPlan, error) {
+	if count < MinInstallments || count > MaxInstallments || amount < int64(count) {
+		return InstallmentPlan{}, ErrInstallmentsInvalid
+	}
+
+	var (
+		base     = amount / int64(count)
+		leftover = amount % int64(count)
+		plan     = InstallmentPlan{installments: make([]Installment, 0, count)}
+	)
+
+	for n := 1; n <= count; n++ {
+		installment := Installment{
+			number:  n,
+			amount:  base,
+			dueDate: addMonths(purchasedAt, n),
+		}
+
+		if (remainder == RemainderOnFirst && n == 1) || (remainder == RemainderOnLast && n == count) {
+			installment.amount += leftover
+		}
+
+		plan.installments = append(plan.installments, installment)
+	}
+
+	return plan, nil
+}
+
+// Installments returns the installments property
+func (p InstallmentPlan) Installments() []Installment {
+	return p.installments
+}
+
+// IsZero reports whether the plan has no installments
+func (p InstallmentPlan) IsZero() bool {
+	return len(p.installments) == 0
+}
+
+// Number returns the number property
+func (i Installment) Number() int {
+	return i.number
+}
+
+// Amount returns the amount property
+func (i Installment) Amount() int64 {
+	return i.amount
+}
+
+// DueDate returns the dueDate property
+func (i Installment) DueDate() time.Time {
+	return i.dueDate
+}
+
+// addMonths moves the date forward keeping the day of month, clamped to the last day of shorter months
+func addMonths(t time.Time, months int) time.Time {
+	var (
+		first = time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+		last  = first.AddDate(0, 1, -1).Day()
+	)
+
+	return time.Date(first.Year(), first.Month(), min(t.Day(), last), 0, 0, 0, 0, t.Location())
+}
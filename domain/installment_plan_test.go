@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSplitInstallments(t *testing.T) {
+	var purchasedAt = time.Date(2020, 1, 31, 15, 4, 5, 0, time.UTC)
+
+	type args struct {
+		amount    int64
+		count     int
+		remainder InstallmentRemainder
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    InstallmentPlan
+		wantErr bool
+	}{
+		{
+			name: "Split leftover cents on the first installment",
+			args: args{
+				amount:    10000,
+				count:     3,
+				remainder: RemainderOnFirst,
+			},
+			want: InstallmentPlan{
+				installments: []Installment{
+					{number: 1, amount: 3334, dueDate: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+					{number: 2, amount: 3333, dueDate: time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC)},
+					{number: 3, amount: 3333, dueDate: time.Date(2020, 4, 30, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Split leftover cents on the last installment",
+			args: args{
+				amount:    10001,
+				count:     2,
+				remainder: RemainderOnLast,
+			},
+			want: InstallmentPlan{
+				installments: []Installment{
+					{number: 1, amount: 5000, dueDate: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+					{number: 2, amount: 5001, dueDate: time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error single installment",
+			args: args{
+				amount: 10000,
+				count:  1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error more installments than allowed",
+			args: args{
+				amount: 10000,
+				count:  MaxInstallments + 1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error installment lower than a cent",
+			args: args{
+				amount: 2,
+				count:  3,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			got, err := SplitInstallments(tt.args.amount, tt.args.count, purchasedAt, tt.args.remainder)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransaction_SplitInstallments(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation Operation
+		count     int
+		wantErr   bool
+	}{
+		{
+			name:      "Split compra parcelada",
+			operation: Operation{id: CompraParcelada, description: "COMPRA PARCELADA", opType: Debit},
+			count:     3,
+			wantErr:   false,
+		},
+		{
+			name:      "Error split compra a vista",
+			operation: Operation{id: CompraAVista, description: "COMPRA A VISTA", opType: Debit},
+			count:     3,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			transaction := NewTransaction("1", "1", tt.operation, 9000, 0, time.Time{})
+			if err := transaction.SplitInstallments(tt.count, RemainderOnFirst); (err != nil) != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(transaction.InstallmentPlan().Installments()) != tt.count {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, transaction.InstallmentPlan(), tt.count)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// LedgerIssuer is the ledger account of the issuer, which grants the credit limits and funds the purchases
+	LedgerIssuer = "issuer"
+	// LedgerSettlement is the ledger account of the money moving in and out of the accounts, such as payments
+	// and deposits, and of the transfers between accounts
+	LedgerSettlement = "settlement"
+
+	ledgerCustomerPrefix = "customer:"
+
+	// openingDescription describes the journal entry that grants the initial credit limit of an account
+	openingDescription = "OPENING LIMIT"
+)
+
+var (
+	ErrJournalEntryUnbalanced = errors.New("journal entry unbalanced")
+)
+
+type (
+	// LedgerBalanceFinder defines the search operation for the ledger balance of every account of the tenant
+	LedgerBalanceFinder interface {
+		FindAccountBalances(context.Context) ([]AccountLedgerBalance, error)
+	}
+
+	// LedgerLimitFinder defines the search operation for the credit limit of an account summed from the
+	// postings of its customer ledger account recorded before a moment
+	LedgerLimitFinder interface {
+		FindLimitBefore(ctx context.Context, accountID string, before time.Time) (int64, error)
+	}
+
+	// JournalEntry defines a set of balanced postings recorded together in the ledger
+	JournalEntry struct {
+		id            string
+		accountID     string
+		transactionID string
+		description   string
+		postings      []Posting
+		createdAt     time.Time
+	}
+
+	// Posting defines the signed amount moved into a ledger account
+	Posting struct {
+		ledgerAccount string
+		amount        int64
+	}
+
+	// AccountLedgerBalance defines the credit limit of an account next to the limit recomputed from its postings
+	AccountLedgerBalance struct {
+		accountID     string
+		limit         int64
+		ledgerBalance int64
+		held          int64
+	}
+)
+
+// NewOpeningJournalEntry records the issuer granting the initial credit limit of the account
+func NewOpeningJournalEntry(id string, account Account) JournalEntry {
+	return JournalEntry{
+		id:          id,
+		accountID:   account.ID(),
+		description: openingDescription,
+		postings: []Posting{
+			NewPosting(CustomerLedgerAccount(account.ID()), account.AvailableCreditLimit()),
+			NewPosting(LedgerIssuer, -account.AvailableCreditLimit()),
+		},
+		createdAt: account.CreatedAt(),
+	}
+}
+
+// NewTransactionJournalEntry records the change of the credit limit made by the transaction against
+// the issuer, for purchases, withdrawals and their reversals, or against the settlement otherwise
+func NewTransactionJournalEntry(id string, transaction Transaction) JournalEntry {
+	return JournalEntry{
+		id:            id,
+		accountID:     transaction.AccountID(),
+		transactionID: transaction.ID(),
+		description:   transaction.Operation().Description(),
+		postings: []Posting{
+			NewPosting(CustomerLedgerAccount(transaction.AccountID()), transaction.Amount()),
+			NewPosting(counterpartLedgerAccount(transaction.Operation()), -transaction.Amount()),
+		},
+		createdAt: transaction.CreatedAt(),
+	}
+}
+
+// CustomerLedgerAccount returns the ledger account that holds the credit limit of the account
+func CustomerLedgerAccount(accountID string) string {
+	return ledgerCustomerPrefix + accountID
+}
+
+func counterpartLedgerAccount(op Operation) string {
+	switch {
+	case op.ID() == Estorno:
+		return LedgerIssuer
+	case op.ID() == TransferenciaEnviada, op.ID() == TransferenciaRecebida:
+		return LedgerSettlement
+	case op.Type() == Credit:
+		return LedgerSettlement
+	default:
+		return LedgerIssuer
+	}
+}
+
+// Balanced reports whether the entry has at least two postings and they sum to zero
+func (j JournalEntry) Balanced() bool {
+	var sum int64
+	for _, posting := range j.postings {
+		sum += posting.amount
+	}
+
+	return len(j.postings) >= 2 && sum == 0
+}
+
+// ID returns the id property
+func (j JournalEntry) ID() string {
+	return j.id
+}
+
+// AccountID returns the accountID property
+func (j JournalEntry) AccountID() string {
+	return j.accountID
+}
+
+// TransactionID returns the transactionID property, empty for the opening entry of an account
+func (j JournalEntry) TransactionID() string {
+	return j.transactionID
+}
+
+// Description returns the description property
+func (j JournalEntry) Description() string {
+	return j.description
+}
+
+// Postings returns the postings property
+func (j JournalEntry) Postings() []Posting {
+	return j.postings
+}
+
+// CreatedAt returns the createdAt property
+func (j JournalEntry) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+// NewPosting creates new Posting
+func NewPosting(ledgerAccount string, amount int64) Posting {
+	return Posting{
+		ledgerAccount: ledgerAccount,
+		amount:        amount,
+	}
+}
+
+// LedgerAccount returns the ledgerAccount property
+func (p Posting) LedgerAccount() string {
+	return p.ledgerAccount
+}
+
+// Amount returns the amount property
+func (p Posting) Amount() int64 {
+	return p.amount
+}
+
+// NewAccountLedgerBalance creates new AccountLedgerBalance from the available credit limit of the account,
+// the sum of the postings of its customer ledger account and the amount held by pending authorizations
+func NewAccountLedgerBalance(accountID string, limit int64, ledgerBalance int64, held int64) AccountLedgerBalance {
+	return AccountLedgerBalance{
+		accountID:     accountID,
+		limit:         limit,
+		ledgerBalance: ledgerBalance,
+		held:          held,
+	}
+}
+
+// AccountID returns the accountID property
+func (a AccountLedgerBalance) AccountID() string {
+	return a.accountID
+}
+
+// Limit returns the available credit limit stored in the account
+func (a AccountLedgerBalance) Limit() int64 {
+	return a.limit
+}
+
+// LedgerLimit returns the available credit limit recomputed from the postings. The holds of pending
+// authorizations reduce the limit without a transaction, so they are subtracted from the postings
+func (a AccountLedgerBalance) LedgerLimit() int64 {
+	return a.ledgerBalance - a.held
+}
+
+// Drift returns how much the stored credit limit differs from the ledger
+func (a AccountLedgerBalance) Drift() int64 {
+	return a.limit - a.LedgerLimit()
+}
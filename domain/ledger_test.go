@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewTransactionJournalEntry(t *testing.T) {
+	tests := []struct {
+		name         string
+		operationID  string
+		amount       int64
+		wantPostings []Posting
+	}{
+		{
+			name:        "Purchase against the issuer",
+			operationID: CompraAVista,
+			amount:      100,
+			wantPostings: []Posting{
+				NewPosting("customer:1", -100),
+				NewPosting(LedgerIssuer, 100),
+			},
+		},
+		{
+			name:        "Reversal against the issuer",
+			operationID: Estorno,
+			amount:      100,
+			wantPostings: []Posting{
+				NewPosting("customer:1", 100),
+				NewPosting(LedgerIssuer, -100),
+			},
+		},
+		{
+			name:        "Payment against the settlement",
+			operationID: Pagamento,
+			amount:      100,
+			wantPostings: []Posting{
+				NewPosting("customer:1", 100),
+				NewPosting(LedgerSettlement, -100),
+			},
+		},
+		{
+			name:        "Sent transfer against the settlement",
+			operationID: TransferenciaEnviada,
+			amount:      100,
+			wantPostings: []Posting{
+				NewPosting("customer:1", -100),
+				NewPosting(LedgerSettlement, 100),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, _ := NewOperation(tt.operationID)
+			got := NewTransactionJournalEntry("j", NewTransaction("t", "1", op, tt.amount, 0, time.Time{}))
+
+			if !reflect.DeepEqual(got.Postings(), tt.wantPostings) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Postings(), tt.wantPostings)
+			}
+
+			if !got.Balanced() {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Balanced(), true)
+			}
+		})
+	}
+}
+
+func TestNewOpeningJournalEntry(t *testing.T) {
+	got := NewOpeningJournalEntry("j", NewStoredAccount("1", "12345678900", 1000, time.Time{}))
+
+	var want = []Posting{NewPosting("customer:1", 1000), NewPosting(LedgerIssuer, -1000)}
+	if !reflect.DeepEqual(got.Postings(), want) || !got.Balanced() {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Opening limit", got.Postings(), want)
+	}
+}
+
+func TestAccountLedgerBalance_Drift(t *testing.T) {
+	tests := []struct {
+		name    string
+		balance AccountLedgerBalance
+		want    int64
+	}{
+		{
+			name:    "Limit matches the postings",
+			balance: NewAccountLedgerBalance("1", 700, 700, 0),
+			want:    0,
+		},
+		{
+			name:    "Limit matches the postings minus the pending holds",
+			balance: NewAccountLedgerBalance("1", 500, 700, 200),
+			want:    0,
+		},
+		{
+			name:    "Limit above the postings",
+			balance: NewAccountLedgerBalance("1", 800, 700, 0),
+			want:    100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.balance.Drift(); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
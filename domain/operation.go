@@ -1,6 +1,9 @@
 package domain
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 const (
 	Debit  string = "DEBIT"
@@ -10,46 +13,123 @@ const (
 	CompraParcelada string = "2"
 	Saque           string = "3"
 	Pagamento       string = "4"
+	Estorno         string = "5"
+
+	TransferenciaEnviada  string = "6"
+	TransferenciaRecebida string = "7"
+
+	Deposito string = "8"
 )
 
 var (
-	ErrOperationInvalid = errors.New("operation type invalid")
+	ErrOperationInvalid       = errors.New("operation type invalid")
+	ErrOperationNotFound      = errors.New("operation not found")
+	ErrOperationDisabled      = errors.New("operation disabled")
+	ErrOperationAlreadyExists = errors.New("operation already exists")
+	ErrOperationInternal      = errors.New("operation used by internal flows")
 )
 
 type (
+	// OperationCreator defines the operation of creating an operation entity
+	OperationCreator interface {
+		Create(context.Context, Operation) (Operation, error)
+	}
+
+	// OperationFinder defines the search operation for an operation entity
+	OperationFinder interface {
+		FindByID(context.Context, string) (Operation, error)
+	}
+
+	// OperationLister defines the search operation for all the operation entities
+	OperationLister interface {
+		FindAll(context.Context) ([]Operation, error)
+	}
+
+	// OperationUpdater defines the update operation for an operation entity
+	OperationUpdater interface {
+		Update(context.Context, Operation) error
+	}
+
+	// OperationRepository defines the operation catalog
+	OperationRepository interface {
+		OperationCreator
+		OperationFinder
+		OperationLister
+		OperationUpdater
+	}
+
 	// Operation defines the operation entity
 	Operation struct {
 		id          string
 		description string
 		opType      string
+		enabled     bool
 	}
 )
 
-// NewOperation checks if there is an operation and returns it
-func NewOperation(id string) (Operation, error) {
-	var operations = map[string]Operation{
-		CompraAVista: {
-			id:          CompraAVista,
-			description: "COMPRA A VISTA",
-			opType:      Debit,
-		},
-		CompraParcelada: {
-			id:          CompraParcelada,
-			description: "COMPRA PARCELADA",
-			opType:      Debit,
-		},
-		Saque: {
-			id:          Saque,
-			description: "SAQUE",
-			opType:      Debit,
-		},
-		Pagamento: {
-			id:          Pagamento,
-			description: "PAGAMENTO",
-			opType:      Credit,
-		},
-	}
+// operations are the built-in operations seeded on the catalog and used by the internal flows
+var operations = map[string]Operation{
+	CompraAVista: {
+		id:          CompraAVista,
+		description: "COMPRA A VISTA",
+		opType:      Debit,
+		enabled:     true,
+	},
+	CompraParcelada: {
+		id:          CompraParcelada,
+		description: "COMPRA PARCELADA",
+		opType:      Debit,
+		enabled:     true,
+	},
+	Saque: {
+		id:          Saque,
+		description: "SAQUE",
+		opType:      Debit,
+		enabled:     true,
+	},
+	Pagamento: {
+		id:          Pagamento,
+		description: "PAGAMENTO",
+		opType:      Credit,
+		enabled:     true,
+	},
+	Estorno: {
+		id:          Estorno,
+		description: "ESTORNO",
+		opType:      Credit,
+		enabled:     true,
+	},
+	TransferenciaEnviada: {
+		id:          TransferenciaEnviada,
+		description: "TRANSFERENCIA ENVIADA",
+		opType:      Debit,
+		enabled:     true,
+	},
+	TransferenciaRecebida: {
+		id:          TransferenciaRecebida,
+		description: "TRANSFERENCIA RECEBIDA",
+		opType:      Credit,
+		enabled:     true,
+	},
+	Deposito: {
+		id:          Deposito,
+		description: "DEPOSITO",
+		opType:      Credit,
+		enabled:     true,
+	},
+}
+
+// internalOperations are the built-in operations the system creates transactions with on its own,
+// regardless of the operation the client chose, so they are never disabled
+var internalOperations = map[string]bool{
+	Estorno:               true,
+	TransferenciaEnviada:  true,
+	TransferenciaRecebida: true,
+	Deposito:              true,
+}
 
+// NewOperation checks if there is a built-in operation and returns it
+func NewOperation(id string) (Operation, error) {
 	operation, exists := operations[id]
 	if exists {
 		return operation, nil
@@ -58,6 +138,31 @@ func NewOperation(id string) (Operation, error) {
 	return Operation{}, ErrOperationInvalid
 }
 
+// NewCatalogOperation creates new Operation of the catalog
+func NewCatalogOperation(id string, description string, opType string, enabled bool) (Operation, error) {
+	if opType != Debit && opType != Credit {
+		return Operation{}, ErrOperationInvalid
+	}
+
+	return Operation{
+		id:          id,
+		description: description,
+		opType:      opType,
+		enabled:     enabled,
+	}, nil
+}
+
+// Disable prevents new transactions with the operation, existing ones keep resolving to it. The
+// operations used by reversals, transfers and deposits can't be disabled
+func (o *Operation) Disable() error {
+	if internalOperations[o.id] {
+		return ErrOperationInternal
+	}
+
+	o.enabled = false
+	return nil
+}
+
 // ID returns the id property
 func (o Operation) ID() string {
 	return o.id
@@ -72,3 +177,8 @@ func (o Operation) Description() string {
 func (o Operation) Type() string {
 	return o.opType
 }
+
+// Enabled returns the enabled property
+func (o Operation) Enabled() bool {
+	return o.enabled
+}
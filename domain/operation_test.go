@@ -24,6 +24,7 @@ func TestNewOperation(t *testing.T) {
 				id:          CompraAVista,
 				description: "COMPRA A VISTA",
 				opType:      Debit,
+				enabled:     true,
 			},
 			wantErr: false,
 		},
@@ -36,6 +37,7 @@ func TestNewOperation(t *testing.T) {
 				id:          CompraParcelada,
 				description: "COMPRA PARCELADA",
 				opType:      Debit,
+				enabled:     true,
 			},
 			wantErr: false,
 		},
@@ -48,6 +50,7 @@ func TestNewOperation(t *testing.T) {
 				id:          Saque,
 				description: "SAQUE",
 				opType:      Debit,
+				enabled:     true,
 			},
 			wantErr: false,
 		},
@@ -60,6 +63,59 @@ func TestNewOperation(t *testing.T) {
 				id:          Pagamento,
 				description: "PAGAMENTO",
 				opType:      Credit,
+				enabled:     true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create operation Estorno",
+			args: args{
+				id: "5",
+			},
+			want: Operation{
+				id:          Estorno,
+				description: "ESTORNO",
+				opType:      Credit,
+				enabled:     true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create operation transferencia enviada",
+			args: args{
+				id: "6",
+			},
+			want: Operation{
+				id:          TransferenciaEnviada,
+				description: "TRANSFERENCIA ENVIADA",
+				opType:      Debit,
+				enabled:     true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create operation transferencia recebida",
+			args: args{
+				id: "7",
+			},
+			want: Operation{
+				id:          TransferenciaRecebida,
+				description: "TRANSFERENCIA RECEBIDA",
+				opType:      Credit,
+				enabled:     true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create operation deposito",
+			args: args{
+				id: "8",
+			},
+			want: Operation{
+				id:          Deposito,
+				description: "DEPOSITO",
+				opType:      Credit,
+				enabled:     true,
 			},
 			wantErr: false,
 		},
@@ -85,3 +141,94 @@ func TestNewOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCatalogOperation(t *testing.T) {
+	type args struct {
+		id          string
+		description string
+		opType      string
+		enabled     bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Operation
+		wantErr error
+	}{
+		{
+			name: "Create catalog operation",
+			args: args{
+				id:          "9",
+				description: "ANUIDADE",
+				opType:      Debit,
+				enabled:     true,
+			},
+			want: Operation{
+				id:          "9",
+				description: "ANUIDADE",
+				opType:      Debit,
+				enabled:     true,
+			},
+		},
+		{
+			name: "Create disabled catalog operation",
+			args: args{
+				id:          "10",
+				description: "CASHBACK",
+				opType:      Credit,
+				enabled:     false,
+			},
+			want: Operation{
+				id:          "10",
+				description: "CASHBACK",
+				opType:      Credit,
+				enabled:     false,
+			},
+		},
+		{
+			name: "Error invalid operation type",
+			args: args{
+				id:          "9",
+				description: "ANUIDADE",
+				opType:      "REFUND",
+				enabled:     true,
+			},
+			wantErr: ErrOperationInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCatalogOperation(tt.args.id, tt.args.description, tt.args.opType, tt.args.enabled)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperation_Disable(t *testing.T) {
+	op, _ := NewOperation(CompraAVista)
+	if err := op.Disable(); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Disable operation", err)
+	}
+
+	if op.Enabled() {
+		t.Errorf("[TestCase '%s'] Got enabled: '%v' | Want: '%v'", "Disable operation", op.Enabled(), false)
+	}
+
+	if builtin, _ := NewOperation(CompraAVista); !builtin.Enabled() {
+		t.Errorf("[TestCase '%s'] Got enabled: '%v' | Want: '%v'", "Built-in operation untouched", builtin.Enabled(), true)
+	}
+
+	for _, ID := range []string{Estorno, TransferenciaEnviada, TransferenciaRecebida, Deposito} {
+		op, _ := NewOperation(ID)
+		if err := op.Disable(); err != ErrOperationInternal || !op.Enabled() {
+			t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Error disable internal operation "+ID, err, ErrOperationInternal)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+const (
+	ScopeAccountsRead      string = "accounts:read"
+	ScopeAccountsWrite     string = "accounts:write"
+	ScopeTransactionsWrite string = "transactions:write"
+	ScopeWebhooksRead      string = "webhooks:read"
+	ScopeWebhooksWrite     string = "webhooks:write"
+	ScopeAdmin             string = "admin"
+	// ScopeSystem manages what every tenant shares, as the operation catalog. It is granted only to the
+	// tokens of the operators, which act for no tenant, and never to the API keys of a tenant
+	ScopeSystem string = "system"
+
+	PrincipalToken  string = "TOKEN"
+	PrincipalAPIKey string = "API_KEY"
+)
+
+var (
+	ErrCredentialsMissing = errors.New("credentials missing")
+	ErrCredentialsInvalid = errors.New("credentials invalid")
+	ErrScopeInvalid       = errors.New("scope invalid")
+	ErrScopeMissing       = errors.New("scope missing")
+)
+
+// scopes are the permissions the principals of a tenant can be granted
+var scopes = []string{
+	ScopeAccountsRead,
+	ScopeAccountsWrite,
+	ScopeTransactionsWrite,
+	ScopeWebhooksRead,
+	ScopeWebhooksWrite,
+	ScopeAdmin,
+}
+
+type (
+	// Principal defines who is calling the API, authenticated by a token or an API key,
+	// the tenant it acts for and the scopes it was granted
+	Principal struct {
+		id       string
+		kind     string
+		tenantID string
+		scopes   []string
+	}
+
+	principalKey struct{}
+)
+
+// NewPrincipal creates new Principal
+func NewPrincipal(id string, kind string, tenantID string, scopes []string) Principal {
+	return Principal{
+		id:       id,
+		kind:     kind,
+		tenantID: tenantID,
+		scopes:   scopes,
+	}
+}
+
+// HasScope reports whether the principal was granted the scope
+func (p Principal) HasScope(scope string) bool {
+	return slices.Contains(p.scopes, scope)
+}
+
+// ID returns the subject of the token or the id of the API key
+func (p Principal) ID() string {
+	return p.id
+}
+
+// Kind returns how the principal was authenticated, TOKEN or API_KEY
+func (p Principal) Kind() string {
+	return p.kind
+}
+
+// TenantID returns the tenant the principal acts for, empty for the operators of the system
+func (p Principal) TenantID() string {
+	return p.tenantID
+}
+
+// Scopes returns the scopes of the principal
+func (p Principal) Scopes() []string {
+	return p.scopes
+}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated principal
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom returns the principal carried by ctx, if the request was authenticated
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+func validScopes(granted []string) error {
+	for _, scope := range granted {
+		if !slices.Contains(scopes, scope) {
+			return ErrScopeInvalid
+		}
+	}
+
+	return nil
+}
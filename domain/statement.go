@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	ErrStatementPeriodInvalid = errors.New("statement period invalid")
+)
+
+type (
+	// Statement defines the movements of the credit limit of an account in a period
+	Statement struct {
+		accountID    string
+		from         time.Time
+		to           time.Time
+		openingLimit int64
+		closingLimit int64
+		entries      []StatementEntry
+		totals       map[string]int64
+	}
+
+	// StatementEntry defines a transaction of the statement and the credit limit right after it
+	StatementEntry struct {
+		transaction Transaction
+		limit       int64
+	}
+)
+
+// NewStatement builds the statement of the account between from and to, both inclusive, out of the
+// transactions of the period and the opening limit recorded in the ledger before from. The holds of
+// pending authorizations are not transactions, so they are part of no limit of the statement
+func NewStatement(
+	account Account,
+	from time.Time,
+	to time.Time,
+	openingLimit int64,
+	transactions []Transaction,
+) (Statement, error) {
+	if to.Before(from) {
+		return Statement{}, ErrStatementPeriodInvalid
+	}
+
+	var chronological = make([]Transaction, len(transactions))
+	copy(chronological, transactions)
+	sort.SliceStable(chronological, func(i, j int) bool {
+		if chronological[i].createdAt.Equal(chronological[j].createdAt) {
+			return chronological[i].id < chronological[j].id
+		}
+		return chronological[i].createdAt.Before(chronological[j].createdAt)
+	})
+
+	var (
+		limit   = openingLimit
+		entries = make([]StatementEntry, 0)
+		totals  = map[string]int64{Debit: 0, Credit: 0}
+	)
+
+	for _, transaction := range chronological {
+		if transaction.createdAt.Before(from) || transaction.createdAt.After(to) {
+			continue
+		}
+
+		limit += transaction.amount
+		totals[transaction.operation.opType] += transaction.amount
+		entries = append(entries, StatementEntry{
+			transaction: transaction,
+			limit:       limit,
+		})
+	}
+
+	return Statement{
+		accountID:    account.ID(),
+		from:         from,
+		to:           to,
+		openingLimit: openingLimit,
+		closingLimit: limit,
+		entries:      entries,
+		totals:       totals,
+	}, nil
+}
+
+// AccountID returns the accountID property
+func (s Statement) AccountID() string {
+	return s.accountID
+}
+
+// From returns the from property
+func (s Statement) From() time.Time {
+	return s.from
+}
+
+// To returns the to property
+func (s Statement) To() time.Time {
+	return s.to
+}
+
+// OpeningLimit returns the available credit limit at the start of the period
+func (s Statement) OpeningLimit() int64 {
+	return s.openingLimit
+}
+
+// ClosingLimit returns the available credit limit at the end of the period
+func (s Statement) ClosingLimit() int64 {
+	return s.closingLimit
+}
+
+// Entries returns the transactions of the period, oldest first
+func (s Statement) Entries() []StatementEntry {
+	return s.entries
+}
+
+// Totals returns the sum of the signed amounts of the period by operation type
+func (s Statement) Totals() map[string]int64 {
+	return s.totals
+}
+
+// Transaction returns the transaction property
+func (e StatementEntry) Transaction() Transaction {
+	return e.transaction
+}
+
+// Limit returns the available credit limit right after the transaction
+func (e StatementEntry) Limit() int64 {
+	return e.limit
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewStatement(t *testing.T) {
+	var (
+		compraAVista, _ = NewOperation(CompraAVista)
+		pagamento, _    = NewOperation(Pagamento)
+		now             = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		// Newest first, as returned by the transaction finders
+		transactions = []Transaction{
+			NewTransaction("d", "1", compraAVista, 50, -50, now),
+			NewTransaction("c", "1", pagamento, 300, 0, now.Add(-24*time.Hour)),
+			NewTransaction("b", "1", compraAVista, 200, -200, now.Add(-48*time.Hour)),
+			NewTransaction("a", "1", compraAVista, 100, -100, now.Add(-72*time.Hour)),
+		}
+	)
+
+	type args struct {
+		from         time.Time
+		to           time.Time
+		opening      int64
+		transactions []Transaction
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		wantOpening int64
+		wantClosing int64
+		wantEntries []int64
+		wantTotals  map[string]int64
+		wantErr     error
+	}{
+		{
+			name: "Whole history",
+			args: args{
+				from:         now.Add(-72 * time.Hour),
+				to:           now,
+				opening:      1000,
+				transactions: transactions,
+			},
+			wantOpening: 1000,
+			wantClosing: 950,
+			wantEntries: []int64{900, 700, 1000, 950},
+			wantTotals:  map[string]int64{Debit: -350, Credit: 300},
+		},
+		{
+			name: "Period in the middle",
+			args: args{
+				from:         now.Add(-48 * time.Hour),
+				to:           now.Add(-24 * time.Hour),
+				opening:      900,
+				transactions: transactions[:3],
+			},
+			wantOpening: 900,
+			wantClosing: 1000,
+			wantEntries: []int64{700, 1000},
+			wantTotals:  map[string]int64{Debit: -200, Credit: 300},
+		},
+		{
+			name: "Period without transactions",
+			args: args{
+				from:    now.Add(time.Hour),
+				to:      now.Add(2 * time.Hour),
+				opening: 950,
+			},
+			wantOpening: 950,
+			wantClosing: 950,
+			wantEntries: []int64{},
+			wantTotals:  map[string]int64{Debit: 0, Credit: 0},
+		},
+		{
+			name: "Error period ends before it starts",
+			args: args{
+				from: now,
+				to:   now.Add(-time.Hour),
+			},
+			wantErr: ErrStatementPeriodInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewStatement(
+				NewStoredAccount("1", "12345678900", 950, time.Time{}),
+				tt.args.from,
+				tt.args.to,
+				tt.args.opening,
+				tt.args.transactions,
+			)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.OpeningLimit() != tt.wantOpening {
+				t.Errorf("[TestCase '%s'] Got opening: '%v' | Want: '%v'", tt.name, got.OpeningLimit(), tt.wantOpening)
+			}
+
+			if got.ClosingLimit() != tt.wantClosing {
+				t.Errorf("[TestCase '%s'] Got closing: '%v' | Want: '%v'", tt.name, got.ClosingLimit(), tt.wantClosing)
+			}
+
+			var entries = make([]int64, 0)
+			for _, entry := range got.Entries() {
+				entries = append(entries, entry.Limit())
+			}
+
+			if !reflect.DeepEqual(entries, tt.wantEntries) {
+				t.Errorf("[TestCase '%s'] Got entries: '%v' | Want: '%v'", tt.name, entries, tt.wantEntries)
+			}
+
+			if !reflect.DeepEqual(got.Totals(), tt.wantTotals) {
+				t.Errorf("[TestCase '%s'] Got totals: '%v' | Want: '%v'", tt.name, got.Totals(), tt.wantTotals)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// DefaultTenant is the tenant of the accounts and API keys created before the service had tenants
+const DefaultTenant = "default"
+
+var ErrTenantMissing = errors.New("tenant missing")
+
+type (
+	tenantKey struct{}
+
+	// tenantScope defines the accounts a context may access: the ones of a tenant, or of every tenant
+	// for the system itself
+	tenantScope struct {
+		tenantID string
+		all      bool
+	}
+)
+
+// WithTenant returns a copy of ctx scoping the repositories to the accounts and transactions of the tenant
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantScope{tenantID: tenantID})
+}
+
+// WithAllTenants returns a copy of ctx acting on the accounts of every tenant. It is reserved to the
+// system itself, such as the workers, and never derived from the credentials of a request
+func WithAllTenants(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantScope{all: true})
+}
+
+// TenantFrom returns the tenant ctx is scoped to, empty when it has none or acts on every tenant
+func TenantFrom(ctx context.Context) string {
+	scope, _ := ctx.Value(tenantKey{}).(tenantScope)
+	return scope.tenantID
+}
+
+// AllTenants reports whether ctx was explicitly scoped to every tenant by WithAllTenants
+func AllTenants(ctx context.Context) bool {
+	scope, _ := ctx.Value(tenantKey{}).(tenantScope)
+	return scope.all
+}
+
+// TenantAllowed reports whether ctx may access the entities of the tenant. A context without tenant
+// accesses none of them
+func TenantAllowed(ctx context.Context, tenantID string) bool {
+	scope, _ := ctx.Value(tenantKey{}).(tenantScope)
+	return scope.all || (scope.tenantID != "" && scope.tenantID == tenantID)
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTenantAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		tenantID string
+		want     bool
+	}{
+		{
+			name:     "Same tenant",
+			ctx:      WithTenant(context.Background(), "tenant-a"),
+			tenantID: "tenant-a",
+			want:     true,
+		},
+		{
+			name:     "Another tenant",
+			ctx:      WithTenant(context.Background(), "tenant-b"),
+			tenantID: "tenant-a",
+			want:     false,
+		},
+		{
+			name:     "Context without tenant",
+			ctx:      context.Background(),
+			tenantID: "tenant-a",
+			want:     false,
+		},
+		{
+			name:     "Context with empty tenant",
+			ctx:      WithTenant(context.Background(), ""),
+			tenantID: "",
+			want:     false,
+		},
+		{
+			name:     "Context of every tenant",
+			ctx:      WithAllTenants(context.Background()),
+			tenantID: "tenant-a",
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TenantAllowed(tt.ctx, tt.tenantID); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransaction_TenantOfAccount(t *testing.T) {
+	var (
+		payer = NewStoredAccount("a", "12345678900", 1000, time.Time{}).WithTenant("tenant-a")
+		payee = NewStoredAccount("b", "98765432100", 500, time.Time{}).WithTenant("tenant-a")
+	)
+
+	transfer, err := NewTransfer("t", &payer, &payee, "d", "c", 300, time.Time{})
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Transfer", err)
+	}
+
+	cashIn := NewCashIn("i", &payee, "e", CashInSource{}, 100, time.Time{})
+
+	reversal, err := NewTransaction("p", "a", operations[CompraAVista], 100, -100, time.Time{}).
+		WithTenant("tenant-a").
+		Reverse("r", 100, 0, time.Time{})
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Reverse", err)
+	}
+
+	for name, transaction := range map[string]Transaction{
+		"Transfer debit":  transfer.Debit(),
+		"Transfer credit": transfer.Credit(),
+		"Cash in":         cashIn.Transaction(),
+		"Reversal":        reversal,
+	} {
+		if transaction.TenantID() != "tenant-a" {
+			t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", name, transaction.TenantID(), "tenant-a")
+		}
+	}
+}
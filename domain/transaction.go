@@ -2,24 +2,59 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+var (
+	ErrTransactionNotFound              = errors.New("transaction not found")
+	ErrTransactionNotReversible         = errors.New("transaction not reversible")
+	ErrTransactionAlreadyReversed       = errors.New("transaction already fully reversed")
+	ErrTransactionReversalExceedsAmount = errors.New("reversal amount exceeds the transaction amount")
+)
+
 type (
 	// TransactionCreator defines the operation of creating a transaction entity
 	TransactionCreator interface {
 		Create(context.Context, Transaction) (Transaction, error)
-		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// TransactionFinder defines the search operation for transaction entities
+	TransactionFinder interface {
+		FindByAccountID(context.Context, TransactionFilter) ([]Transaction, error)
+	}
+
+	// TransactionByIDFinder defines the search operation for a transaction entity
+	TransactionByIDFinder interface {
+		FindByID(context.Context, string) (Transaction, error)
+	}
+
+	// TransactionReversedAmountFinder defines the search operation for the amount already reversed of a transaction
+	TransactionReversedAmountFinder interface {
+		FindReversedAmount(context.Context, string) (int64, error)
+	}
+
+	// TransactionBalanceFinder defines the search operation for transactions with open balance
+	TransactionBalanceFinder interface {
+		FindOpenByAccountID(context.Context, string) ([]Transaction, error)
+	}
+
+	// TransactionBalanceUpdater defines the update operation for the balance of a transaction entity
+	TransactionBalanceUpdater interface {
+		UpdateBalance(context.Context, string, int64) error
 	}
 
 	// Transaction defines the transaction entity
 	Transaction struct {
-		id        string
-		accountID string
-		operation Operation
-		amount    int64
-		balance   int64
-		createdAt time.Time
+		id                    string
+		tenantID              string
+		accountID             string
+		operation             Operation
+		amount                int64
+		balance               int64
+		originalTransactionID string
+		installmentPlan       InstallmentPlan
+		createdAt             time.Time
 	}
 )
 
@@ -39,11 +74,114 @@ func NewTransaction(id string, accID string, op Operation, amount int64, balance
 	}
 }
 
+// Settle discharges the signed amount of the transaction against the open transactions
+// of the opposite type, oldest first. Whatever cannot be discharged remains as the
+// balance of the transaction. It returns the open transactions whose balance changed
+func (t *Transaction) Settle(open []Transaction) []Transaction {
+	t.balance = t.amount
+
+	var settled []Transaction
+	for _, o := range open {
+		if t.balance == 0 {
+			break
+		}
+
+		if o.balance == 0 || (o.balance < 0) == (t.balance < 0) {
+			continue
+		}
+
+		discharge := min(abs(t.balance), abs(o.balance))
+		if t.balance < 0 {
+			t.balance += discharge
+			o.balance -= discharge
+		} else {
+			t.balance -= discharge
+			o.balance += discharge
+		}
+
+		settled = append(settled, o)
+	}
+
+	return settled
+}
+
+// Reverse creates the compensating transaction of a debit. A zero amount reverses
+// everything that was not reversed yet
+func (t Transaction) Reverse(id string, amount int64, reversed int64, createdAt time.Time) (Transaction, error) {
+	if t.operation.opType != Debit || t.operation.id == TransferenciaEnviada {
+		return Transaction{}, ErrTransactionNotReversible
+	}
+
+	remaining := abs(t.amount) - reversed
+	if remaining <= 0 {
+		return Transaction{}, ErrTransactionAlreadyReversed
+	}
+
+	if amount == 0 {
+		amount = remaining
+	}
+
+	if amount > remaining {
+		return Transaction{}, ErrTransactionReversalExceedsAmount
+	}
+
+	reversal := NewTransaction(
+		id,
+		t.accountID,
+		operations[Estorno],
+		amount,
+		0,
+		createdAt,
+	)
+	reversal.originalTransactionID = t.id
+	reversal.tenantID = t.tenantID
+
+	return reversal, nil
+}
+
+// SplitInstallments splits the amount of a COMPRA PARCELADA into an installment plan
+func (t *Transaction) SplitInstallments(count int, remainder InstallmentRemainder) error {
+	if t.operation.id != CompraParcelada {
+		return ErrInstallmentsInvalid
+	}
+
+	plan, err := SplitInstallments(abs(t.amount), count, t.createdAt, remainder)
+	if err != nil {
+		return err
+	}
+
+	t.installmentPlan = plan
+	return nil
+}
+
+// WithInstallmentPlan returns a copy of the transaction with the installment plan
+func (t Transaction) WithInstallmentPlan(plan InstallmentPlan) Transaction {
+	t.installmentPlan = plan
+	return t
+}
+
+// WithBalance returns a copy of the transaction with the open balance
+func (t Transaction) WithBalance(balance int64) Transaction {
+	t.balance = balance
+	return t
+}
+
+// WithTenant returns a copy of the transaction owned by the tenant, the one of its account
+func (t Transaction) WithTenant(tenantID string) Transaction {
+	t.tenantID = tenantID
+	return t
+}
+
 // ID returns the id property
 func (t Transaction) ID() string {
 	return t.id
 }
 
+// TenantID returns the tenantID property
+func (t Transaction) TenantID() string {
+	return t.tenantID
+}
+
 // AccountID returns the accountID property
 func (t Transaction) AccountID() string {
 	return t.accountID
@@ -64,7 +202,24 @@ func (t Transaction) Balance() int64 {
 	return t.balance
 }
 
+// OriginalTransactionID returns the originalTransactionID property
+func (t Transaction) OriginalTransactionID() string {
+	return t.originalTransactionID
+}
+
+// InstallmentPlan returns the installmentPlan property
+func (t Transaction) InstallmentPlan() InstallmentPlan {
+	return t.installmentPlan
+}
+
 // CreatedAt returns the createdAt property
 func (t Transaction) CreatedAt() time.Time {
 	return t.createdAt
 }
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrTransactionCursorInvalid = errors.New("transaction cursor invalid")
+)
+
+type (
+	// TransactionFilter defines the criteria to search the transactions of an account
+	TransactionFilter struct {
+		AccountID   string
+		OperationID string
+		Type        string
+		MinAmount   int64
+		MaxAmount   int64
+		From        time.Time
+		To          time.Time
+		After       TransactionCursor
+		Limit       int
+	}
+
+	// TransactionCursor defines the position of a transaction in a paginated search
+	TransactionCursor struct {
+		id        string
+		createdAt time.Time
+	}
+)
+
+// NewTransactionCursor creates new TransactionCursor pointing to the transaction
+func NewTransactionCursor(t Transaction) TransactionCursor {
+	return TransactionCursor{
+		id:        t.id,
+		createdAt: t.createdAt,
+	}
+}
+
+// ParseTransactionCursor decodes a TransactionCursor from its string representation
+func ParseTransactionCursor(s string) (TransactionCursor, error) {
+	if s == "" {
+		return TransactionCursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return TransactionCursor{}, ErrTransactionCursorInvalid
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return TransactionCursor{}, ErrTransactionCursorInvalid
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return TransactionCursor{}, ErrTransactionCursorInvalid
+	}
+
+	return TransactionCursor{
+		id:        parts[1],
+		createdAt: createdAt,
+	}, nil
+}
+
+// String returns the opaque representation of the cursor
+func (c TransactionCursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(c.createdAt.UTC().Format(time.RFC3339Nano) + "|" + c.id),
+	)
+}
+
+// IsZero reports whether the cursor points to no transaction
+func (c TransactionCursor) IsZero() bool {
+	return c.id == ""
+}
+
+// ID returns the id property
+func (c TransactionCursor) ID() string {
+	return c.id
+}
+
+// CreatedAt returns the createdAt property
+func (c TransactionCursor) CreatedAt() time.Time {
+	return c.createdAt
+}
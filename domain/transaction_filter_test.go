@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTransactionCursor(t *testing.T) {
+	var transaction = NewTransaction(
+		"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+		"eae0bbf7-19ee-46d6-8244-77bccd64ab93",
+		Operation{id: Saque, description: "SAQUE", opType: Debit},
+		100,
+		-100,
+		time.Date(2020, 10, 17, 22, 17, 40, 0, time.UTC),
+	)
+
+	tests := []struct {
+		name    string
+		cursor  string
+		want    TransactionCursor
+		wantErr bool
+	}{
+		{
+			name:   "Parse cursor of transaction",
+			cursor: NewTransactionCursor(transaction).String(),
+			want: TransactionCursor{
+				id:        "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				createdAt: time.Date(2020, 10, 17, 22, 17, 40, 0, time.UTC),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Parse empty cursor",
+			cursor:  "",
+			want:    TransactionCursor{},
+			wantErr: false,
+		},
+		{
+			name:    "Error invalid encoding",
+			cursor:  "%%%",
+			want:    TransactionCursor{},
+			wantErr: true,
+		},
+		{
+			name:    "Error invalid content",
+			cursor:  "aW52YWxpZA",
+			want:    TransactionCursor{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			got, err := ParseTransactionCursor(tt.cursor)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got.ID() != tt.want.ID() || !got.CreatedAt().Equal(tt.want.CreatedAt()) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
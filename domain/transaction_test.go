@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"reflect"
 	"testing"
 	"time"
 )
@@ -66,3 +67,178 @@ func TestTransaction_Amount(t *testing.T) {
 		})
 	}
 }
+
+func TestTransaction_Settle(t *testing.T) {
+	var (
+		compraAVista = Operation{id: CompraAVista, description: "COMPRA A VISTA", opType: Debit}
+		saque        = Operation{id: Saque, description: "SAQUE", opType: Debit}
+		pagamento    = Operation{id: Pagamento, description: "PAGAMENTO", opType: Credit}
+	)
+
+	tests := []struct {
+		name        string
+		transaction Transaction
+		open        []Transaction
+		wantBalance int64
+		wantSettled []Transaction
+	}{
+		{
+			name:        "Payment without open debits keeps the surplus",
+			transaction: NewTransaction("3", "1", pagamento, 6000, 0, time.Time{}),
+			open:        []Transaction{},
+			wantBalance: 6000,
+		},
+		{
+			name:        "Payment discharges open debits oldest first",
+			transaction: NewTransaction("3", "1", pagamento, 6000, 0, time.Time{}),
+			open: []Transaction{
+				NewTransaction("1", "1", compraAVista, 5000, -5000, time.Time{}),
+				NewTransaction("2", "1", saque, 2300, -2300, time.Time{}),
+			},
+			wantBalance: 0,
+			wantSettled: []Transaction{
+				NewTransaction("1", "1", compraAVista, 5000, 0, time.Time{}),
+				NewTransaction("2", "1", saque, 2300, -1300, time.Time{}),
+			},
+		},
+		{
+			name:        "Payment discharges every open debit and carries the surplus",
+			transaction: NewTransaction("3", "1", pagamento, 8000, 0, time.Time{}),
+			open: []Transaction{
+				NewTransaction("1", "1", compraAVista, 5000, -5000, time.Time{}),
+				NewTransaction("2", "1", saque, 2300, -2300, time.Time{}),
+			},
+			wantBalance: 700,
+			wantSettled: []Transaction{
+				NewTransaction("1", "1", compraAVista, 5000, 0, time.Time{}),
+				NewTransaction("2", "1", saque, 2300, 0, time.Time{}),
+			},
+		},
+		{
+			name:        "Debit consumes the surplus of open payments",
+			transaction: NewTransaction("3", "1", saque, 1000, 0, time.Time{}),
+			open: []Transaction{
+				NewTransaction("1", "1", pagamento, 700, 700, time.Time{}),
+				NewTransaction("2", "1", compraAVista, 100, -100, time.Time{}),
+			},
+			wantBalance: -300,
+			wantSettled: []Transaction{
+				NewTransaction("1", "1", pagamento, 700, 0, time.Time{}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			got := tt.transaction.Settle(tt.open)
+
+			if tt.transaction.Balance() != tt.wantBalance {
+				t.Errorf("[TestCase '%s'] Got balance: '%+v' | Want balance: '%+v'", tt.name, tt.transaction.Balance(), tt.wantBalance)
+			}
+
+			if !reflect.DeepEqual(got, tt.wantSettled) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.wantSettled)
+			}
+		})
+	}
+}
+
+func TestTransaction_Reverse(t *testing.T) {
+	var (
+		compraAVista = Operation{id: CompraAVista, description: "COMPRA A VISTA", opType: Debit}
+		pagamento    = Operation{id: Pagamento, description: "PAGAMENTO", opType: Credit}
+		estorno      = Operation{id: Estorno, description: "ESTORNO", opType: Credit, enabled: true}
+		enviada      = Operation{id: TransferenciaEnviada, description: "TRANSFERENCIA ENVIADA", opType: Debit}
+	)
+
+	type args struct {
+		amount   int64
+		reversed int64
+	}
+	tests := []struct {
+		name        string
+		transaction Transaction
+		args        args
+		want        Transaction
+		wantErr     error
+	}{
+		{
+			name:        "Full reversal of purchase",
+			transaction: NewTransaction("1", "1", compraAVista, 5000, -5000, time.Time{}),
+			args:        args{amount: 0, reversed: 0},
+			want: Transaction{
+				id:                    "2",
+				accountID:             "1",
+				operation:             estorno,
+				amount:                5000,
+				originalTransactionID: "1",
+			},
+		},
+		{
+			name:        "Full reversal of partially reversed purchase",
+			transaction: NewTransaction("1", "1", compraAVista, 5000, -5000, time.Time{}),
+			args:        args{amount: 0, reversed: 1500},
+			want: Transaction{
+				id:                    "2",
+				accountID:             "1",
+				operation:             estorno,
+				amount:                3500,
+				originalTransactionID: "1",
+			},
+		},
+		{
+			name:        "Partial reversal of purchase",
+			transaction: NewTransaction("1", "1", compraAVista, 5000, -5000, time.Time{}),
+			args:        args{amount: 1000, reversed: 1500},
+			want: Transaction{
+				id:                    "2",
+				accountID:             "1",
+				operation:             estorno,
+				amount:                1000,
+				originalTransactionID: "1",
+			},
+		},
+		{
+			name:        "Error reversal exceeds remaining amount",
+			transaction: NewTransaction("1", "1", compraAVista, 5000, -5000, time.Time{}),
+			args:        args{amount: 4000, reversed: 1500},
+			wantErr:     ErrTransactionReversalExceedsAmount,
+		},
+		{
+			name:        "Error purchase already fully reversed",
+			transaction: NewTransaction("1", "1", compraAVista, 5000, -5000, time.Time{}),
+			args:        args{amount: 0, reversed: 5000},
+			wantErr:     ErrTransactionAlreadyReversed,
+		},
+		{
+			name:        "Error reversal of payment",
+			transaction: NewTransaction("1", "1", pagamento, 5000, 5000, time.Time{}),
+			args:        args{amount: 0, reversed: 0},
+			wantErr:     ErrTransactionNotReversible,
+		},
+		{
+			name:        "Error reversal of reversal",
+			transaction: NewTransaction("1", "1", estorno, 5000, 0, time.Time{}),
+			args:        args{amount: 0, reversed: 0},
+			wantErr:     ErrTransactionNotReversible,
+		},
+		{
+			name:        "Error reversal of transfer",
+			transaction: NewTransaction("1", "1", enviada, 5000, -5000, time.Time{}),
+			args:        args{amount: 0, reversed: 0},
+			wantErr:     ErrTransactionNotReversible,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			got, err := tt.transaction.Reverse("2", tt.args.amount, tt.args.reversed, time.Time{})
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
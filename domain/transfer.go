@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrTransferSameAccount = errors.New("transfer to the same account")
+)
+
+type (
+	// TransferCreator defines the operation of creating a transfer entity
+	TransferCreator interface {
+		Create(context.Context, Transfer) (Transfer, error)
+	}
+
+	// Transfer defines the transfer entity, linking the debit of the payer to the credit of the payee
+	Transfer struct {
+		id        string
+		payerID   string
+		payeeID   string
+		amount    int64
+		debit     Transaction
+		credit    Transaction
+		createdAt time.Time
+	}
+)
+
+// NewTransfer moves the amount from the credit limit of the payer to the payee
+// and returns the transfer with its debit and credit transactions
+func NewTransfer(
+	id string,
+	payer *Account,
+	payee *Account,
+	debitID string,
+	creditID string,
+	amount int64,
+	createdAt time.Time,
+) (Transfer, error) {
+	if payer.ID() == payee.ID() {
+		return Transfer{}, ErrTransferSameAccount
+	}
+
+	if err := payer.Withdraw(amount); err != nil {
+		return Transfer{}, err
+	}
+	payee.Deposit(amount)
+
+	sent, _ := NewOperation(TransferenciaEnviada)
+	received, _ := NewOperation(TransferenciaRecebida)
+
+	return Transfer{
+		id:        id,
+		payerID:   payer.ID(),
+		payeeID:   payee.ID(),
+		amount:    amount,
+		debit:     NewTransaction(debitID, payer.ID(), sent, amount, 0, createdAt).WithTenant(payer.TenantID()),
+		credit:    NewTransaction(creditID, payee.ID(), received, amount, 0, createdAt).WithTenant(payee.TenantID()),
+		createdAt: createdAt,
+	}, nil
+}
+
+// WithTransactions returns a copy of the transfer with the debit and credit transactions
+func (t Transfer) WithTransactions(debit Transaction, credit Transaction) Transfer {
+	t.debit = debit
+	t.credit = credit
+	return t
+}
+
+// ID returns the id property
+func (t Transfer) ID() string {
+	return t.id
+}
+
+// PayerID returns the payerID property
+func (t Transfer) PayerID() string {
+	return t.payerID
+}
+
+// PayeeID returns the payeeID property
+func (t Transfer) PayeeID() string {
+	return t.payeeID
+}
+
+// Amount returns the amount property
+func (t Transfer) Amount() int64 {
+	return t.amount
+}
+
+// Debit returns the debit property
+func (t Transfer) Debit() Transaction {
+	return t.debit
+}
+
+// Credit returns the credit property
+func (t Transfer) Credit() Transaction {
+	return t.credit
+}
+
+// CreatedAt returns the createdAt property
+func (t Transfer) CreatedAt() time.Time {
+	return t.createdAt
+}
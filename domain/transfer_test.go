@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewTransfer(t *testing.T) {
+	type args struct {
+		payer  Account
+		payee  Account
+		amount int64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		wantPayerLimit int64
+		wantPayeeLimit int64
+		wantErr        error
+	}{
+		{
+			name: "Transfer between accounts",
+			args: args{
+				payer:  NewStoredAccount("a", "12345678900", 1000, time.Time{}),
+				payee:  NewStoredAccount("b", "98765432100", 500, time.Time{}),
+				amount: 300,
+			},
+			wantPayerLimit: 700,
+			wantPayeeLimit: 800,
+		},
+		{
+			name: "Transfer the whole credit limit",
+			args: args{
+				payer:  NewStoredAccount("a", "12345678900", 1000, time.Time{}),
+				payee:  NewStoredAccount("b", "98765432100", 0, time.Time{}),
+				amount: 1000,
+			},
+			wantPayerLimit: 0,
+			wantPayeeLimit: 1000,
+		},
+		{
+			name: "Error transfer exceeds the credit limit of the payer",
+			args: args{
+				payer:  NewStoredAccount("a", "12345678900", 100, time.Time{}),
+				payee:  NewStoredAccount("b", "98765432100", 500, time.Time{}),
+				amount: 300,
+			},
+			wantPayerLimit: 100,
+			wantPayeeLimit: 500,
+			wantErr:        ErrAccountInsufficientCreditLimit,
+		},
+		{
+			name: "Error transfer to the same account",
+			args: args{
+				payer:  NewStoredAccount("a", "12345678900", 1000, time.Time{}),
+				payee:  NewStoredAccount("a", "12345678900", 1000, time.Time{}),
+				amount: 300,
+			},
+			wantPayerLimit: 1000,
+			wantPayeeLimit: 1000,
+			wantErr:        ErrTransferSameAccount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTransfer("t", &tt.args.payer, &tt.args.payee, "d", "c", tt.args.amount, time.Time{})
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.args.payer.AvailableCreditLimit() != tt.wantPayerLimit {
+				t.Errorf("[TestCase '%s'] Got payer limit: '%v' | Want: '%v'", tt.name, tt.args.payer.AvailableCreditLimit(), tt.wantPayerLimit)
+			}
+
+			if tt.args.payee.AvailableCreditLimit() != tt.wantPayeeLimit {
+				t.Errorf("[TestCase '%s'] Got payee limit: '%v' | Want: '%v'", tt.name, tt.args.payee.AvailableCreditLimit(), tt.wantPayeeLimit)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Debit().Amount() != -tt.args.amount || got.Debit().Operation().ID() != TransferenciaEnviada {
+				t.Errorf("[TestCase '%s'] Got debit: '%+v' | Want amount: '%v'", tt.name, got.Debit(), -tt.args.amount)
+			}
+
+			if got.Credit().Amount() != tt.args.amount || got.Credit().Operation().ID() != TransferenciaRecebida {
+				t.Errorf("[TestCase '%s'] Got credit: '%+v' | Want amount: '%v'", tt.name, got.Credit(), tt.args.amount)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+// UnitOfWork defines the boundary of an atomic operation over one or more repositories.
+// Repositories called with the context received by the function take part in the same unit of work,
+// which is committed when the function returns nil and rolled back otherwise.
+// Calling WithTransaction with a context that already belongs to a unit of work nests a new one inside it
+type UnitOfWork interface {
+	WithTransaction(context.Context, func(context.Context) error) error
+}
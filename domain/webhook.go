@@ -0,0 +1,475 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	WebhookDeliveryPending   string = "PENDING"
+	WebhookDeliveryDelivered string = "DELIVERED"
+	WebhookDeliveryDead      string = "DEAD"
+
+	// WebhookMaxAttempts defines how many failed attempts move a delivery to the dead-letter state
+	WebhookMaxAttempts = 10
+
+	// webhookRetryBase defines the wait after the first failed attempt, doubled after each new failure
+	webhookRetryBase = 30 * time.Second
+	// webhookRetryMax defines the longest wait between two attempts
+	webhookRetryMax = time.Hour
+	// webhookLastErrorSize defines how many bytes of the reason of a failed attempt are kept
+	webhookLastErrorSize = 512
+)
+
+var (
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrWebhookEventInvalid = errors.New("webhook event invalid")
+	ErrWebhookURLInvalid   = errors.New("webhook url must be an absolute https url to a public address")
+)
+
+// webhookReservedPrefixes are the ranges reserved for special use that the checks of netip do not cover,
+// such as the shared address space of carrier-grade NAT and the networks of documentation and benchmarks
+var webhookReservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// webhookEvents are the events a webhook can subscribe to
+var webhookEvents = []string{
+	EventTransactionCreated,
+	EventTransactionRejected,
+}
+
+type (
+	// WebhookCreator defines the operation of creating a webhook entity
+	WebhookCreator interface {
+		Create(context.Context, Webhook) (Webhook, error)
+	}
+
+	// WebhookFinder defines the search operation for a webhook entity
+	WebhookFinder interface {
+		FindByID(context.Context, string) (Webhook, error)
+	}
+
+	// WebhookLister defines the search operation for the webhooks of an account, or for all of them when empty
+	WebhookLister interface {
+		FindAll(context.Context, string) ([]Webhook, error)
+	}
+
+	// WebhookSubscriberFinder defines the search operation for the active webhooks of the tenant subscribed
+	// to the event, the global webhooks of the tenant included
+	WebhookSubscriberFinder interface {
+		FindSubscribers(context.Context, Event) ([]Webhook, error)
+	}
+
+	// WebhookUpdater defines the update operation for a webhook entity
+	WebhookUpdater interface {
+		Update(context.Context, Webhook) error
+	}
+
+	// WebhookDeleter defines the delete operation for a webhook entity and its deliveries
+	WebhookDeleter interface {
+		Delete(context.Context, string) error
+	}
+
+	// WebhookRepository defines the webhook subscriptions
+	WebhookRepository interface {
+		WebhookCreator
+		WebhookFinder
+		WebhookLister
+		WebhookSubscriberFinder
+		WebhookUpdater
+		WebhookDeleter
+	}
+
+	// WebhookDeliveryCreator defines the operation of scheduling a delivery, ignoring an event already
+	// scheduled to the same webhook
+	WebhookDeliveryCreator interface {
+		Create(context.Context, WebhookDelivery) error
+	}
+
+	// WebhookDeliveryDueFinder defines the search operation for the pending deliveries of active webhooks
+	// whose next attempt is due, oldest first
+	WebhookDeliveryDueFinder interface {
+		FindDue(context.Context, time.Time, int) ([]WebhookDelivery, error)
+	}
+
+	// WebhookDeliveryLister defines the search operation for the latest deliveries of a webhook, newest first
+	WebhookDeliveryLister interface {
+		FindByWebhookID(context.Context, string, int) ([]WebhookDelivery, error)
+	}
+
+	// WebhookDeliveryUpdater defines the update operation for a delivery entity
+	WebhookDeliveryUpdater interface {
+		Update(context.Context, WebhookDelivery) error
+	}
+
+	// WebhookDeliveryRepository defines the delivery log of the webhooks
+	WebhookDeliveryRepository interface {
+		WebhookDeliveryCreator
+		WebhookDeliveryDueFinder
+		WebhookDeliveryLister
+		WebhookDeliveryUpdater
+	}
+
+	// WebhookSender defines the attempt of delivering a webhook to its receiver
+	WebhookSender interface {
+		Send(context.Context, Webhook, WebhookDelivery) error
+	}
+
+	// Webhook defines the webhook entity, a subscription of a URL to the events of an account,
+	// or of every account of its tenant when the account is empty
+	Webhook struct {
+		id        string
+		accountID string
+		tenantID  string
+		url       string
+		secret    string
+		events    []string
+		active    bool
+		createdAt time.Time
+	}
+
+	// WebhookDelivery defines the delivery entity, an event scheduled to a webhook and its attempts
+	WebhookDelivery struct {
+		id            string
+		webhookID     string
+		tenantID      string
+		eventID       string
+		eventType     string
+		body          []byte
+		status        string
+		attempts      int
+		lastError     string
+		nextAttemptAt time.Time
+		deliveredAt   time.Time
+		createdAt     time.Time
+	}
+
+	webhookBody struct {
+		ID         string          `json:"id"`
+		Type       string          `json:"type"`
+		AccountID  string          `json:"account_id"`
+		OccurredAt time.Time       `json:"occurred_at"`
+		Data       json.RawMessage `json:"data"`
+	}
+)
+
+// NewWebhook creates new Webhook
+func NewWebhook(
+	id string,
+	accountID string,
+	rawURL string,
+	secret string,
+	events []string,
+	active bool,
+	createdAt time.Time,
+) Webhook {
+	return Webhook{
+		id:        id,
+		accountID: accountID,
+		url:       rawURL,
+		secret:    secret,
+		events:    events,
+		active:    active,
+		createdAt: createdAt,
+	}
+}
+
+// SubscribeWebhook creates new active Webhook, checking its URL and the events it subscribes to
+func SubscribeWebhook(
+	id string,
+	accountID string,
+	rawURL string,
+	secret string,
+	events []string,
+	createdAt time.Time,
+) (Webhook, error) {
+	if err := validWebhook(rawURL, events); err != nil {
+		return Webhook{}, err
+	}
+
+	return NewWebhook(id, accountID, rawURL, secret, webhookEventSet(events), true, createdAt), nil
+}
+
+// Change replaces the URL, the events and the state of the webhook
+func (w *Webhook) Change(rawURL string, events []string, active bool) error {
+	if err := validWebhook(rawURL, events); err != nil {
+		return err
+	}
+
+	w.url = rawURL
+	w.events = webhookEventSet(events)
+	w.active = active
+
+	return nil
+}
+
+// Subscribes reports whether the webhook is active and receives the event, which must be of an account
+// of its tenant
+func (w Webhook) Subscribes(event Event) bool {
+	return w.active &&
+		w.tenantID != "" && w.tenantID == event.TenantID() &&
+		(w.accountID == "" || w.accountID == event.AccountID()) &&
+		slices.Contains(w.events, event.Type())
+}
+
+// WithTenant returns a copy of the webhook owned by the tenant
+func (w Webhook) WithTenant(tenantID string) Webhook {
+	w.tenantID = tenantID
+	return w
+}
+
+// webhookEventSet returns the events sorted and without repetitions
+func webhookEventSet(events []string) []string {
+	events = slices.Clone(events)
+	slices.Sort(events)
+
+	return slices.Compact(events)
+}
+
+// WebhookAddressAllowed reports whether deliveries may reach the address: only public unicast addresses
+// are, so a webhook cannot reach the loopback, the private networks or the metadata of the cloud provider
+func WebhookAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range webhookReservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validWebhook(rawURL string, events []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return ErrWebhookURLInvalid
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrWebhookURLInvalid
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && !WebhookAddressAllowed(addr) {
+		return ErrWebhookURLInvalid
+	}
+
+	if len(events) == 0 {
+		return ErrWebhookEventInvalid
+	}
+
+	for _, event := range events {
+		if !slices.Contains(webhookEvents, event) {
+			return ErrWebhookEventInvalid
+		}
+	}
+
+	return nil
+}
+
+// ID returns the id property
+func (w Webhook) ID() string {
+	return w.id
+}
+
+// AccountID returns the accountID property, empty for a global webhook
+func (w Webhook) AccountID() string {
+	return w.accountID
+}
+
+// TenantID returns the tenantID property
+func (w Webhook) TenantID() string {
+	return w.tenantID
+}
+
+// URL returns the url property
+func (w Webhook) URL() string {
+	return w.url
+}
+
+// Secret returns the key the deliveries are signed with
+func (w Webhook) Secret() string {
+	return w.secret
+}
+
+// Events returns the events property
+func (w Webhook) Events() []string {
+	return w.events
+}
+
+// Active returns the active property
+func (w Webhook) Active() bool {
+	return w.active
+}
+
+// CreatedAt returns the createdAt property
+func (w Webhook) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// NewWebhookDelivery creates new WebhookDelivery
+func NewWebhookDelivery(
+	id string,
+	webhookID string,
+	eventID string,
+	eventType string,
+	body []byte,
+	status string,
+	attempts int,
+	lastError string,
+	nextAttemptAt time.Time,
+	deliveredAt time.Time,
+	createdAt time.Time,
+) WebhookDelivery {
+	return WebhookDelivery{
+		id:            id,
+		webhookID:     webhookID,
+		eventID:       eventID,
+		eventType:     eventType,
+		body:          body,
+		status:        status,
+		attempts:      attempts,
+		lastError:     lastError,
+		nextAttemptAt: nextAttemptAt,
+		deliveredAt:   deliveredAt,
+		createdAt:     createdAt,
+	}
+}
+
+// ScheduleWebhookDelivery creates new pending WebhookDelivery of the event to the webhook, due immediately
+func ScheduleWebhookDelivery(id string, webhook Webhook, event Event, now time.Time) WebhookDelivery {
+	body, _ := json.Marshal(webhookBody{
+		ID:         event.ID(),
+		Type:       event.Type(),
+		AccountID:  event.AccountID(),
+		OccurredAt: event.OccurredAt(),
+		Data:       event.Payload(),
+	})
+
+	return NewWebhookDelivery(id, webhook.ID(), event.ID(), event.Type(), body, WebhookDeliveryPending, 0, "", now, time.Time{}, now).
+		WithTenant(webhook.TenantID())
+}
+
+// WithTenant returns a copy of the delivery owned by the tenant, the one of its webhook
+func (d WebhookDelivery) WithTenant(tenantID string) WebhookDelivery {
+	d.tenantID = tenantID
+	return d
+}
+
+// Claim reserves the delivery to the attempt about to be made, postponing its next attempt to until so that
+// no other dispatcher takes it meanwhile. A delivery whose attempt is never recorded is due again after until
+func (d *WebhookDelivery) Claim(until time.Time) {
+	d.nextAttemptAt = until
+}
+
+// Succeed records the attempt accepted by the receiver
+func (d *WebhookDelivery) Succeed(now time.Time) {
+	d.attempts++
+	d.status = WebhookDeliveryDelivered
+	d.lastError = ""
+	d.deliveredAt = now
+}
+
+// Fail records the failed attempt and schedules the next one with exponential backoff. After
+// WebhookMaxAttempts failures the delivery is dead and no longer attempted
+func (d *WebhookDelivery) Fail(reason error, now time.Time) {
+	d.attempts++
+	d.lastError = reason.Error()
+	if len(d.lastError) > webhookLastErrorSize {
+		d.lastError = strings.ToValidUTF8(d.lastError[:webhookLastErrorSize], "")
+	}
+
+	if d.attempts >= WebhookMaxAttempts {
+		d.status = WebhookDeliveryDead
+		return
+	}
+
+	wait := webhookRetryBase << (d.attempts - 1)
+	if wait > webhookRetryMax {
+		wait = webhookRetryMax
+	}
+
+	d.nextAttemptAt = now.Add(wait)
+}
+
+// ID returns the id property
+func (d WebhookDelivery) ID() string {
+	return d.id
+}
+
+// WebhookID returns the webhookID property
+func (d WebhookDelivery) WebhookID() string {
+	return d.webhookID
+}
+
+// TenantID returns the tenantID property
+func (d WebhookDelivery) TenantID() string {
+	return d.tenantID
+}
+
+// EventID returns the eventID property
+func (d WebhookDelivery) EventID() string {
+	return d.eventID
+}
+
+// EventType returns the eventType property
+func (d WebhookDelivery) EventType() string {
+	return d.eventType
+}
+
+// Body returns the JSON posted to the receiver
+func (d WebhookDelivery) Body() []byte {
+	return d.body
+}
+
+// Status returns the status property
+func (d WebhookDelivery) Status() string {
+	return d.status
+}
+
+// Attempts returns the attempts property
+func (d WebhookDelivery) Attempts() int {
+	return d.attempts
+}
+
+// LastError returns the reason of the last failed attempt
+func (d WebhookDelivery) LastError() string {
+	return d.lastError
+}
+
+// NextAttemptAt returns the nextAttemptAt property
+func (d WebhookDelivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+// DeliveredAt returns the deliveredAt property, zero until the delivery succeeds
+func (d WebhookDelivery) DeliveredAt() time.Time {
+	return d.deliveredAt
+}
+
+// CreatedAt returns the createdAt property
+func (d WebhookDelivery) CreatedAt() time.Time {
+	return d.createdAt
+}
@@ -0,0 +1,246 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSubscribeWebhook(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		events     []string
+		wantEvents []string
+		wantErr    error
+	}{
+		{
+			name:       "Events sorted without repetitions",
+			url:        "https://partner.example.com/hooks",
+			events:     []string{EventTransactionRejected, EventTransactionCreated, EventTransactionRejected},
+			wantEvents: []string{EventTransactionCreated, EventTransactionRejected},
+		},
+		{
+			name:    "Error without events",
+			url:     "https://partner.example.com/hooks",
+			events:  []string{},
+			wantErr: ErrWebhookEventInvalid,
+		},
+		{
+			name:    "Error event not supported",
+			url:     "https://partner.example.com/hooks",
+			events:  []string{EventAccountCreated},
+			wantErr: ErrWebhookEventInvalid,
+		},
+		{
+			name:    "Error url scheme not supported",
+			url:     "ftp://partner.example.com/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:       "Public address",
+			url:        "https://8.8.8.8/hooks",
+			events:     []string{EventTransactionCreated},
+			wantEvents: []string{EventTransactionCreated},
+		},
+		{
+			name:    "Error relative url",
+			url:     "/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error plain http url",
+			url:     "http://partner.example.com/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error localhost",
+			url:     "https://localhost:8443/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error loopback address",
+			url:     "https://127.0.0.1/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error private address",
+			url:     "https://10.0.0.8/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error cloud metadata address",
+			url:     "https://169.254.169.254/latest/meta-data",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error carrier-grade NAT address",
+			url:     "https://100.64.0.1/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error IPv6 loopback address",
+			url:     "https://[::1]/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error IPv6 unique local address",
+			url:     "https://[fd00::1]/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+		{
+			name:    "Error IPv4-mapped loopback address",
+			url:     "https://[::ffff:127.0.0.1]/hooks",
+			events:  []string{EventTransactionCreated},
+			wantErr: ErrWebhookURLInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SubscribeWebhook("1", "", tt.url, "secret", tt.events, time.Time{})
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if err == nil && !reflect.DeepEqual(got.Events(), tt.wantEvents) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Events(), tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestWebhook_Subscribes(t *testing.T) {
+	var (
+		events  = []string{EventTransactionCreated}
+		event   = NewEvent("e", EventTransactionCreated, "1", nil, time.Time{}).WithTenant("tenant-a")
+		webhook = func(accountID string, active bool) Webhook {
+			return NewWebhook("1", accountID, "http://localhost", "secret", events, active, time.Time{}).WithTenant("tenant-a")
+		}
+	)
+
+	tests := []struct {
+		name    string
+		webhook Webhook
+		event   Event
+		want    bool
+	}{
+		{
+			name:    "Webhook of the account",
+			webhook: webhook("1", true),
+			event:   event,
+			want:    true,
+		},
+		{
+			name:    "Global webhook",
+			webhook: webhook("", true),
+			event:   NewEvent("e", EventTransactionCreated, "2", nil, time.Time{}).WithTenant("tenant-a"),
+			want:    true,
+		},
+		{
+			name:    "Webhook of another account",
+			webhook: webhook("1", true),
+			event:   NewEvent("e", EventTransactionCreated, "2", nil, time.Time{}).WithTenant("tenant-a"),
+			want:    false,
+		},
+		{
+			name:    "Global webhook of another tenant",
+			webhook: webhook("", true),
+			event:   event.WithTenant("tenant-b"),
+			want:    false,
+		},
+		{
+			name:    "Event without tenant",
+			webhook: webhook("", true).WithTenant(""),
+			event:   event.WithTenant(""),
+			want:    false,
+		},
+		{
+			name:    "Event not subscribed",
+			webhook: webhook("", true),
+			event:   NewEvent("e", EventTransactionRejected, "1", nil, time.Time{}).WithTenant("tenant-a"),
+			want:    false,
+		},
+		{
+			name:    "Inactive webhook",
+			webhook: webhook("", false),
+			event:   event,
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.webhook.Subscribes(tt.event); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookDelivery_Fail(t *testing.T) {
+	var now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		attempts   int
+		wantStatus string
+		wantNext   time.Time
+	}{
+		{
+			name:       "First failure waits the base interval",
+			attempts:   0,
+			wantStatus: WebhookDeliveryPending,
+			wantNext:   now.Add(30 * time.Second),
+		},
+		{
+			name:       "Wait doubled after each failure",
+			attempts:   3,
+			wantStatus: WebhookDeliveryPending,
+			wantNext:   now.Add(4 * time.Minute),
+		},
+		{
+			name:       "Wait capped",
+			attempts:   WebhookMaxAttempts - 2,
+			wantStatus: WebhookDeliveryPending,
+			wantNext:   now.Add(time.Hour),
+		},
+		{
+			name:       "Dead after the last attempt",
+			attempts:   WebhookMaxAttempts - 1,
+			wantStatus: WebhookDeliveryDead,
+			wantNext:   now,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewWebhookDelivery("1", "1", "1", EventTransactionCreated, nil, WebhookDeliveryPending, tt.attempts, "", now, time.Time{}, now)
+
+			delivery.Fail(errors.New("connection refused"), now)
+
+			if delivery.Status() != tt.wantStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want: '%v'", tt.name, delivery.Status(), tt.wantStatus)
+			}
+
+			if !delivery.NextAttemptAt().Equal(tt.wantNext) {
+				t.Errorf("[TestCase '%s'] Got next attempt: '%v' | Want: '%v'", tt.name, delivery.NextAttemptAt(), tt.wantNext)
+			}
+
+			if delivery.Attempts() != tt.attempts+1 || delivery.LastError() != "connection refused" {
+				t.Errorf("[TestCase '%s'] Got attempts: '%v', error: '%v'", tt.name, delivery.Attempts(), delivery.LastError())
+			}
+		})
+	}
+}
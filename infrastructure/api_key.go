@@ -0,0 +1,52 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/go-transactions/adapter/presenter"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// APIKey runs the api-key subcommand, which issues the API keys against the database selected by DB_DRIVER,
+// such as the first admin key of a tenant, before any principal of it can call the API to create them
+func APIKey(args []string) {
+	if len(args) != 4 || args[0] != "create" {
+		log.Fatal("usage: go-transactions api-key create <tenant> <name> <scope,...>")
+	}
+
+	if os.Getenv("APP_STORAGE") == storageMemory {
+		log.Fatal("api keys can not be created in the memory storage, use the database")
+	}
+
+	s := newStorage()
+	defer s.db.Close()
+
+	uc := usecase.NewCreateAPIKeyInteractor(
+		s.apiKeys,
+		presenter.NewCreateAPIKeyPresenter(),
+		usecase.NewSystemClock(),
+		10*time.Second,
+	)
+
+	input := usecase.CreateAPIKeyInput{
+		Name:   args[2],
+		Scopes: strings.Split(args[3], ","),
+	}
+	if err := validation.NewValidator().Struct(input); err != nil {
+		log.Fatal(strings.Join(validation.ErrMessages(err), "; "))
+	}
+
+	output, err := uc.Execute(domain.WithTenant(context.Background(), args[1]), input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("id\t%s\ntenant\t%s\nkey\t%s\nscopes\t%s\n", output.ID, args[1], output.Key, strings.Join(output.Scopes, ","))
+}
@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type (
+	// Config defines the keys and the claims the bearer tokens are checked against. A token is signed
+	// either with the HS256 secret or with one of the RS256 public keys, chosen by the kid of its header
+	Config struct {
+		Secret     []byte
+		PublicKeys map[string]*rsa.PublicKey
+		Issuer     string
+		Audience   string
+	}
+
+	// TokenVerifier authenticates the principals of the JWT bearer tokens
+	TokenVerifier struct {
+		config Config
+		parser *jwt.Parser
+	}
+
+	// claims are the claims read from the token. The scopes are granted either in the space separated
+	// scope claim of OAuth 2.0 or in the scp array, and tenant_id is the tenant the subject acts for
+	claims struct {
+		jwt.RegisteredClaims
+		TenantID string   `json:"tenant_id"`
+		Scope    string   `json:"scope"`
+		Scp      []string `json:"scp"`
+	}
+
+	// jwks is the JSON Web Key Set holding the RSA public keys
+	jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+)
+
+// errKeyNotFound is returned when no configured key verifies the token
+var errKeyNotFound = errors.New("signing key not found")
+
+// NewTokenVerifier creates new TokenVerifier. Only the algorithms with a configured key are accepted,
+// and the tokens must expire
+func NewTokenVerifier(config Config) *TokenVerifier {
+	// the list is never nil, as a nil list would accept any algorithm
+	var methods = []string{}
+	if len(config.Secret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if len(config.PublicKeys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+	}
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+
+	return &TokenVerifier{
+		config: config,
+		parser: jwt.NewParser(options...),
+	}
+}
+
+// NewTokenVerifierFromEnv creates new TokenVerifier configured by AUTH_JWT_SECRET for HS256,
+// AUTH_JWT_PUBLIC_KEY_FILE (PEM) or AUTH_JWKS_FILE for RS256, and AUTH_JWT_ISSUER and AUTH_JWT_AUDIENCE.
+// Without any key every token is refused, leaving the API keys as the only credentials
+func NewTokenVerifierFromEnv() (*TokenVerifier, error) {
+	config := Config{
+		Secret:     []byte(os.Getenv("AUTH_JWT_SECRET")),
+		PublicKeys: make(map[string]*rsa.PublicKey),
+		Issuer:     os.Getenv("AUTH_JWT_ISSUER"),
+		Audience:   os.Getenv("AUTH_JWT_AUDIENCE"),
+	}
+
+	if path := os.Getenv("AUTH_JWT_PUBLIC_KEY_FILE"); path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := jwt.ParseRSAPublicKeyFromPEM(b)
+		if err != nil {
+			return nil, err
+		}
+
+		config.PublicKeys[""] = key
+	}
+
+	if path := os.Getenv("AUTH_JWKS_FILE"); path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		keys, err := ParseJWKS(b)
+		if err != nil {
+			return nil, err
+		}
+
+		for kid, key := range keys {
+			config.PublicKeys[kid] = key
+		}
+	}
+
+	return NewTokenVerifier(config), nil
+}
+
+// ParseJWKS returns the RSA public keys of the JSON Web Key Set indexed by their kid. Keys of other types are skipped
+func ParseJWKS(b []byte) (map[string]*rsa.PublicKey, error) {
+	var set jwks
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %q: %w", k.Kid, err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %q: %w", k.Kid, err)
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+// Verify checks the signature and the claims of the token and returns its principal, identified by the
+// subject and acting for the tenant of the token. A token of the system scope is of an operator and acts
+// for no tenant. Any failure is reported as domain.ErrCredentialsInvalid
+func (v TokenVerifier) Verify(_ context.Context, token string) (domain.Principal, error) {
+	var c claims
+	if _, err := v.parser.ParseWithClaims(token, &c, v.key); err != nil {
+		return domain.Principal{}, domain.ErrCredentialsInvalid
+	}
+
+	scopes := c.Scp
+	if c.Scope != "" {
+		scopes = strings.Fields(c.Scope)
+	}
+
+	if c.Subject == "" || (c.TenantID == "") != slices.Contains(scopes, domain.ScopeSystem) {
+		return domain.Principal{}, domain.ErrCredentialsInvalid
+	}
+
+	return domain.NewPrincipal(c.Subject, domain.PrincipalToken, c.TenantID, scopes), nil
+}
+
+// key returns the key verifying the token: the secret for HS256, or the public key of the kid for RS256.
+// A token without kid is verified by the static public key, or by the only key of the set
+func (v TokenVerifier) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if len(v.config.Secret) > 0 {
+			return v.config.Secret, nil
+		}
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := v.config.PublicKeys[kid]; ok {
+			return key, nil
+		}
+
+		if kid == "" && len(v.config.PublicKeys) == 1 {
+			for _, key := range v.config.PublicKeys {
+				return key, nil
+			}
+		}
+	}
+
+	return nil, errKeyNotFound
+}
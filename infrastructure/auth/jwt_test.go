@@ -0,0 +1,229 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestTokenVerifier_Verify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		secret = []byte("secret")
+		config = Config{
+			Secret:     secret,
+			PublicKeys: map[string]*rsa.PublicKey{"k1": &rsaKey.PublicKey},
+			Issuer:     "issuer",
+			Audience:   "go-transactions",
+		}
+		expiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+		valid     = jwt.MapClaims{
+			"sub":       "client",
+			"tenant_id": "tenant-a",
+			"iss":       "issuer",
+			"aud":       "go-transactions",
+			"exp":       expiresAt,
+			"scope":     "accounts:read transactions:write",
+		}
+	)
+
+	sign := func(method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return s
+	}
+
+	with := func(key string, value interface{}) jwt.MapClaims {
+		claims := jwt.MapClaims{}
+		for k, v := range valid {
+			claims[k] = v
+		}
+
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		config  Config
+		token   string
+		want    domain.Principal
+		wantErr error
+	}{
+		{
+			name:   "HS256 token",
+			config: config,
+			token:  sign(jwt.SigningMethodHS256, secret, "", valid),
+			want: domain.NewPrincipal(
+				"client",
+				domain.PrincipalToken,
+				"tenant-a",
+				[]string{domain.ScopeAccountsRead, domain.ScopeTransactionsWrite},
+			),
+		},
+		{
+			name:   "RS256 token of the key set",
+			config: config,
+			token:  sign(jwt.SigningMethodRS256, rsaKey, "k1", with("scope", nil)),
+			want:   domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", nil),
+		},
+		{
+			name:   "RS256 token with scp claim verified by the only key",
+			config: config,
+			token: sign(jwt.SigningMethodRS256, rsaKey, "", func() jwt.MapClaims {
+				claims := with("scope", nil)
+				claims["scp"] = []string{domain.ScopeAdmin}
+				return claims
+			}()),
+			want: domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", []string{domain.ScopeAdmin}),
+		},
+		{
+			name:    "RS256 token of unknown kid",
+			config:  config,
+			token:   sign(jwt.SigningMethodRS256, rsaKey, "k2", valid),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "HS256 token with wrong secret",
+			config:  config,
+			token:   sign(jwt.SigningMethodHS256, []byte("other"), "", valid),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "HS256 token without configured secret",
+			config:  Config{PublicKeys: config.PublicKeys},
+			token:   sign(jwt.SigningMethodHS256, []byte{}, "", valid),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Token without configured keys",
+			config:  Config{},
+			token:   sign(jwt.SigningMethodHS256, secret, "", valid),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Expired token",
+			config:  config,
+			token:   sign(jwt.SigningMethodHS256, secret, "", with("exp", jwt.NewNumericDate(time.Now().Add(-time.Hour)))),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Token without expiration",
+			config:  config,
+			token:   sign(jwt.SigningMethodHS256, secret, "", with("exp", nil)),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Token of other issuer",
+			config:  config,
+			token:   sign(jwt.SigningMethodHS256, secret, "", with("iss", "other")),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Token of other audience",
+			config:  config,
+			token:   sign(jwt.SigningMethodHS256, secret, "", with("aud", "other")),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Token without subject",
+			config:  config,
+			token:   sign(jwt.SigningMethodHS256, secret, "", with("sub", nil)),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Token without tenant",
+			config:  config,
+			token:   sign(jwt.SigningMethodHS256, secret, "", with("tenant_id", nil)),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "System token acting for a tenant",
+			config:  config,
+			token:   sign(jwt.SigningMethodHS256, secret, "", with("scope", domain.ScopeSystem)),
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:   "System token of an operator",
+			config: config,
+			token: sign(jwt.SigningMethodHS256, secret, "", func() jwt.MapClaims {
+				claims := with("tenant_id", nil)
+				claims["scope"] = domain.ScopeSystem
+				return claims
+			}()),
+			want: domain.NewPrincipal("client", domain.PrincipalToken, "", []string{domain.ScopeSystem}),
+		},
+		{
+			name:    "Malformed token",
+			config:  config,
+			token:   "token",
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTokenVerifier(tt.config).Verify(context.Background(), tt.token)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		n = base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes())
+		e = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes())
+		b = fmt.Sprintf(
+			`{"keys":[{"kty":"RSA","kid":"k1","n":"%s","e":"%s"},{"kty":"EC","kid":"k2"}]}`,
+			n,
+			e,
+		)
+	)
+
+	got, err := ParseJWKS([]byte(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]*rsa.PublicKey{"k1": &rsaKey.PublicKey}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("[TestCase 'RSA keys of the set'] Got: '%v' | Want: '%v'", got, want)
+	}
+}
@@ -0,0 +1,19 @@
+package database
+
+import "os"
+
+const (
+	// DriverMySQL selects the MySQL database, the default
+	DriverMySQL = "mysql"
+	// DriverPostgres selects the PostgreSQL database
+	DriverPostgres = "postgres"
+)
+
+// Driver returns the database driver selected by DB_DRIVER, MySQL when it is not set
+func Driver() string {
+	if driver := os.Getenv("DB_DRIVER"); driver != "" {
+		return driver
+	}
+
+	return DriverMySQL
+}
@@ -11,13 +11,24 @@ import (
 
 // NewMySQLConnection creates a new mysql connection
 func NewMySQLConnection() *sql.DB {
+	return openMySQL("parseTime=true")
+}
+
+// NewMySQLMigrationConnection creates a new mysql connection that runs several statements per call, as the
+// migration files do. It is kept apart from the connection of the application, which runs one at a time
+func NewMySQLMigrationConnection() *sql.DB {
+	return openMySQL("parseTime=true&multiStatements=true")
+}
+
+func openMySQL(params string) *sql.DB {
 	db, err := sql.Open("mysql", fmt.Sprintf(
-		"%s:%s@tcp(%s:%s)/%s?parseTime=true",
+		"%s:%s@tcp(%s:%s)/%s?%s",
 		os.Getenv("MYSQL_USER"),
 		os.Getenv("MYSQL_PASSWORD"),
 		os.Getenv("MYSQL_HOST"),
 		os.Getenv("MYSQL_PORT"),
 		os.Getenv("MYSQL_DATABASE"),
+		params,
 	))
 	if err != nil {
 		log.Fatal(err)
@@ -0,0 +1,37 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+
+	_ "github.com/lib/pq"
+)
+
+// NewPostgresConnection creates a new postgres connection
+func NewPostgresConnection() *sql.DB {
+	db, err := sql.Open("postgres", fmt.Sprintf(
+		"user=%s password=%s host=%s port=%s dbname=%s sslmode=%s",
+		os.Getenv("POSTGRES_USER"),
+		os.Getenv("POSTGRES_PASSWORD"),
+		os.Getenv("POSTGRES_HOST"),
+		os.Getenv("POSTGRES_PORT"),
+		os.Getenv("POSTGRES_DB"),
+		sslMode(),
+	))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return db
+}
+
+// sslMode returns the POSTGRES_SSLMODE setting, disabled by default as in the local environment
+func sslMode() string {
+	if mode := os.Getenv("POSTGRES_SSLMODE"); mode != "" {
+		return mode
+	}
+
+	return "disable"
+}
@@ -0,0 +1,34 @@
+package event
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// ChannelPublisher hands the events to in-process consumers, such as tests or local subscribers
+type ChannelPublisher struct {
+	events chan domain.Event
+}
+
+// NewChannelPublisher creates new ChannelPublisher buffering up to size events
+func NewChannelPublisher(size int) ChannelPublisher {
+	return ChannelPublisher{
+		events: make(chan domain.Event, size),
+	}
+}
+
+// Publish sends the event, waiting for room in the buffer until the context is done
+func (c ChannelPublisher) Publish(ctx context.Context, e domain.Event) error {
+	select {
+	case c.events <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Events returns the channel the events are delivered to
+func (c ChannelPublisher) Events() <-chan domain.Event {
+	return c.events
+}
@@ -0,0 +1,27 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// envelope is the JSON representation of an event delivered by the local publishers
+type envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	AccountID  string          `json:"account_id"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+func marshal(e domain.Event) ([]byte, error) {
+	return json.Marshal(envelope{
+		ID:         e.ID(),
+		Type:       e.Type(),
+		AccountID:  e.AccountID(),
+		OccurredAt: e.OccurredAt(),
+		Payload:    e.Payload(),
+	})
+}
@@ -0,0 +1,50 @@
+package event
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// FilePublisher appends the events to a file, one JSON document per line, for local consumers to tail
+type FilePublisher struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFilePublisher creates new FilePublisher writing to path
+func NewFilePublisher(path string) *FilePublisher {
+	return &FilePublisher{
+		path: path,
+	}
+}
+
+// Publish appends the event to the file and syncs it before returning, so an accepted event is not lost
+func (f *FilePublisher) Publish(_ context.Context, e domain.Event) error {
+	b, err := marshal(e)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Write(append(b, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err = file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
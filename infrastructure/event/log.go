@@ -0,0 +1,32 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+)
+
+// LogPublisher writes the events to the application log
+type LogPublisher struct {
+	log logger.Logger
+}
+
+// NewLogPublisher creates new LogPublisher with its dependencies
+func NewLogPublisher(log logger.Logger) LogPublisher {
+	return LogPublisher{
+		log: log,
+	}
+}
+
+// Publish logs the event as JSON
+func (l LogPublisher) Publish(ctx context.Context, e domain.Event) error {
+	b, err := marshal(e)
+	if err != nil {
+		return err
+	}
+
+	l.log.Info(ctx, "event published", logger.AccountID(e.AccountID()), "event", json.RawMessage(b))
+	return nil
+}
@@ -0,0 +1,31 @@
+package event
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// MultiPublisher publishes every event to several publishers in turn
+type MultiPublisher struct {
+	publishers []domain.EventPublisher
+}
+
+// NewMultiPublisher creates new MultiPublisher over the publishers
+func NewMultiPublisher(publishers ...domain.EventPublisher) MultiPublisher {
+	return MultiPublisher{
+		publishers: publishers,
+	}
+}
+
+// Publish stops at the first publisher that fails. The event is then relayed again to all of
+// them, so each publisher must tolerate receiving it more than once
+func (m MultiPublisher) Publish(ctx context.Context, e domain.Event) error {
+	for _, publisher := range m.publishers {
+		if err := publisher.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package event
+
+import (
+	"log"
+	"os"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+)
+
+const (
+	// PublisherLog selects the LogPublisher, the default
+	PublisherLog = "log"
+	// PublisherFile selects the FilePublisher writing to EVENT_FILE
+	PublisherFile = "file"
+
+	defaultEventFile = "events.jsonl"
+)
+
+// NewPublisher creates the publisher selected by EVENT_PUBLISHER
+func NewPublisher(l logger.Logger) domain.EventPublisher {
+	switch publisher := os.Getenv("EVENT_PUBLISHER"); publisher {
+	case "", PublisherLog:
+		return NewLogPublisher(l)
+	case PublisherFile:
+		path := os.Getenv("EVENT_FILE")
+		if path == "" {
+			path = defaultEventFile
+		}
+
+		return NewFilePublisher(path)
+	default:
+		log.Fatalf("unsupported EVENT_PUBLISHER %q", publisher)
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func TestFilePublisher_Publish(t *testing.T) {
+	var (
+		path      = filepath.Join(t.TempDir(), "events.jsonl")
+		publisher = NewFilePublisher(path)
+		occurred  = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	)
+
+	for _, id := range []string{"1", "2"} {
+		e := domain.NewEvent(id, domain.EventAccountCreated, "a", []byte(`{"id":"a"}`), occurred)
+		if err := publisher.Publish(context.Background(), e); err != nil {
+			t.Fatalf("[TestCase '%s'] Err: '%v'", "Append events", err)
+		}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Append events", err)
+	}
+
+	var want = strings.Join([]string{
+		`{"id":"1","type":"AccountCreated","account_id":"a","occurred_at":"2026-10-18T12:00:00Z","payload":{"id":"a"}}`,
+		`{"id":"2","type":"AccountCreated","account_id":"a","occurred_at":"2026-10-18T12:00:00Z","payload":{"id":"a"}}`,
+	}, "\n") + "\n"
+
+	if string(b) != want {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Append events", string(b), want)
+	}
+}
+
+func TestChannelPublisher_Publish(t *testing.T) {
+	var (
+		publisher = NewChannelPublisher(1)
+		e         = domain.NewEvent("1", domain.EventAccountCreated, "a", nil, time.Time{})
+	)
+
+	if err := publisher.Publish(context.Background(), e); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Deliver", err)
+	}
+
+	if got := <-publisher.Events(); got.ID() != e.ID() {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Deliver", got.ID(), e.ID())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_ = publisher.Publish(context.Background(), e)
+	if err := publisher.Publish(ctx, e); err != context.Canceled {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Full buffer", err, context.Canceled)
+	}
+}
+
+type stubPublisher struct {
+	published *[]string
+	name      string
+	err       error
+}
+
+func (s stubPublisher) Publish(_ context.Context, _ domain.Event) error {
+	*s.published = append(*s.published, s.name)
+	return s.err
+}
+
+func TestMultiPublisher_Publish(t *testing.T) {
+	var errBroker = errors.New("broker unavailable")
+
+	tests := []struct {
+		name          string
+		errs          []error
+		wantPublished []string
+		wantErr       error
+	}{
+		{
+			name:          "Publish to every publisher in order",
+			errs:          []error{nil, nil},
+			wantPublished: []string{"0", "1"},
+		},
+		{
+			name:          "Stop at the first failure",
+			errs:          []error{errBroker, nil},
+			wantPublished: []string{"0"},
+			wantErr:       errBroker,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				published  []string
+				publishers []domain.EventPublisher
+			)
+
+			for i, err := range tt.errs {
+				publishers = append(publishers, stubPublisher{published: &published, name: strconv.Itoa(i), err: err})
+			}
+
+			err := NewMultiPublisher(publishers...).Publish(context.Background(), domain.Event{})
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(published, tt.wantPublished) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, published, tt.wantPublished)
+			}
+		})
+	}
+}
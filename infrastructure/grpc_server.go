@@ -0,0 +1,27 @@
+package infrastructure
+
+import (
+	"github.com/GSabadini/go-transactions/adapter/rpc"
+	"github.com/GSabadini/go-transactions/adapter/rpc/pb"
+
+	"google.golang.org/grpc"
+)
+
+// grpcServer creates the gRPC server of the internal services, served next to the HTTP server and
+// authenticated with the same credentials
+func (a HTTPServer) grpcServer() *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		rpc.CorrelationID,
+		rpc.NewAuthentication(a.tokens, a.authenticateAPIKeyUseCase()).Execute,
+	))
+
+	pb.RegisterTransactionsServer(server, rpc.NewTransactionsServer(
+		a.createAccountUseCase(),
+		a.findAccountByIDUseCase(),
+		a.createTransactionUseCase(),
+		a.logger,
+		a.validator,
+	))
+
+	return server
+}
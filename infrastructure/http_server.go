@@ -2,11 +2,10 @@ package infrastructure
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 	"github.com/GSabadini/go-transactions/adapter/api/middleware"
-	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -15,30 +14,70 @@ import (
 
 	"github.com/GSabadini/go-transactions/adapter/api/handler"
 	"github.com/GSabadini/go-transactions/adapter/presenter"
-	"github.com/GSabadini/go-transactions/adapter/repository"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/auth"
 	"github.com/GSabadini/go-transactions/infrastructure/database"
+	"github.com/GSabadini/go-transactions/infrastructure/event"
 	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/infrastructure/metrics"
 	"github.com/GSabadini/go-transactions/infrastructure/router"
+	"github.com/GSabadini/go-transactions/infrastructure/tracing"
 	"github.com/GSabadini/go-transactions/infrastructure/validation"
+	"github.com/GSabadini/go-transactions/infrastructure/webhook"
+	"github.com/GSabadini/go-transactions/infrastructure/worker"
 	"github.com/GSabadini/go-transactions/usecase"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 )
 
+const (
+	// authorizationHoldTTL defines how long an authorization holds the credit limit before it expires
+	authorizationHoldTTL = 7 * 24 * time.Hour
+)
+
 // HTTPServer define an application structure
 type HTTPServer struct {
-	database  *sql.DB
-	logger    *log.Logger
+	storage   storage
+	publisher domain.EventPublisher
+	logger    logger.Logger
+	metrics   *metrics.Metrics
+	tracing   *tracing.Provider
+	tokens    *auth.TokenVerifier
 	router    *mux.Router
 	validator *validator.Validate
 }
 
 // NewHTTPServer creates new HTTPServer with its dependencies
 func NewHTTPServer() *HTTPServer {
+	var (
+		l = logger.NewLog()
+		m = metrics.New()
+		s = newStorage()
+	)
+
+	s.uow = m.UnitOfWork(s.uow)
+	if s.db != nil {
+		m.RegisterDB(s.db, database.Driver())
+	}
+
+	t, err := tracing.NewProvider(context.Background())
+	if err != nil {
+		l.Fatal(context.Background(), "failed to creating tracer provider", err)
+	}
+
+	tokens, err := auth.NewTokenVerifierFromEnv()
+	if err != nil {
+		l.Fatal(context.Background(), "failed to loading token keys", err)
+	}
+
 	return &HTTPServer{
-		database:  database.NewMySQLConnection(),
-		logger:    logger.NewLog(),
+		storage:   s,
+		publisher: event.NewPublisher(l),
+		logger:    l,
+		metrics:   m,
+		tracing:   t,
+		tokens:    tokens,
 		router:    router.NewGorillaMux(),
 		validator: validation.NewValidator(),
 	}
@@ -46,20 +85,102 @@ func NewHTTPServer() *HTTPServer {
 
 // Start run the application
 func (a HTTPServer) Start() {
+	a.router.Handle("/metrics", a.metrics.Handler()).Methods(http.MethodGet)
+
 	api := a.router.PathPrefix("/v1").Subrouter()
 
-	api.Use(middleware.NewCorrelationID().Execute)
+	api.Use(
+		middleware.NewCorrelationID().Execute,
+		middleware.NewRoute().Execute,
+		middleware.NewMetrics(a.metrics).Execute,
+		middleware.NewTracing(a.tracing).Execute,
+	)
 
-	api.Handle("/accounts", a.createAccountHandler()).Methods(http.MethodPost)
-	api.Handle("/accounts/{account_id}", a.findAccountByIDHandler()).Methods(http.MethodGet)
+	api.HandleFunc("/health", healthCheck).Methods(http.MethodGet)
 
-	api.Handle("/transactions", a.createTransactionHandler()).Methods(http.MethodPost)
+	private := api.NewRoute().Subrouter()
+	private.Use(middleware.NewAuthentication(a.tokens, a.authenticateAPIKeyUseCase(), a.logger).Execute)
 
-	//api.Handle("/cashout", a.createCashoutHandler()).Methods(http.MethodPost)
-	//api.Handle("/cashin", a.createTransactionHandler()).Methods(http.MethodPost)
-	//api.Handle("/peer-too-peer", a.createTransactionHandler()).Methods(http.MethodPost)
+	private.Handle("/accounts", scope(domain.ScopeAccountsWrite, a.createAccountHandler())).Methods(http.MethodPost)
+	private.Handle(
+		"/accounts/{account_id}",
+		scope(domain.ScopeAccountsRead, a.findAccountByIDHandler()),
+	).Methods(http.MethodGet)
+	private.Handle(
+		"/accounts/{account_id}/transactions",
+		scope(domain.ScopeAccountsRead, a.findTransactionsByAccountIDHandler()),
+	).Methods(http.MethodGet)
+	private.Handle(
+		"/accounts/{account_id}/statement",
+		scope(domain.ScopeAccountsRead, a.findAccountStatementHandler()),
+	).Methods(http.MethodGet)
 
-	api.HandleFunc("/health", healthCheck).Methods(http.MethodGet)
+	private.Handle(
+		"/transactions",
+		scope(domain.ScopeTransactionsWrite, a.idempotency().Execute(a.createTransactionHandler())),
+	).Methods(http.MethodPost)
+	private.Handle(
+		"/transactions/{transaction_id}/reversal",
+		scope(domain.ScopeTransactionsWrite, a.idempotency().Execute(a.reverseTransactionHandler())),
+	).Methods(http.MethodPost)
+
+	private.Handle(
+		"/authorizations",
+		scope(domain.ScopeTransactionsWrite, a.idempotency().Execute(a.createAuthorizationHandler())),
+	).Methods(http.MethodPost)
+	private.Handle(
+		"/authorizations/{authorization_id}/capture",
+		scope(domain.ScopeTransactionsWrite, a.idempotency().Execute(a.captureAuthorizationHandler())),
+	).Methods(http.MethodPost)
+	private.Handle(
+		"/authorizations/{authorization_id}/void",
+		scope(domain.ScopeTransactionsWrite, a.voidAuthorizationHandler()),
+	).Methods(http.MethodPost)
+
+	private.Handle(
+		"/cashin",
+		scope(domain.ScopeTransactionsWrite, a.idempotency().Execute(a.createCashInHandler())),
+	).Methods(http.MethodPost)
+	private.Handle(
+		"/peer-to-peer",
+		scope(domain.ScopeTransactionsWrite, a.idempotency().Execute(a.createTransferHandler())),
+	).Methods(http.MethodPost)
+
+	// The operation catalog is shared by every tenant, so only the operators of the system manage it
+	private.Handle("/admin/operations", scope(domain.ScopeSystem, a.createOperationHandler())).Methods(http.MethodPost)
+	private.Handle("/admin/operations", scope(domain.ScopeSystem, a.findAllOperationsHandler())).Methods(http.MethodGet)
+	private.Handle(
+		"/admin/operations/{operation_id}/disable",
+		scope(domain.ScopeSystem, a.disableOperationHandler()),
+	).Methods(http.MethodPost)
+	private.Handle(
+		"/admin/ledger/reconciliation",
+		scope(domain.ScopeAdmin, a.reconcileLedgerHandler()),
+	).Methods(http.MethodGet)
+	private.Handle("/admin/api-keys", scope(domain.ScopeAdmin, a.createAPIKeyHandler())).Methods(http.MethodPost)
+	private.Handle(
+		"/admin/api-keys/{api_key_id}",
+		scope(domain.ScopeAdmin, a.revokeAPIKeyHandler()),
+	).Methods(http.MethodDelete)
+
+	private.Handle("/webhooks", scope(domain.ScopeWebhooksWrite, a.createWebhookHandler())).Methods(http.MethodPost)
+	private.Handle("/webhooks", scope(domain.ScopeWebhooksRead, a.findAllWebhooksHandler())).Methods(http.MethodGet)
+	private.Handle(
+		"/webhooks/{webhook_id}",
+		scope(domain.ScopeWebhooksRead, a.findWebhookByIDHandler()),
+	).Methods(http.MethodGet)
+	private.Handle(
+		"/webhooks/{webhook_id}",
+		scope(domain.ScopeWebhooksWrite, a.updateWebhookHandler()),
+	).Methods(http.MethodPut)
+	private.Handle(
+		"/webhooks/{webhook_id}",
+		scope(domain.ScopeWebhooksWrite, a.deleteWebhookHandler()),
+	).Methods(http.MethodDelete)
+	private.Handle(
+		"/webhooks/{webhook_id}/deliveries",
+		scope(domain.ScopeWebhooksRead, a.findWebhookDeliveriesHandler()),
+	).Methods(http.MethodGet)
 
 	server := &http.Server{
 		ReadTimeout:  15 * time.Second,
@@ -71,68 +192,430 @@ func (a HTTPServer) Start() {
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
+	// The workers are the system itself, acting on the accounts of every tenant
+	ctxWorker, cancelWorker := context.WithCancel(domain.WithAllTenants(context.Background()))
+	defer cancelWorker()
+
+	go a.authorizationSweeper().Start(ctxWorker)
+	go a.outboxRelay().Start(ctxWorker)
+	go a.webhookDispatcher().Start(ctxWorker)
+
+	grpcServer := a.grpcServer()
+
+	go func() {
+		a.logger.Info(ctxWorker, "Starting HTTP Server", "port", os.Getenv("APP_PORT"))
+		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+			a.logger.Fatal(ctxWorker, "failed to serving HTTP", err)
+		}
+	}()
+
 	go func() {
-		a.logger.Println("Starting HTTP Server in port:", os.Getenv("APP_PORT"))
-		a.logger.Fatal(server.ListenAndServe())
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%s", os.Getenv("GRPC_PORT")))
+		if err != nil {
+			a.logger.Fatal(ctxWorker, "failed to listening gRPC", err)
+		}
+
+		a.logger.Info(ctxWorker, "Starting gRPC Server", "port", os.Getenv("GRPC_PORT"))
+		if err := grpcServer.Serve(lis); err != nil {
+			a.logger.Fatal(ctxWorker, "failed to serving gRPC", err)
+		}
 	}()
 
 	<-stop
+	cancelWorker()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer func() {
 		cancel()
 	}()
 
+	// GracefulStop waits for the running calls, so the pending ones are cancelled once the timeout expires
+	go func() {
+		<-ctx.Done()
+		grpcServer.Stop()
+	}()
+	grpcServer.GracefulStop()
+
 	if err := server.Shutdown(ctx); err != nil {
-		a.logger.Fatal("Server Shutdown Failed")
+		a.logger.Fatal(ctx, "Server Shutdown Failed", err)
 	}
 
-	a.logger.Println("Service down")
+	if err := a.tracing.Shutdown(ctx); err != nil {
+		a.logger.Error(ctx, "failed to exporting spans", err)
+	}
+
+	a.logger.Info(ctx, "Service down")
 }
 
 func (a HTTPServer) createAccountHandler() http.HandlerFunc {
-	uc := usecase.NewCreateAccountInteractor(
-		repository.NewCreateAccountRepository(a.database),
+	return handler.NewCreateAccountHandler(a.createAccountUseCase(), a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) createAccountUseCase() usecase.CreateAccountUseCase {
+	return usecase.NewCreateAccountInteractor(
+		a.storage.uow,
+		a.storage.accountCreator,
 		presenter.NewCreateAccountPresenter(),
 		5*time.Second,
 	)
-
-	return handler.NewCreateAccountHandler(uc, a.logger, a.validator).Handle
 }
 
 func (a HTTPServer) findAccountByIDHandler() http.HandlerFunc {
-	uc := usecase.NewFindAccountByIDInteractor(
-		repository.NewAccountByIDRepository(a.database),
+	return handler.NewFindAccountByIDHandler(a.findAccountByIDUseCase(), a.logger).Handle
+}
+
+func (a HTTPServer) findAccountByIDUseCase() usecase.FindAccountByIDUseCase {
+	return usecase.NewFindAccountByIDInteractor(
+		a.storage.accountFinder,
 		presenter.NewFindAccountByIDPresenter(),
 		5*time.Second,
 	)
+}
 
-	return handler.NewFindAccountByIDHandler(uc, a.logger).Handle
+func (a HTTPServer) findTransactionsByAccountIDHandler() http.HandlerFunc {
+	uc := usecase.NewFindTransactionsByAccountIDInteractor(
+		a.storage.transactionFinder,
+		a.storage.accountFinder,
+		presenter.NewFindTransactionsByAccountIDPresenter(),
+		5*time.Second,
+	)
+
+	return handler.NewFindTransactionsByAccountIDHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) findAccountStatementHandler() http.HandlerFunc {
+	uc := usecase.NewFindAccountStatementInteractor(
+		a.storage.uow,
+		a.storage.accountFinder,
+		a.storage.transactionFinder,
+		a.storage.ledgerLimitFinder,
+		presenter.NewFindAccountStatementPresenter(),
+		usecase.NewSystemClock(),
+		10*time.Second,
+	)
+
+	return handler.NewFindAccountStatementHandler(uc, a.logger, a.validator).Handle
 }
 
 func (a HTTPServer) createTransactionHandler() http.HandlerFunc {
-	uc := usecase.NewCreateTransactionInteractor(
-		repository.NewCreateTransactionRepository(a.database),
-		repository.NewAccountByIDRepository(a.database),
-		repository.NewUpdateAccountCreditLimitRepository(a.database),
+	return handler.NewCreateTransactionHandler(a.createTransactionUseCase(), a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) createTransactionUseCase() usecase.CreateTransactionUseCase {
+	return usecase.NewCreateTransactionInteractor(
+		a.storage.uow,
+		a.storage.transactionCreator,
+		a.storage.transactionBalanceFinder,
+		a.storage.transactionBalanceUpdater,
+		a.storage.accountLocker,
+		a.storage.accountUpdater,
+		a.storage.operations,
+		a.storage.outboxCreator,
 		presenter.NewCreateTransactionPresenter(),
+		a.metrics,
+		5*time.Second,
+	)
+}
+
+func (a HTTPServer) reverseTransactionHandler() http.HandlerFunc {
+	uc := usecase.NewReverseTransactionInteractor(
+		a.storage.uow,
+		a.storage.transactionCreator,
+		a.storage.transactionByIDFinder,
+		a.storage.transactionReversedFinder,
+		a.storage.transactionBalanceFinder,
+		a.storage.transactionBalanceUpdater,
+		a.storage.accountLocker,
+		a.storage.accountUpdater,
+		presenter.NewReverseTransactionPresenter(),
+		a.metrics,
+		5*time.Second,
+	)
+
+	return handler.NewReverseTransactionHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) idempotency() *middleware.Idempotency {
+	return middleware.NewIdempotency(
+		a.storage.idempotencyKeyCreator,
+		a.storage.idempotencyKeyFinder,
+		a.storage.idempotencyKeyUpdater,
+		a.storage.idempotencyKeyReclaimer,
+		a.storage.idempotencyKeyDeleter,
+		a.logger,
+		time.Minute,
+	)
+}
+
+func (a HTTPServer) createCashInHandler() http.HandlerFunc {
+	uc := usecase.NewCreateCashInInteractor(
+		a.storage.uow,
+		a.storage.cashInCreator,
+		a.storage.transactionCreator,
+		a.storage.transactionBalanceFinder,
+		a.storage.transactionBalanceUpdater,
+		a.storage.accountLocker,
+		a.storage.accountUpdater,
+		presenter.NewCreateCashInPresenter(),
+		a.metrics,
 		5*time.Second,
 	)
 
-	return handler.NewCreateTransactionHandler(uc, a.logger, a.validator).Handle
+	return handler.NewCreateCashInHandler(uc, a.logger, a.validator).Handle
 }
 
-//func (a HTTPServer) createCashoutHandler() http.HandlerFunc {
-//	uc := usecase.NewCreateAuthorizationInteractor(
-//		repository.NewCreateAuthorizationRepository(a.database),
-//		repository.NewAccountByIDRepository(a.database),
-//		repository.NewUpdateAccountCreditLimitRepository(a.database),
-//		presenter.NewCreateCashoutPresenter(),
-//		10*time.Second,
-//	)
-//
-//	return handler.NewCreateTransactionHandler(uc, a.logger, a.validator).Handle
-//}
+func (a HTTPServer) createTransferHandler() http.HandlerFunc {
+	uc := usecase.NewCreateTransferInteractor(
+		a.storage.uow,
+		a.storage.transferCreator,
+		a.storage.transactionCreator,
+		a.storage.transactionBalanceFinder,
+		a.storage.transactionBalanceUpdater,
+		a.storage.accountLocker,
+		a.storage.accountUpdater,
+		presenter.NewCreateTransferPresenter(),
+		a.metrics,
+		5*time.Second,
+	)
+
+	return handler.NewCreateTransferHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) createAuthorizationHandler() http.HandlerFunc {
+	uc := usecase.NewCreateAuthorizationInteractor(
+		a.storage.uow,
+		a.storage.authorizationCreator,
+		a.storage.accountLocker,
+		a.storage.accountUpdater,
+		a.storage.operations,
+		presenter.NewCreateAuthorizationPresenter(),
+		usecase.NewSystemClock(),
+		authorizationHoldTTL,
+		5*time.Second,
+	)
+
+	return handler.NewCreateAuthorizationHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) captureAuthorizationHandler() http.HandlerFunc {
+	uc := usecase.NewCaptureAuthorizationInteractor(
+		a.storage.uow,
+		a.storage.authorizationFinder,
+		a.storage.authorizationUpdater,
+		a.storage.transactionCreator,
+		a.storage.transactionBalanceFinder,
+		a.storage.transactionBalanceUpdater,
+		a.storage.accountLocker,
+		a.storage.accountUpdater,
+		presenter.NewCaptureAuthorizationPresenter(),
+		a.metrics,
+		usecase.NewSystemClock(),
+		5*time.Second,
+	)
+
+	return handler.NewCaptureAuthorizationHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) voidAuthorizationHandler() http.HandlerFunc {
+	uc := usecase.NewVoidAuthorizationInteractor(
+		a.storage.uow,
+		a.storage.authorizationFinder,
+		a.storage.authorizationUpdater,
+		a.storage.accountLocker,
+		a.storage.accountUpdater,
+		presenter.NewVoidAuthorizationPresenter(),
+		usecase.NewSystemClock(),
+		5*time.Second,
+	)
+
+	return handler.NewVoidAuthorizationHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) createOperationHandler() http.HandlerFunc {
+	uc := usecase.NewCreateOperationInteractor(
+		a.storage.operations,
+		presenter.NewCreateOperationPresenter(),
+		5*time.Second,
+	)
+
+	return handler.NewCreateOperationHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) findAllOperationsHandler() http.HandlerFunc {
+	uc := usecase.NewFindAllOperationsInteractor(
+		a.storage.operations,
+		presenter.NewFindAllOperationsPresenter(),
+		5*time.Second,
+	)
+
+	return handler.NewFindAllOperationsHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) reconcileLedgerHandler() http.HandlerFunc {
+	uc := usecase.NewReconcileLedgerInteractor(
+		a.storage.ledgerBalanceFinder,
+		presenter.NewReconcileLedgerPresenter(),
+		30*time.Second,
+	)
+
+	return handler.NewReconcileLedgerHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) disableOperationHandler() http.HandlerFunc {
+	uc := usecase.NewDisableOperationInteractor(
+		a.storage.operations,
+		a.storage.operations,
+		presenter.NewDisableOperationPresenter(),
+		5*time.Second,
+	)
+
+	return handler.NewDisableOperationHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) createWebhookHandler() http.HandlerFunc {
+	uc := usecase.NewCreateWebhookInteractor(
+		a.storage.webhooks,
+		a.storage.accountFinder,
+		presenter.NewCreateWebhookPresenter(),
+		usecase.NewSystemClock(),
+		5*time.Second,
+	)
+
+	return handler.NewCreateWebhookHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) findAllWebhooksHandler() http.HandlerFunc {
+	uc := usecase.NewFindAllWebhooksInteractor(
+		a.storage.webhooks,
+		presenter.NewFindAllWebhooksPresenter(),
+		5*time.Second,
+	)
+
+	return handler.NewFindAllWebhooksHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) findWebhookByIDHandler() http.HandlerFunc {
+	uc := usecase.NewFindWebhookByIDInteractor(
+		a.storage.webhooks,
+		presenter.NewFindWebhookByIDPresenter(),
+		5*time.Second,
+	)
+
+	return handler.NewFindWebhookByIDHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) updateWebhookHandler() http.HandlerFunc {
+	uc := usecase.NewUpdateWebhookInteractor(
+		a.storage.webhooks,
+		a.storage.webhooks,
+		presenter.NewUpdateWebhookPresenter(),
+		5*time.Second,
+	)
+
+	return handler.NewUpdateWebhookHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) deleteWebhookHandler() http.HandlerFunc {
+	uc := usecase.NewDeleteWebhookInteractor(a.storage.webhooks, 5*time.Second)
+
+	return handler.NewDeleteWebhookHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) findWebhookDeliveriesHandler() http.HandlerFunc {
+	uc := usecase.NewFindWebhookDeliveriesInteractor(
+		a.storage.webhooks,
+		a.storage.webhookDeliveries,
+		presenter.NewFindWebhookDeliveriesPresenter(),
+		5*time.Second,
+	)
+
+	return handler.NewFindWebhookDeliveriesHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) createAPIKeyHandler() http.HandlerFunc {
+	uc := usecase.NewCreateAPIKeyInteractor(
+		a.storage.apiKeys,
+		presenter.NewCreateAPIKeyPresenter(),
+		usecase.NewSystemClock(),
+		5*time.Second,
+	)
+
+	return handler.NewCreateAPIKeyHandler(uc, a.logger, a.validator).Handle
+}
+
+func (a HTTPServer) revokeAPIKeyHandler() http.HandlerFunc {
+	uc := usecase.NewRevokeAPIKeyInteractor(
+		a.storage.apiKeys,
+		a.storage.apiKeys,
+		usecase.NewSystemClock(),
+		5*time.Second,
+	)
+
+	return handler.NewRevokeAPIKeyHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) authenticateAPIKeyUseCase() usecase.AuthenticateAPIKeyUseCase {
+	return usecase.NewAuthenticateAPIKeyInteractor(a.storage.apiKeys, 5*time.Second)
+}
+
+func (a HTTPServer) authorizationSweeper() worker.AuthorizationSweeper {
+	uc := usecase.NewExpireAuthorizationsInteractor(
+		a.storage.uow,
+		a.storage.authorizationExpiredFinder,
+		a.storage.authorizationFinder,
+		a.storage.authorizationUpdater,
+		a.storage.accountLocker,
+		a.storage.accountUpdater,
+		usecase.NewSystemClock(),
+		100,
+		30*time.Second,
+	)
+
+	return worker.NewAuthorizationSweeper(uc, a.logger, time.Minute)
+}
+
+func (a HTTPServer) outboxRelay() worker.OutboxRelay {
+	uc := usecase.NewRelayOutboxInteractor(
+		a.storage.uow,
+		a.storage.outboxFinder,
+		a.storage.outboxUpdater,
+		a.storage.outboxPostponer,
+		event.NewMultiPublisher(
+			a.publisher,
+			usecase.NewScheduleWebhookDeliveriesInteractor(
+				a.storage.webhooks,
+				a.storage.webhookDeliveries,
+				usecase.NewSystemClock(),
+			),
+		),
+		usecase.NewSystemClock(),
+		100,
+		time.Minute,
+		30*time.Second,
+	)
+
+	return worker.NewOutboxRelay(uc, a.logger, time.Second)
+}
+
+func (a HTTPServer) webhookDispatcher() worker.WebhookDispatcher {
+	uc := usecase.NewDispatchWebhooksInteractor(
+		a.storage.uow,
+		a.storage.webhookDeliveries,
+		a.storage.webhookDeliveries,
+		a.storage.webhooks,
+		webhook.NewHTTPSender(10*time.Second),
+		usecase.NewSystemClock(),
+		10,
+		2*time.Minute,
+	)
+
+	return worker.NewWebhookDispatcher(uc, a.logger, time.Second)
+}
+
+// scope guards the handler with the scope the principal must be granted
+func scope(required string, h http.Handler) http.Handler {
+	return handler.RequireScope(required, h)
+}
 
 func healthCheck(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
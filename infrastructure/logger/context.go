@@ -0,0 +1,32 @@
+package logger
+
+import "context"
+
+type contextKey string
+
+const (
+	correlationIDContextKey contextKey = keyCorrelationID
+	routeContextKey         contextKey = keyRoute
+)
+
+// WithCorrelationID returns the context carrying the correlation id of the request
+func WithCorrelationID(ctx context.Context, ID string) context.Context {
+	return context.WithValue(ctx, correlationIDContextKey, ID)
+}
+
+// CorrelationID returns the correlation id carried by the context, empty when there is none
+func CorrelationID(ctx context.Context) string {
+	ID, _ := ctx.Value(correlationIDContextKey).(string)
+	return ID
+}
+
+// WithRoute returns the context carrying the route that serves the request
+func WithRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, routeContextKey, route)
+}
+
+// Route returns the route carried by the context, empty when there is none
+func Route(ctx context.Context) string {
+	route, _ := ctx.Value(routeContextKey).(string)
+	return route
+}
@@ -0,0 +1,102 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	// KindInvalidInput is the kind of the requests that could not be decoded or validated
+	KindInvalidInput = "invalid_input"
+	// KindNotFound is the kind of the errors of entities that do not exist
+	KindNotFound = "not_found"
+	// KindConflict is the kind of the errors of entities that already exist or were already processed
+	KindConflict = "conflict"
+	// KindRuleViolation is the kind of the errors of requests refused by a business rule
+	KindRuleViolation = "rule_violation"
+	// KindUnauthorized is the kind of the requests refused for their credentials or scopes
+	KindUnauthorized = "unauthorized"
+	// KindTimeout is the kind of the errors of operations that ran out of time or were canceled
+	KindTimeout = "timeout"
+	// KindInternal is the kind of every other error, such as the failures of the database
+	KindInternal = "internal"
+)
+
+var domainErrorKinds = map[error]string{
+	domain.ErrAccountNotFound:        KindNotFound,
+	domain.ErrAPIKeyNotFound:         KindNotFound,
+	domain.ErrAuthorizationNotFound:  KindNotFound,
+	domain.ErrIdempotencyKeyNotFound: KindNotFound,
+	domain.ErrOperationNotFound:      KindNotFound,
+	domain.ErrTransactionNotFound:    KindNotFound,
+	domain.ErrWebhookNotFound:        KindNotFound,
+
+	domain.ErrAccountAlreadyExists:        KindConflict,
+	domain.ErrCashInAlreadyProcessed:      KindConflict,
+	domain.ErrIdempotencyKeyAlreadyExists: KindConflict,
+	domain.ErrIdempotencyKeyInProgress:    KindConflict,
+	domain.ErrIdempotencyKeyReused:        KindConflict,
+	domain.ErrOperationAlreadyExists:      KindConflict,
+	domain.ErrTransactionAlreadyReversed:  KindConflict,
+
+	domain.ErrAccountInsufficientCreditLimit:    KindRuleViolation,
+	domain.ErrAuthorizationAccountMismatch:      KindRuleViolation,
+	domain.ErrAuthorizationCaptureExceedsAmount: KindRuleViolation,
+	domain.ErrAuthorizationExpired:              KindRuleViolation,
+	domain.ErrAuthorizationNotExpired:           KindRuleViolation,
+	domain.ErrAuthorizationNotPending:           KindRuleViolation,
+	domain.ErrCashInSourceInvalid:               KindRuleViolation,
+	domain.ErrDocumentInvalid:                   KindRuleViolation,
+	domain.ErrInstallmentsInvalid:               KindRuleViolation,
+	domain.ErrOperationDisabled:                 KindRuleViolation,
+	domain.ErrOperationInvalid:                  KindRuleViolation,
+	domain.ErrScopeInvalid:                      KindRuleViolation,
+	domain.ErrStatementPeriodInvalid:            KindRuleViolation,
+	domain.ErrTransactionCursorInvalid:          KindRuleViolation,
+	domain.ErrTransactionNotReversible:          KindRuleViolation,
+	domain.ErrTransactionReversalExceedsAmount:  KindRuleViolation,
+	domain.ErrTransferSameAccount:               KindRuleViolation,
+	domain.ErrWebhookEventInvalid:               KindRuleViolation,
+	domain.ErrWebhookURLInvalid:                 KindRuleViolation,
+
+	domain.ErrCredentialsInvalid: KindUnauthorized,
+	domain.ErrCredentialsMissing: KindUnauthorized,
+	domain.ErrScopeMissing:       KindUnauthorized,
+}
+
+// ErrorKind classifies the error, so the entries of the same kind of failure can be searched together
+func ErrorKind(err error) string {
+	var (
+		validationErrs validator.ValidationErrors
+		queryErrs      validation.Errors
+		syntaxErr      *json.SyntaxError
+		typeErr        *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.As(err, &validationErrs),
+		errors.As(err, &queryErrs),
+		errors.As(err, &syntaxErr),
+		errors.As(err, &typeErr),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF):
+		return KindInvalidInput
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return KindTimeout
+	}
+
+	for domainErr, kind := range domainErrorKinds {
+		if errors.Is(err, domainErr) {
+			return kind
+		}
+	}
+
+	return KindInternal
+}
@@ -0,0 +1,76 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+type (
+	// LogFake captures the entries instead of writing them, so tests can assert on them
+	LogFake struct {
+		slogLogger
+		capture *captureHandler
+	}
+
+	// Entry defines an entry captured by the LogFake
+	Entry struct {
+		Level   slog.Level
+		Message string
+		Attrs   map[string]any
+	}
+
+	captureHandler struct {
+		mu      sync.Mutex
+		entries []Entry
+	}
+)
+
+// NewLogFake creates new LogFake, which does not stop the application on Fatal
+func NewLogFake() *LogFake {
+	capture := &captureHandler{}
+
+	return &LogFake{
+		slogLogger: newSlogLogger(capture, func(int) {}),
+		capture:    capture,
+	}
+}
+
+// Entries returns the entries captured, oldest first
+func (l *LogFake) Entries() []Entry {
+	l.capture.mu.Lock()
+	defer l.capture.mu.Unlock()
+
+	return append([]Entry(nil), l.capture.entries...)
+}
+
+func (c *captureHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (c *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	entry := Entry{
+		Level:   r.Level,
+		Message: r.Message,
+		Attrs:   make(map[string]any),
+	}
+
+	r.Attrs(func(attr slog.Attr) bool {
+		entry.Attrs[attr.Key] = attr.Value.Any()
+		return true
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, entry)
+
+	return nil
+}
+
+func (c *captureHandler) WithAttrs([]slog.Attr) slog.Handler {
+	return c
+}
+
+func (c *captureHandler) WithGroup(string) slog.Handler {
+	return c
+}
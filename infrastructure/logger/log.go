@@ -1,20 +1,114 @@
 package logger
 
 import (
-	"log"
+	"context"
+	"log/slog"
 	"os"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+const (
+	keyCorrelationID = "correlation_id"
+	keyRoute         = "route"
+	keyPrincipalID   = "principal_id"
+	keyTenantID      = "tenant_id"
+	keyAccountID     = "account_id"
+	keyError         = "error"
+	keyErrorKind     = "error_kind"
+)
+
+type (
+	// Logger defines the structured log of the application. Every entry carries the correlation id, the
+	// route and the authenticated principal stored in the context, and the failed entries carry the error and its kind
+	Logger interface {
+		Info(ctx context.Context, msg string, args ...any)
+		Error(ctx context.Context, msg string, err error, args ...any)
+		Fatal(ctx context.Context, msg string, err error, args ...any)
+	}
+
+	slogLogger struct {
+		log  *slog.Logger
+		exit func(int)
+	}
+
+	// contextHandler adds the request data stored in the context to the records of the wrapped handler
+	contextHandler struct {
+		slog.Handler
+	}
 )
 
-// NewLog create new log.Logger
-func NewLog() *log.Logger {
-	return log.New(os.Stdout, "", log.LstdFlags|log.Lmicroseconds)
+// NewLog creates new Logger writing JSON lines to the standard output
+func NewLog() Logger {
+	return newSlogLogger(slog.NewJSONHandler(os.Stdout, nil), os.Exit)
+}
+
+func newSlogLogger(h slog.Handler, exit func(int)) slogLogger {
+	return slogLogger{
+		log:  slog.New(contextHandler{Handler: h}),
+		exit: exit,
+	}
+}
+
+// Info logs the entry of an operation that succeeded
+func (s slogLogger) Info(ctx context.Context, msg string, args ...any) {
+	s.log.InfoContext(ctx, msg, args...)
+}
+
+// Error logs the entry of an operation that failed
+func (s slogLogger) Error(ctx context.Context, msg string, err error, args ...any) {
+	s.log.ErrorContext(ctx, msg, append(errorAttrs(err), args...)...)
 }
 
-// NewLogFake create new log.Logger with fake writer
-func NewLogFake() *log.Logger {
-	return log.New(fakeWriter{}, "", 0)
+// Fatal logs the entry of an operation that failed and stops the application
+func (s slogLogger) Fatal(ctx context.Context, msg string, err error, args ...any) {
+	s.Error(ctx, msg, err, args...)
+	s.exit(1)
 }
 
-type fakeWriter struct{}
+// AccountID returns the attribute of the account an entry refers to
+func AccountID(ID string) slog.Attr {
+	return slog.String(keyAccountID, ID)
+}
+
+func errorAttrs(err error) []any {
+	if err == nil {
+		return nil
+	}
+
+	return []any{
+		slog.String(keyError, err.Error()),
+		slog.String(keyErrorKind, ErrorKind(err)),
+	}
+}
+
+// Handle adds the correlation id, the route, the principal and the tenant of the context to the record
+func (c contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ID := CorrelationID(ctx); ID != "" {
+		r.AddAttrs(slog.String(keyCorrelationID, ID))
+	}
+
+	if route := Route(ctx); route != "" {
+		r.AddAttrs(slog.String(keyRoute, route))
+	}
+
+	if principal, ok := domain.PrincipalFrom(ctx); ok {
+		r.AddAttrs(slog.String(keyPrincipalID, principal.ID()))
+	}
 
-func (f fakeWriter) Write(_ []byte) (n int, err error) { return 0, nil }
+	if tenant := domain.TenantFrom(ctx); tenant != "" {
+		r.AddAttrs(slog.String(keyTenantID, tenant))
+	}
+
+	return c.Handler.Handle(ctx, r)
+}
+
+// WithAttrs returns the handler with the attributes, still reading the context
+func (c contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: c.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup returns the handler with the group, still reading the context
+func (c contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: c.Handler.WithGroup(name)}
+}
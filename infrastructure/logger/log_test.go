@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/validation"
+)
+
+func TestSlogLogger_Error(t *testing.T) {
+	var (
+		buf bytes.Buffer
+		l   = newSlogLogger(slog.NewJSONHandler(&buf, nil), func(int) {})
+		ctx = WithRoute(WithCorrelationID(context.Background(), "f9882930"), "POST /v1/transactions")
+	)
+
+	ctx = domain.WithPrincipal(ctx, domain.NewPrincipal("client", domain.PrincipalToken, "tenant-a", nil))
+	ctx = domain.WithTenant(ctx, "tenant-a")
+
+	l.Error(ctx, "failed to creating transaction", domain.ErrAccountInsufficientCreditLimit, AccountID("1"))
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Err: '%v' | Line: '%s'", err, buf.String())
+	}
+
+	delete(got, "time")
+	want := map[string]interface{}{
+		"level":          "ERROR",
+		"msg":            "failed to creating transaction",
+		"correlation_id": "f9882930",
+		"route":          "POST /v1/transactions",
+		"principal_id":   "client",
+		"tenant_id":      "tenant-a",
+		"account_id":     "1",
+		"error":          "credit limit insufficient",
+		"error_kind":     KindRuleViolation,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got: '%v' | Want: '%v'", got, want)
+	}
+}
+
+func TestLogFake_Entries(t *testing.T) {
+	l := NewLogFake()
+
+	l.Info(WithCorrelationID(context.Background(), "f9882930"), "success to find account", AccountID("1"))
+
+	want := []Entry{
+		{
+			Level:   slog.LevelInfo,
+			Message: "success to find account",
+			Attrs:   map[string]any{"account_id": "1", "correlation_id": "f9882930"},
+		},
+	}
+
+	if got := l.Entries(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Got: '%v' | Want: '%v'", got, want)
+	}
+}
+
+func TestErrorKind(t *testing.T) {
+	var syntaxErr = json.Unmarshal([]byte("{"), &struct{}{})
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "Malformed body",
+			err:  syntaxErr,
+			want: KindInvalidInput,
+		},
+		{
+			name: "Invalid query",
+			err:  validation.Errors{"limit must be between 1 and 100"},
+			want: KindInvalidInput,
+		},
+		{
+			name: "Entity not found",
+			err:  domain.ErrAccountNotFound,
+			want: KindNotFound,
+		},
+		{
+			name: "Entity already exists",
+			err:  domain.ErrAccountAlreadyExists,
+			want: KindConflict,
+		},
+		{
+			name: "Business rule wrapped",
+			err:  fmt.Errorf("capture: %w", domain.ErrAuthorizationExpired),
+			want: KindRuleViolation,
+		},
+		{
+			name: "Timeout",
+			err:  context.DeadlineExceeded,
+			want: KindTimeout,
+		},
+		{
+			name: "Unknown",
+			err:  errors.New("connection refused"),
+			want: KindInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorKind(tt.err); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	outcomeCreated  = "created"
+	outcomeDeclined = "declined"
+	outcomeFailed   = "failed"
+
+	// unknownOperation labels the outcomes of operations that do not exist, so that the identifiers
+	// sent by the clients do not become labels
+	unknownOperation = "unknown"
+)
+
+// Metrics records the HTTP, business and database metrics of the application in its own registry
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requestDuration           *prometheus.HistogramVec
+	transactions              *prometheus.CounterVec
+	transactionsAmount        *prometheus.CounterVec
+	declinedInsufficientLimit *prometheus.CounterVec
+	unitOfWork                *prometheus.CounterVec
+}
+
+// New creates new Metrics with the Go runtime and process collectors registered
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Duration of the HTTP requests by method, route and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		transactions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transactions_total",
+			Help: "Transactions by operation and outcome: created, declined or failed.",
+		}, []string{"operation_id", "outcome"}),
+		transactionsAmount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transactions_amount_cents_total",
+			Help: "Absolute amount in cents of the transactions by operation and outcome.",
+		}, []string{"operation_id", "outcome"}),
+		declinedInsufficientLimit: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transactions_declined_insufficient_limit_total",
+			Help: "Transactions declined for insufficient credit limit by operation.",
+		}, []string{"operation_id"}),
+		unitOfWork: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "unit_of_work_total",
+			Help: "Units of work by result: commit or rollback.",
+		}, []string{"result"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requestDuration,
+		m.transactions,
+		m.transactionsAmount,
+		m.declinedInsufficientLimit,
+		m.unitOfWork,
+	)
+
+	return m
+}
+
+// RegisterDB registers the gauges of the connection pool of the database
+func (m *Metrics) RegisterDB(db *sql.DB, name string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveRequest records the duration of the HTTP request served
+func (m *Metrics) ObserveRequest(method string, route string, status int, duration time.Duration) {
+	m.requestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+// TransactionCreated records the transaction created
+func (m *Metrics) TransactionCreated(transaction domain.Transaction) {
+	m.transaction(transaction.Operation().ID(), outcomeCreated, transaction.Amount())
+}
+
+// TransactionDeclined records the transaction refused by a business rule
+func (m *Metrics) TransactionDeclined(operationID string, amount int64, reason error) {
+	m.transaction(operationID, outcomeDeclined, amount)
+
+	if errors.Is(reason, domain.ErrAccountInsufficientCreditLimit) {
+		m.declinedInsufficientLimit.WithLabelValues(operationLabel(operationID)).Inc()
+	}
+}
+
+// TransactionFailed records the transaction that could not be processed
+func (m *Metrics) TransactionFailed(operationID string, _ error) {
+	m.transactions.WithLabelValues(operationLabel(operationID), outcomeFailed).Inc()
+}
+
+func (m *Metrics) transaction(operationID string, outcome string, amount int64) {
+	if amount < 0 {
+		amount = -amount
+	}
+
+	m.transactions.WithLabelValues(operationLabel(operationID), outcome).Inc()
+	m.transactionsAmount.WithLabelValues(operationLabel(operationID), outcome).Add(float64(amount))
+}
+
+func operationLabel(operationID string) string {
+	if operationID == "" {
+		return unknownOperation
+	}
+
+	return operationID
+}
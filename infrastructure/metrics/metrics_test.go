@@ -0,0 +1,154 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type stubUnitOfWork struct{}
+
+func (stubUnitOfWork) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestMetrics_Transactions(t *testing.T) {
+	compraAVista, _ := domain.NewOperation(domain.CompraAVista)
+
+	tests := []struct {
+		name       string
+		record     func(m *Metrics)
+		wantCount  map[string]float64
+		wantAmount map[string]float64
+		wantLimit  map[string]float64
+	}{
+		{
+			name: "Created transaction with its absolute amount",
+			record: func(m *Metrics) {
+				m.TransactionCreated(domain.NewTransaction("1", "1", compraAVista, 150, -150, time.Time{}))
+			},
+			wantCount:  map[string]float64{domain.CompraAVista + " created": 1},
+			wantAmount: map[string]float64{domain.CompraAVista + " created": 150},
+			wantLimit:  map[string]float64{domain.CompraAVista: 0},
+		},
+		{
+			name: "Declined transaction for insufficient credit limit",
+			record: func(m *Metrics) {
+				m.TransactionDeclined(domain.CompraAVista, 200, domain.ErrAccountInsufficientCreditLimit)
+			},
+			wantCount:  map[string]float64{domain.CompraAVista + " declined": 1},
+			wantAmount: map[string]float64{domain.CompraAVista + " declined": 200},
+			wantLimit:  map[string]float64{domain.CompraAVista: 1},
+		},
+		{
+			name: "Declined transaction of an unknown operation",
+			record: func(m *Metrics) {
+				m.TransactionDeclined("", 200, domain.ErrOperationInvalid)
+			},
+			wantCount:  map[string]float64{unknownOperation + " declined": 1},
+			wantAmount: map[string]float64{unknownOperation + " declined": 200},
+			wantLimit:  map[string]float64{unknownOperation: 0},
+		},
+		{
+			name: "Failed transaction",
+			record: func(m *Metrics) {
+				m.TransactionFailed(domain.CompraAVista, errors.New("db"))
+			},
+			wantCount:  map[string]float64{domain.CompraAVista + " failed": 1},
+			wantAmount: map[string]float64{domain.CompraAVista + " failed": 0},
+			wantLimit:  map[string]float64{domain.CompraAVista: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			tt.record(m)
+
+			for labels, want := range tt.wantCount {
+				l := strings.Split(labels, " ")
+				if got := testutil.ToFloat64(m.transactions.WithLabelValues(l...)); got != want {
+					t.Errorf("[TestCase '%s'] Got transactions '%s': '%v' | Want: '%v'", tt.name, labels, got, want)
+				}
+			}
+
+			for labels, want := range tt.wantAmount {
+				l := strings.Split(labels, " ")
+				if got := testutil.ToFloat64(m.transactionsAmount.WithLabelValues(l...)); got != want {
+					t.Errorf("[TestCase '%s'] Got amount '%s': '%v' | Want: '%v'", tt.name, labels, got, want)
+				}
+			}
+
+			for operationID, want := range tt.wantLimit {
+				if got := testutil.ToFloat64(m.declinedInsufficientLimit.WithLabelValues(operationID)); got != want {
+					t.Errorf("[TestCase '%s'] Got declined '%s': '%v' | Want: '%v'", tt.name, operationID, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMetrics_UnitOfWork(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantCommit   float64
+		wantRollback float64
+	}{
+		{
+			name:       "Commit counted",
+			wantCommit: 1,
+		},
+		{
+			name:         "Rollback counted",
+			err:          errors.New("rollback"),
+			wantRollback: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+
+			err := m.UnitOfWork(stubUnitOfWork{}).WithTransaction(context.Background(), func(context.Context) error {
+				return tt.err
+			})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.err)
+			}
+
+			if got := testutil.ToFloat64(m.unitOfWork.WithLabelValues("commit")); got != tt.wantCommit {
+				t.Errorf("[TestCase '%s'] Got commits: '%v' | Want: '%v'", tt.name, got, tt.wantCommit)
+			}
+
+			if got := testutil.ToFloat64(m.unitOfWork.WithLabelValues("rollback")); got != tt.wantRollback {
+				t.Errorf("[TestCase '%s'] Got rollbacks: '%v' | Want: '%v'", tt.name, got, tt.wantRollback)
+			}
+		})
+	}
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	m := New()
+	m.ObserveRequest(http.MethodGet, "/v1/accounts/{account_id}", http.StatusOK, time.Millisecond)
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	want := fmt.Sprintf(
+		`http_request_duration_seconds_count{method="%s",route="%s",status="%d"} 1`,
+		http.MethodGet, "/v1/accounts/{account_id}", http.StatusOK,
+	)
+	if !strings.Contains(w.Body.String(), want) {
+		t.Errorf("Got: '%s' | Want: '%s'", w.Body.String(), want)
+	}
+}
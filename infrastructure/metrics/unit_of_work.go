@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type unitOfWork struct {
+	uow     domain.UnitOfWork
+	metrics *Metrics
+}
+
+// UnitOfWork decorates the domain.UnitOfWork counting its commits and rollbacks
+func (m *Metrics) UnitOfWork(uow domain.UnitOfWork) domain.UnitOfWork {
+	return unitOfWork{uow: uow, metrics: m}
+}
+
+func (u unitOfWork) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	err := u.uow.WithTransaction(ctx, fn)
+	if err != nil {
+		u.metrics.unitOfWork.WithLabelValues("rollback").Inc()
+		return err
+	}
+
+	u.metrics.unitOfWork.WithLabelValues("commit").Inc()
+	return nil
+}
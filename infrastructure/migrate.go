@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/GSabadini/go-transactions/infrastructure/database"
+	"github.com/GSabadini/go-transactions/infrastructure/migration"
+)
+
+const (
+	// migrateTimeout bounds a whole migrate command, including the wait for the migration lock
+	migrateTimeout = 5 * time.Minute
+)
+
+// Migrate runs the migrate subcommand against the database selected by DB_DRIVER
+func Migrate(args []string) {
+	if len(args) != 1 {
+		log.Fatal("usage: go-transactions migrate up|down|status")
+	}
+
+	db, dialect := openDatabase()
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+
+	var migrator = migration.NewMigrator(db, dialect)
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("reverted %04d_%s\n", reverted.Version, reverted.Name)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, status := range statuses {
+			var state = "pending"
+			if status.Applied {
+				state = "applied at " + status.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, state)
+		}
+	default:
+		log.Fatalf("unknown migrate command %q, want up, down or status", args[0])
+	}
+}
+
+// autoMigrate applies the pending migrations on start when DB_AUTO_MIGRATE is true
+func autoMigrate() {
+	if os.Getenv("DB_AUTO_MIGRATE") != "true" {
+		return
+	}
+
+	db, dialect := openDatabase()
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+
+	if _, err := migration.NewMigrator(db, dialect).Up(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// openDatabase connects to the database selected by DB_DRIVER to run the migrations
+func openDatabase() (*sql.DB, migration.Dialect) {
+	switch driver := database.Driver(); driver {
+	case database.DriverMySQL:
+		return database.NewMySQLMigrationConnection(), migration.MySQL
+	case database.DriverPostgres:
+		return database.NewPostgresConnection(), migration.Postgres
+	default:
+		log.Fatalf("unsupported DB_DRIVER %q", driver)
+		return nil, migration.Dialect{}
+	}
+}
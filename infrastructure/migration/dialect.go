@@ -0,0 +1,84 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+const (
+	// lockName identifies the lock held while migrating, so that instances starting together wait for each other
+	lockName = "go-transactions:migrate"
+	// lockKey is the advisory lock key of PostgreSQL, which only accepts integers
+	lockKey = 7_311_846_512
+)
+
+var (
+	// ErrLockTimeout is returned when another instance holds the migration lock for too long
+	ErrLockTimeout = errors.New("timed out waiting for the migration lock")
+)
+
+// Dialect holds the statements that differ between the supported databases
+type Dialect struct {
+	dir string
+
+	createTable string
+	selectAll   string
+	insert      string
+	delete      string
+
+	lock   func(ctx context.Context, conn *sql.Conn) error
+	unlock func(ctx context.Context, conn *sql.Conn) error
+}
+
+var (
+	// MySQL runs the migrations of the mysql directory
+	MySQL = Dialect{
+		dir: "mysql",
+		createTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		selectAll: "SELECT version, applied_at FROM schema_migrations ORDER BY version",
+		insert:    "INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)",
+		delete:    "DELETE FROM schema_migrations WHERE version = ?",
+		lock: func(ctx context.Context, conn *sql.Conn) error {
+			var acquired sql.NullInt64
+			if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 60)", lockName).Scan(&acquired); err != nil {
+				return err
+			}
+
+			if acquired.Int64 != 1 {
+				return ErrLockTimeout
+			}
+
+			return nil
+		},
+		unlock: func(ctx context.Context, conn *sql.Conn) error {
+			_, err := conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", lockName)
+			return err
+		},
+	}
+
+	// Postgres runs the migrations of the postgres directory
+	Postgres = Dialect{
+		dir: "postgres",
+		createTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`,
+		selectAll: "SELECT version, applied_at FROM schema_migrations ORDER BY version",
+		insert:    "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)",
+		delete:    "DELETE FROM schema_migrations WHERE version = $1",
+		lock: func(ctx context.Context, conn *sql.Conn) error {
+			_, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey)
+			return err
+		},
+		unlock: func(ctx context.Context, conn *sql.Conn) error {
+			_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", lockKey)
+			return err
+		},
+	}
+)
@@ -0,0 +1,113 @@
+package migration
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// files holds the versioned migrations of every dialect, named <version>_<name>.<up|down>.sql
+//
+//go:embed mysql/*.sql postgres/*.sql
+var files embed.FS
+
+// Migration is a versioned schema change and the statements that revert it. Each file runs whole in a
+// single call, so the database parses its statements, literals and function bodies included
+type Migration struct {
+	Version int64
+	Name    string
+
+	up   string
+	down string
+}
+
+// migrationFile identifies the file of a direction of a migration
+type migrationFile struct {
+	version   int64
+	direction string
+}
+
+// load reads the migrations embedded in dir ordered by version
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		byVersion = make(map[int64]*Migration)
+		found     = make(map[migrationFile]bool)
+	)
+
+	for _, entry := range entries {
+		version, name, direction, err := parseFilename(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration %d has two names: %q and %q", version, migration.Name, name)
+		}
+
+		switch direction {
+		case "up":
+			migration.up = strings.TrimSpace(string(content))
+		case "down":
+			migration.down = strings.TrimSpace(string(content))
+		}
+		found[migrationFile{version: version, direction: direction}] = true
+	}
+
+	var migrations = make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if !found[migrationFile{migration.Version, "up"}] || !found[migrationFile{migration.Version, "down"}] {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// parseFilename splits 0001_create_accounts.up.sql into its version, name and direction
+func parseFilename(filename string) (int64, string, string, error) {
+	var base = strings.TrimSuffix(filename, ".sql")
+
+	var direction = path.Ext(base)
+	if direction != ".up" && direction != ".down" {
+		return 0, "", "", fmt.Errorf("migration %q must end with .up.sql or .down.sql", filename)
+	}
+	base = strings.TrimSuffix(base, direction)
+
+	prefix, name, ok := strings.Cut(base, "_")
+	if !ok || name == "" {
+		return 0, "", "", fmt.Errorf("migration %q must be named <version>_<name>", filename)
+	}
+
+	version, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, "", "", fmt.Errorf("migration %q must start with a positive version", filename)
+	}
+
+	return version, name, strings.TrimPrefix(direction, "."), nil
+}
@@ -0,0 +1,160 @@
+package migration
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad(t *testing.T) {
+	type args struct {
+		fsys fstest.MapFS
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected []int64
+		wantErr  bool
+	}{
+		{
+			name: "Order by version",
+			args: args{
+				fsys: fstest.MapFS{
+					"db/0002_b.up.sql":   {Data: []byte("CREATE TABLE b (id INT);")},
+					"db/0002_b.down.sql": {Data: []byte("DROP TABLE b;")},
+					"db/0001_a.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+					"db/0001_a.down.sql": {Data: []byte("DROP TABLE a;")},
+				},
+			},
+			expected: []int64{1, 2},
+		},
+		{
+			name: "Missing down file",
+			args: args{
+				fsys: fstest.MapFS{
+					"db/0001_a.up.sql": {Data: []byte("CREATE TABLE a (id INT);")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid filename",
+			args: args{
+				fsys: fstest.MapFS{
+					"db/a.up.sql": {Data: []byte("CREATE TABLE a (id INT);")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Same version with two names",
+			args: args{
+				fsys: fstest.MapFS{
+					"db/0001_a.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+					"db/0001_b.down.sql": {Data: []byte("DROP TABLE a;")},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := load(tt.args.fsys, "db")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			var got = make([]int64, 0)
+			for _, migration := range migrations {
+				got = append(got, migration.Version)
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoad_Embedded(t *testing.T) {
+	mysql, err := load(files, MySQL.dir)
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "MySQL", err)
+	}
+
+	postgres, err := load(files, Postgres.dir)
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Postgres", err)
+	}
+
+	if len(mysql) != len(postgres) {
+		t.Fatalf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Same migrations", len(postgres), len(mysql))
+	}
+
+	for i := range mysql {
+		if mysql[i].Version != postgres[i].Version || mysql[i].Name != postgres[i].Name {
+			t.Errorf(
+				"[TestCase '%s'] Got: '%d_%s' | Want: '%d_%s'",
+				"Same migrations",
+				postgres[i].Version,
+				postgres[i].Name,
+				mysql[i].Version,
+				mysql[i].Name,
+			)
+		}
+	}
+}
+
+func TestLoad_Scripts(t *testing.T) {
+	tests := []struct {
+		name     string
+		up       string
+		down     string
+		wantUp   string
+		wantDown string
+	}{
+		{
+			name:     "Several statements",
+			up:       "CREATE TABLE a (id INT);\n\nCREATE INDEX idx_a ON a (id);\n",
+			down:     "DROP TABLE a;\n",
+			wantUp:   "CREATE TABLE a (id INT);\n\nCREATE INDEX idx_a ON a (id);",
+			wantDown: "DROP TABLE a;",
+		},
+		{
+			name:     "Semicolons inside literals and function bodies",
+			up:       "INSERT INTO a (name) VALUES ('a;b');\nCREATE FUNCTION f() RETURNS INT AS $$ BEGIN RETURN 1; END $$ LANGUAGE plpgsql;",
+			down:     "DROP FUNCTION f;",
+			wantUp:   "INSERT INTO a (name) VALUES ('a;b');\nCREATE FUNCTION f() RETURNS INT AS $$ BEGIN RETURN 1; END $$ LANGUAGE plpgsql;",
+			wantDown: "DROP FUNCTION f;",
+		},
+		{
+			name:     "Empty down file",
+			up:       "UPDATE a SET id = id + 1;",
+			down:     "\n",
+			wantUp:   "UPDATE a SET id = id + 1;",
+			wantDown: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := load(fstest.MapFS{
+				"db/0001_a.up.sql":   {Data: []byte(tt.up)},
+				"db/0001_a.down.sql": {Data: []byte(tt.down)},
+			}, "db")
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			if got := migrations[0]; got.up != tt.wantUp || got.down != tt.wantDown {
+				t.Errorf("[TestCase '%s'] Got: '%q %q' | Want: '%q %q'", tt.name, got.up, got.down, tt.wantUp, tt.wantDown)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNoMigrationApplied is returned when reverting a database without applied migrations
+	ErrNoMigrationApplied = errors.New("no migration applied")
+)
+
+// Status reports whether a migration was applied to the database
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Migrator applies and reverts the embedded migrations of a dialect
+type Migrator struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// NewMigrator creates new Migrator with its dependencies
+func NewMigrator(db *sql.DB, dialect Dialect) Migrator {
+	return Migrator{
+		db:      db,
+		dialect: dialect,
+	}
+}
+
+// Up applies every pending migration in version order and returns the ones applied
+func (m Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied = make([]Migration, 0)
+
+	err := m.locked(ctx, func(conn *sql.Conn, migrations []Migration, done map[int64]time.Time) error {
+		for _, migration := range migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+
+			if err := m.run(ctx, conn, migration.up, m.dialect.insert, migration.Version, migration.Name, time.Now().UTC()); err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down reverts the last applied migration and returns it
+func (m Migrator) Down(ctx context.Context) (Migration, error) {
+	var reverted Migration
+
+	err := m.locked(ctx, func(conn *sql.Conn, migrations []Migration, done map[int64]time.Time) error {
+		for i := len(migrations) - 1; i >= 0; i-- {
+			if _, ok := done[migrations[i].Version]; !ok {
+				continue
+			}
+
+			reverted = migrations[i]
+			if err := m.run(ctx, conn, reverted.down, m.dialect.delete, reverted.Version); err != nil {
+				return fmt.Errorf("failed to revert migration %d_%s: %w", reverted.Version, reverted.Name, err)
+			}
+
+			return nil
+		}
+
+		return ErrNoMigrationApplied
+	})
+
+	return reverted, err
+}
+
+// Status lists every embedded migration and whether it was applied
+func (m Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses = make([]Status, 0)
+
+	err := m.locked(ctx, func(_ *sql.Conn, migrations []Migration, done map[int64]time.Time) error {
+		for _, migration := range migrations {
+			appliedAt, ok := done[migration.Version]
+			statuses = append(statuses, Status{
+				Migration: migration,
+				Applied:   ok,
+				AppliedAt: appliedAt,
+			})
+		}
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+// locked runs fn on a dedicated connection holding the migration lock, since the locks belong to the session
+func (m Migrator) locked(
+	ctx context.Context,
+	fn func(conn *sql.Conn, migrations []Migration, done map[int64]time.Time) error,
+) error {
+	migrations, err := load(files, m.dialect.dir)
+	if err != nil {
+		return err
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := m.dialect.lock(ctx, conn); err != nil {
+		return err
+	}
+	defer func() {
+		_ = m.dialect.unlock(context.Background(), conn)
+	}()
+
+	if _, err := conn.ExecContext(ctx, m.dialect.createTable); err != nil {
+		return err
+	}
+
+	done, err := m.applied(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	return fn(conn, migrations, done)
+}
+
+func (m Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, m.dialect.selectAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var done = make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			version   int64
+			appliedAt time.Time
+		)
+
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+
+		done[version] = appliedAt
+	}
+
+	return done, rows.Err()
+}
+
+// run executes the script of a migration file and records the change in schema_migrations within a single
+// database transaction. MySQL commits DDL statements implicitly, so there a failed migration must be fixed by
+// hand before retrying. An empty script, as the down file of a data fix that can't be undone, only records it
+func (m Migrator) run(ctx context.Context, conn *sql.Conn, script string, record string, args ...interface{}) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if script != "" {
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,128 @@
+//go:build integration
+
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// TestMigrator_Up_BaselineMySQL adopts a database created by the init.sql the service shipped before the
+// migrations, in a scratch database of the server of MYSQL_DSN. Run it with:
+// MYSQL_DSN="user:password@tcp(localhost:3306)/" go test -tags integration ./infrastructure/migration/
+func TestMigrator_Up_BaselineMySQL(t *testing.T) {
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN not set")
+	}
+
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Parse DSN", err)
+	}
+	cfg.DBName = ""
+	cfg.ParseTime = true
+	cfg.MultiStatements = true
+
+	server, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Open server", err)
+	}
+	defer server.Close()
+
+	const name = "go_transactions_baseline"
+	if _, err = server.Exec("DROP DATABASE IF EXISTS " + name + "; CREATE DATABASE " + name); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create database", err)
+	}
+	defer func() {
+		_, _ = server.Exec("DROP DATABASE " + name)
+	}()
+
+	cfg.DBName = name
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Open database", err)
+	}
+	defer db.Close()
+
+	baseline, err := os.ReadFile("testdata/mysql_baseline_init.sql")
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Read baseline", err)
+	}
+
+	if _, err = db.Exec(string(baseline) + `;
+		INSERT INTO accounts (id, document_number, available_credit_limit, created_at)
+			VALUES ('a', '123.456.789-09', 1000, NOW() - INTERVAL 1 DAY),
+				('b', ' 123456789-09 ', 1000, NOW()),
+				('c', '529 982 247-25', 1000, NOW());
+		INSERT INTO transactions (id, account_id, operation_id, amount, balance, created_at)
+			VALUES ('t', 'a', '1', -100, -100, NOW())`,
+	); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Create baseline", err)
+	}
+
+	var (
+		ctx      = context.Background()
+		migrator = NewMigrator(db, MySQL)
+	)
+
+	if _, err = migrator.Up(ctx); err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Adopt baseline", err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "Baseline operations enabled",
+			query: "SELECT COUNT(*) FROM operations WHERE enabled",
+			want:  "8",
+		},
+		{
+			name:  "Baseline transactions without original transaction",
+			query: "SELECT COUNT(*) FROM transactions WHERE original_transaction_id IS NULL",
+			want:  "1",
+		},
+		{
+			name: "Index of the transactions of an account",
+			query: `SELECT COUNT(DISTINCT index_name) FROM information_schema.statistics
+				WHERE table_schema = DATABASE() AND index_name = 'idx_transactions_account_created_at'`,
+			want: "1",
+		},
+		{
+			name:  "Baseline document normalized",
+			query: "SELECT document_number FROM accounts WHERE id = 'a'",
+			want:  "12345678909",
+		},
+		{
+			name:  "Newer duplicate document kept as recorded",
+			query: "SELECT document_number FROM accounts WHERE id = 'b'",
+			want:  " 123456789-09 ",
+		},
+		{
+			name:  "Document with inner spaces kept as recorded",
+			query: "SELECT document_number FROM accounts WHERE id = 'c'",
+			want:  "529 982 247-25",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := db.QueryRow(tt.query).Scan(&got); err != nil || got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v' | Err: '%v'", tt.name, got, tt.want, err)
+			}
+		})
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil || len(applied) != 0 {
+		t.Errorf("[TestCase '%s'] Got applied: '%v' | Err: '%v'", "Nothing pending after adoption", len(applied), err)
+	}
+}
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS accounts (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    document_number VARCHAR(50) NOT NULL UNIQUE,
+    available_credit_limit INTEGER NOT NULL,
+    created_at TIMESTAMP
+);
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS operations (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    description VARCHAR(50) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+SET @adopt = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+        WHERE table_schema = DATABASE() AND table_name = 'operations' AND column_name = 'enabled') = 0,
+    'ALTER TABLE operations ADD COLUMN enabled BOOLEAN NOT NULL DEFAULT TRUE',
+    'DO 0'
+);
+PREPARE adopt FROM @adopt;
+EXECUTE adopt;
+DEALLOCATE PREPARE adopt;
+
+INSERT IGNORE
+    INTO
+        `operations` (`id`, `description`, `type`)
+    VALUES
+        ('1', 'COMPRA A VISTA', 'DEBIT'),
+        ('2', 'COMPRA PARCELADA', 'DEBIT'),
+        ('3', 'SAQUE', 'DEBIT'),
+        ('4', 'PAGAMENTO', 'CREDIT'),
+        ('5', 'ESTORNO', 'CREDIT'),
+        ('6', 'TRANSFERENCIA ENVIADA', 'DEBIT'),
+        ('7', 'TRANSFERENCIA RECEBIDA', 'CREDIT'),
+        ('8', 'DEPOSITO', 'CREDIT');
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS transactions (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    account_id VARCHAR(36) NOT NULL,
+    operation_id VARCHAR(36) NOT NULL,
+    amount INTEGER NOT NULL,
+    balance INTEGER NOT NULL,
+    original_transaction_id VARCHAR(36),
+    created_at TIMESTAMP,
+
+    INDEX idx_transactions_account_created_at (account_id, created_at),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    FOREIGN KEY (original_transaction_id) REFERENCES transactions(id),
+    FOREIGN KEY (operation_id) REFERENCES operations(id)
+);
+
+SET @adopt = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+        WHERE table_schema = DATABASE() AND table_name = 'transactions' AND column_name = 'original_transaction_id') = 0,
+    'ALTER TABLE transactions
+        ADD COLUMN original_transaction_id VARCHAR(36) AFTER balance,
+        ADD FOREIGN KEY (original_transaction_id) REFERENCES transactions(id)',
+    'DO 0'
+);
+PREPARE adopt FROM @adopt;
+EXECUTE adopt;
+DEALLOCATE PREPARE adopt;
+
+SET @adopt = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+        WHERE table_schema = DATABASE() AND table_name = 'transactions' AND index_name = 'idx_transactions_account_created_at') = 0,
+    'ALTER TABLE transactions ADD INDEX idx_transactions_account_created_at (account_id, created_at)',
+    'DO 0'
+);
+PREPARE adopt FROM @adopt;
+EXECUTE adopt;
+DEALLOCATE PREPARE adopt;
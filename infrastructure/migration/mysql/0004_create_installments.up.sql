@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS installments (
+    transaction_id VARCHAR(36) NOT NULL,
+    number INTEGER NOT NULL,
+    amount INTEGER NOT NULL,
+    due_date DATE NOT NULL,
+
+    PRIMARY KEY (transaction_id, number),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
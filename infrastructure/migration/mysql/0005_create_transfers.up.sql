@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS transfers (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    payer_account_id VARCHAR(36) NOT NULL,
+    payee_account_id VARCHAR(36) NOT NULL,
+    amount INTEGER NOT NULL,
+    debit_transaction_id VARCHAR(36) NOT NULL,
+    credit_transaction_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMP,
+
+    FOREIGN KEY (payer_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (payee_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (debit_transaction_id) REFERENCES transactions(id),
+    FOREIGN KEY (credit_transaction_id) REFERENCES transactions(id)
+);
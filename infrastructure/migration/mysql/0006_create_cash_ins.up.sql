@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS cash_ins (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    account_id VARCHAR(36) NOT NULL,
+    source_type VARCHAR(20) NOT NULL,
+    source_reference VARCHAR(64) NOT NULL,
+    amount INTEGER NOT NULL,
+    transaction_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMP,
+
+    UNIQUE KEY uq_cash_ins_source (source_type, source_reference),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
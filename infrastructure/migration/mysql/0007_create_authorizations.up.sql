@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS authorizations (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    account_id VARCHAR(36) NOT NULL,
+    operation_id VARCHAR(36) NOT NULL,
+    amount INTEGER NOT NULL,
+    captured_amount INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL,
+    transaction_id VARCHAR(36),
+    expires_at DATETIME NOT NULL,
+    created_at TIMESTAMP,
+
+    INDEX idx_authorizations_status_expires_at (status, expires_at),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    FOREIGN KEY (operation_id) REFERENCES operations(id),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    account_id VARCHAR(36) NOT NULL,
+    transaction_id VARCHAR(36) UNIQUE,
+    description VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP,
+
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
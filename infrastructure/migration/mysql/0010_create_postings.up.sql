@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS postings (
+    journal_entry_id VARCHAR(36) NOT NULL,
+    ledger_account VARCHAR(64) NOT NULL,
+    amount INTEGER NOT NULL,
+
+    PRIMARY KEY (journal_entry_id, ledger_account),
+    INDEX idx_postings_ledger_account (ledger_account),
+    FOREIGN KEY (journal_entry_id) REFERENCES journal_entries(id)
+);
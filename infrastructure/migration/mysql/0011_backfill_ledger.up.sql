@@ -0,0 +1,42 @@
+INSERT INTO journal_entries (id, account_id, transaction_id, description, created_at)
+    SELECT UUID(), t.account_id, t.id, o.description, t.created_at
+    FROM transactions t
+    JOIN operations o ON o.id = t.operation_id;
+
+INSERT INTO postings (journal_entry_id, ledger_account, amount)
+    SELECT j.id, CONCAT('customer:', t.account_id), t.amount
+    FROM journal_entries j
+    JOIN transactions t ON t.id = j.transaction_id;
+
+INSERT INTO postings (journal_entry_id, ledger_account, amount)
+    SELECT
+        j.id,
+        CASE
+            WHEN t.operation_id = '5' THEN 'issuer'
+            WHEN t.operation_id IN ('6', '7') OR t.amount > 0 THEN 'settlement'
+            ELSE 'issuer'
+        END,
+        -t.amount
+    FROM journal_entries j
+    JOIN transactions t ON t.id = j.transaction_id;
+
+INSERT INTO journal_entries (id, account_id, transaction_id, description, created_at)
+    SELECT UUID(), a.id, NULL, 'OPENING LIMIT', a.created_at
+    FROM accounts a;
+
+INSERT INTO postings (journal_entry_id, ledger_account, amount)
+    SELECT
+        j.id,
+        CONCAT('customer:', a.id),
+        a.available_credit_limit
+            - COALESCE((SELECT SUM(t.amount) FROM transactions t WHERE t.account_id = a.id), 0)
+            + COALESCE((SELECT SUM(h.amount) FROM authorizations h WHERE h.account_id = a.id AND h.status = 'PENDING'), 0)
+    FROM journal_entries j
+    JOIN accounts a ON a.id = j.account_id
+    WHERE j.transaction_id IS NULL;
+
+INSERT INTO postings (journal_entry_id, ledger_account, amount)
+    SELECT j.id, 'issuer', -p.amount
+    FROM journal_entries j
+    JOIN postings p ON p.journal_entry_id = j.id
+    WHERE j.transaction_id IS NULL;
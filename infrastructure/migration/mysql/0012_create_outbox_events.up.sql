@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+    seq BIGINT AUTO_INCREMENT PRIMARY KEY,
+    id VARCHAR(36) NOT NULL UNIQUE,
+    type VARCHAR(50) NOT NULL,
+    account_id VARCHAR(36) NOT NULL,
+    payload TEXT NOT NULL,
+    occurred_at TIMESTAMP NULL,
+    published_at TIMESTAMP NULL,
+
+    INDEX idx_outbox_events_published_at_seq (published_at, seq)
+);
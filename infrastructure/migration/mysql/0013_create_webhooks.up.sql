@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS webhooks (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    account_id VARCHAR(36),
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    events VARCHAR(255) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NULL,
+
+    INDEX idx_webhooks_account_id (account_id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+    id VARCHAR(36) PRIMARY KEY UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    key_hash CHAR(64) NOT NULL,
+    scopes VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+
+    UNIQUE INDEX idx_api_keys_key_hash (key_hash)
+);
@@ -0,0 +1,10 @@
+ALTER TABLE api_keys
+    DROP COLUMN tenant_id;
+
+ALTER TABLE transactions
+    DROP COLUMN tenant_id;
+
+ALTER TABLE accounts
+    DROP INDEX idx_accounts_tenant_id_document_number,
+    ADD UNIQUE INDEX document_number (document_number),
+    DROP COLUMN tenant_id;
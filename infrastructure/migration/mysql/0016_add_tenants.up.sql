@@ -0,0 +1,10 @@
+ALTER TABLE accounts
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default',
+    DROP INDEX document_number,
+    ADD UNIQUE INDEX idx_accounts_tenant_id_document_number (tenant_id, document_number);
+
+ALTER TABLE transactions
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+ALTER TABLE api_keys
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
@@ -0,0 +1,9 @@
+ALTER TABLE outbox_events
+    DROP COLUMN tenant_id;
+
+ALTER TABLE webhook_deliveries
+    DROP COLUMN tenant_id;
+
+ALTER TABLE webhooks
+    DROP INDEX idx_webhooks_tenant_id,
+    DROP COLUMN tenant_id;
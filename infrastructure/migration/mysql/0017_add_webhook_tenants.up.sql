@@ -0,0 +1,21 @@
+ALTER TABLE webhooks
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default',
+    ADD INDEX idx_webhooks_tenant_id (tenant_id);
+
+UPDATE webhooks w
+    JOIN accounts a ON a.id = w.account_id
+    SET w.tenant_id = a.tenant_id;
+
+ALTER TABLE webhook_deliveries
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+UPDATE webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+    SET d.tenant_id = w.tenant_id;
+
+ALTER TABLE outbox_events
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+UPDATE outbox_events e
+    JOIN accounts a ON a.id = e.account_id
+    SET e.tenant_id = a.tenant_id;
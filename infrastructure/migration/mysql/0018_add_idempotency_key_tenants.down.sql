@@ -0,0 +1,8 @@
+DELETE k FROM idempotency_keys k
+    JOIN idempotency_keys other ON other.id = k.id AND other.tenant_id < k.tenant_id;
+
+ALTER TABLE idempotency_keys
+    DROP PRIMARY KEY,
+    ADD PRIMARY KEY (id),
+    ADD UNIQUE INDEX id (id),
+    DROP COLUMN tenant_id;
@@ -0,0 +1,5 @@
+ALTER TABLE idempotency_keys
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default' FIRST,
+    DROP PRIMARY KEY,
+    DROP INDEX id,
+    ADD PRIMARY KEY (tenant_id, id);
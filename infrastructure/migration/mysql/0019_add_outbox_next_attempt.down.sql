@@ -0,0 +1,3 @@
+ALTER TABLE outbox_events
+    DROP INDEX idx_outbox_events_published_at_next_attempt_at,
+    DROP COLUMN next_attempt_at;
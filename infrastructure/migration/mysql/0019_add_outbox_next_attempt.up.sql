@@ -0,0 +1,3 @@
+ALTER TABLE outbox_events
+    ADD COLUMN next_attempt_at TIMESTAMP NULL,
+    ADD INDEX idx_outbox_events_published_at_next_attempt_at (published_at, next_attempt_at);
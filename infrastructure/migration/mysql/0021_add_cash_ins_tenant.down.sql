@@ -0,0 +1,4 @@
+ALTER TABLE cash_ins
+    DROP INDEX uq_cash_ins_tenant_source,
+    ADD UNIQUE KEY uq_cash_ins_source (source_type, source_reference),
+    DROP COLUMN tenant_id;
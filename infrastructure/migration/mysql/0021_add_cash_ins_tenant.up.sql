@@ -0,0 +1,10 @@
+ALTER TABLE cash_ins
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+UPDATE cash_ins c
+    JOIN accounts a ON a.id = c.account_id
+    SET c.tenant_id = a.tenant_id;
+
+ALTER TABLE cash_ins
+    DROP INDEX uq_cash_ins_source,
+    ADD UNIQUE KEY uq_cash_ins_tenant_source (tenant_id, source_type, source_reference);
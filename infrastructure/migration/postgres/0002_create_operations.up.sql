@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS operations (
+    id VARCHAR(36) PRIMARY KEY,
+    description VARCHAR(50) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+ALTER TABLE operations ADD COLUMN IF NOT EXISTS enabled BOOLEAN NOT NULL DEFAULT TRUE;
+
+INSERT
+    INTO
+        operations (id, description, type)
+    VALUES
+        ('1', 'COMPRA A VISTA', 'DEBIT'),
+        ('2', 'COMPRA PARCELADA', 'DEBIT'),
+        ('3', 'SAQUE', 'DEBIT'),
+        ('4', 'PAGAMENTO', 'CREDIT'),
+        ('5', 'ESTORNO', 'CREDIT'),
+        ('6', 'TRANSFERENCIA ENVIADA', 'DEBIT'),
+        ('7', 'TRANSFERENCIA RECEBIDA', 'CREDIT'),
+        ('8', 'DEPOSITO', 'CREDIT')
+    ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS transactions (
+    id VARCHAR(36) PRIMARY KEY,
+    account_id VARCHAR(36) NOT NULL REFERENCES accounts(id),
+    operation_id VARCHAR(36) NOT NULL REFERENCES operations(id),
+    amount INTEGER NOT NULL,
+    balance INTEGER NOT NULL,
+    original_transaction_id VARCHAR(36) REFERENCES transactions(id),
+    created_at TIMESTAMPTZ
+);
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS original_transaction_id VARCHAR(36) REFERENCES transactions(id);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_account_created_at ON transactions (account_id, created_at);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS installments (
+    transaction_id VARCHAR(36) NOT NULL REFERENCES transactions(id),
+    number INTEGER NOT NULL,
+    amount INTEGER NOT NULL,
+    due_date DATE NOT NULL,
+
+    PRIMARY KEY (transaction_id, number)
+);
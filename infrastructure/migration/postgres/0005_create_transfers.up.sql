@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS transfers (
+    id VARCHAR(36) PRIMARY KEY,
+    payer_account_id VARCHAR(36) NOT NULL REFERENCES accounts(id),
+    payee_account_id VARCHAR(36) NOT NULL REFERENCES accounts(id),
+    amount INTEGER NOT NULL,
+    debit_transaction_id VARCHAR(36) NOT NULL REFERENCES transactions(id),
+    credit_transaction_id VARCHAR(36) NOT NULL REFERENCES transactions(id),
+    created_at TIMESTAMPTZ
+);
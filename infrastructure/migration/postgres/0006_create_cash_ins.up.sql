@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS cash_ins (
+    id VARCHAR(36) PRIMARY KEY,
+    account_id VARCHAR(36) NOT NULL REFERENCES accounts(id),
+    source_type VARCHAR(20) NOT NULL,
+    source_reference VARCHAR(64) NOT NULL,
+    amount INTEGER NOT NULL,
+    transaction_id VARCHAR(36) NOT NULL REFERENCES transactions(id),
+    created_at TIMESTAMPTZ,
+
+    CONSTRAINT uq_cash_ins_source UNIQUE (source_type, source_reference)
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS authorizations (
+    id VARCHAR(36) PRIMARY KEY,
+    account_id VARCHAR(36) NOT NULL REFERENCES accounts(id),
+    operation_id VARCHAR(36) NOT NULL REFERENCES operations(id),
+    amount INTEGER NOT NULL,
+    captured_amount INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL,
+    transaction_id VARCHAR(36) REFERENCES transactions(id),
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_authorizations_status_expires_at ON authorizations (status, expires_at);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id VARCHAR(36) PRIMARY KEY,
+    account_id VARCHAR(36) NOT NULL REFERENCES accounts(id),
+    transaction_id VARCHAR(36) UNIQUE REFERENCES transactions(id),
+    description VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS postings (
+    journal_entry_id VARCHAR(36) NOT NULL REFERENCES journal_entries(id),
+    ledger_account VARCHAR(64) NOT NULL,
+    amount INTEGER NOT NULL,
+
+    PRIMARY KEY (journal_entry_id, ledger_account)
+);
+
+CREATE INDEX IF NOT EXISTS idx_postings_ledger_account ON postings (ledger_account);
@@ -0,0 +1,3 @@
+DELETE FROM postings;
+
+DELETE FROM journal_entries;
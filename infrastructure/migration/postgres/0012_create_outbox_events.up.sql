@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+    seq BIGSERIAL PRIMARY KEY,
+    id VARCHAR(36) NOT NULL UNIQUE,
+    type VARCHAR(50) NOT NULL,
+    account_id VARCHAR(36) NOT NULL,
+    payload TEXT NOT NULL,
+    occurred_at TIMESTAMPTZ,
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published_at_seq ON outbox_events (published_at, seq);
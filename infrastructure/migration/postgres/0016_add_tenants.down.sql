@@ -0,0 +1,10 @@
+ALTER TABLE api_keys
+    DROP COLUMN tenant_id;
+
+ALTER TABLE transactions
+    DROP COLUMN tenant_id;
+
+ALTER TABLE accounts
+    DROP CONSTRAINT accounts_tenant_id_document_number_key,
+    ADD CONSTRAINT accounts_document_number_key UNIQUE (document_number),
+    DROP COLUMN tenant_id;
@@ -0,0 +1,10 @@
+ALTER TABLE accounts
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default',
+    DROP CONSTRAINT accounts_document_number_key,
+    ADD CONSTRAINT accounts_tenant_id_document_number_key UNIQUE (tenant_id, document_number);
+
+ALTER TABLE transactions
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+ALTER TABLE api_keys
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
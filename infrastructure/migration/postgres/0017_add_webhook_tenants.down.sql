@@ -0,0 +1,10 @@
+ALTER TABLE outbox_events
+    DROP COLUMN tenant_id;
+
+ALTER TABLE webhook_deliveries
+    DROP COLUMN tenant_id;
+
+DROP INDEX idx_webhooks_tenant_id;
+
+ALTER TABLE webhooks
+    DROP COLUMN tenant_id;
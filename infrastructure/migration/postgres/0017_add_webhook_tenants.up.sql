@@ -0,0 +1,25 @@
+ALTER TABLE webhooks
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+CREATE INDEX idx_webhooks_tenant_id ON webhooks (tenant_id);
+
+UPDATE webhooks w
+    SET tenant_id = a.tenant_id
+    FROM accounts a
+    WHERE a.id = w.account_id;
+
+ALTER TABLE webhook_deliveries
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+UPDATE webhook_deliveries d
+    SET tenant_id = w.tenant_id
+    FROM webhooks w
+    WHERE w.id = d.webhook_id;
+
+ALTER TABLE outbox_events
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+UPDATE outbox_events e
+    SET tenant_id = a.tenant_id
+    FROM accounts a
+    WHERE a.id = e.account_id;
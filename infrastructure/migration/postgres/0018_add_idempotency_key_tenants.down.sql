@@ -0,0 +1,8 @@
+DELETE FROM idempotency_keys k
+    USING idempotency_keys other
+    WHERE other.id = k.id AND other.tenant_id < k.tenant_id;
+
+ALTER TABLE idempotency_keys
+    DROP CONSTRAINT idempotency_keys_pkey,
+    ADD PRIMARY KEY (id),
+    DROP COLUMN tenant_id;
@@ -0,0 +1,4 @@
+ALTER TABLE idempotency_keys
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default',
+    DROP CONSTRAINT idempotency_keys_pkey,
+    ADD PRIMARY KEY (tenant_id, id);
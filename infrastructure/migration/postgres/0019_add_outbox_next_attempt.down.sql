@@ -0,0 +1,3 @@
+DROP INDEX idx_outbox_events_published_at_next_attempt_at;
+
+ALTER TABLE outbox_events DROP COLUMN next_attempt_at;
@@ -0,0 +1,25 @@
+CREATE TEMPORARY TABLE normalized_documents AS
+    SELECT c.id, c.normalized
+    FROM (
+        SELECT id, tenant_id, created_at, document_number,
+            REPLACE(REPLACE(REPLACE(TRIM(document_number), '.', ''), '-', ''), '/', '') AS normalized
+        FROM accounts
+    ) c
+    WHERE c.normalized <> c.document_number
+        AND c.normalized ~ '^[0-9]+$'
+        AND NOT EXISTS (
+            SELECT 1 FROM accounts d WHERE d.tenant_id = c.tenant_id AND d.document_number = c.normalized
+        )
+        AND NOT EXISTS (
+            SELECT 1 FROM accounts e
+            WHERE e.tenant_id = c.tenant_id
+                AND REPLACE(REPLACE(REPLACE(TRIM(e.document_number), '.', ''), '-', ''), '/', '') = c.normalized
+                AND (e.created_at < c.created_at OR (e.created_at = c.created_at AND e.id < c.id))
+        );
+
+UPDATE accounts a
+    SET document_number = n.normalized
+    FROM normalized_documents n
+    WHERE n.id = a.id;
+
+DROP TABLE normalized_documents;
@@ -0,0 +1,4 @@
+ALTER TABLE cash_ins
+    DROP CONSTRAINT uq_cash_ins_tenant_source,
+    ADD CONSTRAINT uq_cash_ins_source UNIQUE (source_type, source_reference),
+    DROP COLUMN tenant_id;
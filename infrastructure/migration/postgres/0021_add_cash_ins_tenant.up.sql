@@ -0,0 +1,11 @@
+ALTER TABLE cash_ins
+    ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT 'default';
+
+UPDATE cash_ins c
+    SET tenant_id = a.tenant_id
+    FROM accounts a
+    WHERE a.id = c.account_id;
+
+ALTER TABLE cash_ins
+    DROP CONSTRAINT uq_cash_ins_source,
+    ADD CONSTRAINT uq_cash_ins_tenant_source UNIQUE (tenant_id, source_type, source_reference);
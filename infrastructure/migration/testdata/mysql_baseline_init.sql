@@ -30,4 +30,4 @@ INSERT
         ('1', 'COMPRA A VISTA', 'DEBIT'),
         ('2', 'COMPRA PARCELADA', 'DEBIT'),
         ('3', 'SAQUE', 'DEBIT'),
-        ('4', 'PAGAMENTO', 'CREDIT');
\ No newline at end of file
+        ('4', 'PAGAMENTO', 'CREDIT');
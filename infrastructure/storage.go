@@ -0,0 +1,237 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"log"
+	"os"
+	"time"
+
+	"github.com/GSabadini/go-transactions/adapter/repository"
+	"github.com/GSabadini/go-transactions/adapter/repository/memory"
+	"github.com/GSabadini/go-transactions/adapter/repository/postgres"
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/GSabadini/go-transactions/infrastructure/database"
+)
+
+const (
+	// storageMemory is the APP_STORAGE value that keeps every entity in memory instead of MySQL
+	storageMemory = "memory"
+	// operationCatalogTTL defines how long the operation catalog is served from memory before reloading
+	operationCatalogTTL = time.Minute
+	// operationMissTTL defines how long an operation not found on the catalog is answered from memory
+	operationMissTTL = 5 * time.Second
+	// txIsolation defines the isolation level of the database transactions opened by the units of work
+	txIsolation = sql.LevelRepeatableRead
+)
+
+// storage groups the repositories behind the use cases
+type storage struct {
+	// db is the connection pool of the database, nil when the storage is in memory
+	db  *sql.DB
+	uow domain.UnitOfWork
+
+	accountCreator domain.AccountCreator
+	accountFinder  domain.AccountFinder
+	accountLocker  domain.AccountLocker
+	accountUpdater domain.AccountUpdater
+
+	transactionCreator        domain.TransactionCreator
+	transactionFinder         domain.TransactionFinder
+	transactionByIDFinder     domain.TransactionByIDFinder
+	transactionReversedFinder domain.TransactionReversedAmountFinder
+	transactionBalanceFinder  domain.TransactionBalanceFinder
+	transactionBalanceUpdater domain.TransactionBalanceUpdater
+
+	authorizationCreator       domain.AuthorizationCreator
+	authorizationFinder        domain.AuthorizationFinder
+	authorizationExpiredFinder domain.AuthorizationExpiredFinder
+	authorizationUpdater       domain.AuthorizationUpdater
+
+	transferCreator     domain.TransferCreator
+	cashInCreator       domain.CashInCreator
+	operations          domain.OperationRepository
+	ledgerBalanceFinder domain.LedgerBalanceFinder
+	ledgerLimitFinder   domain.LedgerLimitFinder
+
+	outboxCreator   domain.OutboxCreator
+	outboxFinder    domain.OutboxFinder
+	outboxUpdater   domain.OutboxUpdater
+	outboxPostponer domain.OutboxPostponer
+
+	webhooks          domain.WebhookRepository
+	webhookDeliveries domain.WebhookDeliveryRepository
+	apiKeys           domain.APIKeyRepository
+
+	idempotencyKeyCreator   domain.IdempotencyKeyCreator
+	idempotencyKeyFinder    domain.IdempotencyKeyFinder
+	idempotencyKeyUpdater   domain.IdempotencyKeyUpdater
+	idempotencyKeyReclaimer domain.IdempotencyKeyReclaimer
+	idempotencyKeyDeleter   domain.IdempotencyKeyDeleter
+}
+
+// newStorage creates the storage selected by APP_STORAGE, or the database selected by DB_DRIVER,
+// applying the pending migrations first when DB_AUTO_MIGRATE is true
+func newStorage() storage {
+	if os.Getenv("APP_STORAGE") == storageMemory {
+		return newMemoryStorage()
+	}
+
+	autoMigrate()
+
+	switch driver := database.Driver(); driver {
+	case database.DriverMySQL:
+		return newMySQLStorage(database.NewMySQLConnection())
+	case database.DriverPostgres:
+		return newPostgresStorage(database.NewPostgresConnection())
+	default:
+		log.Fatalf("unsupported DB_DRIVER %q", driver)
+		return storage{}
+	}
+}
+
+func newMySQLStorage(db *sql.DB) storage {
+	operations := memory.NewCachedOperationRepository(repository.NewOperationRepository(db), operationCatalogTTL, operationMissTTL)
+
+	return storage{
+		db:  db,
+		uow: repository.NewUnitOfWork(db, sql.TxOptions{Isolation: txIsolation}),
+
+		accountCreator: repository.NewCreateAccountRepository(db),
+		accountFinder:  repository.NewAccountByIDRepository(db),
+		accountLocker:  repository.NewLockAccountByIDRepository(db),
+		accountUpdater: repository.NewUpdateAccountCreditLimitRepository(db),
+
+		transactionCreator:        repository.NewCreateTransactionRepository(db),
+		transactionFinder:         repository.NewFindTransactionsByAccountIDRepository(db, operations),
+		transactionByIDFinder:     repository.NewFindTransactionByIDRepository(db, operations),
+		transactionReversedFinder: repository.NewFindReversedAmountRepository(db),
+		transactionBalanceFinder:  repository.NewFindOpenTransactionsRepository(db, operations),
+		transactionBalanceUpdater: repository.NewUpdateTransactionBalanceRepository(db),
+
+		authorizationCreator:       repository.NewCreateAuthorizationRepository(db),
+		authorizationFinder:        repository.NewFindAuthorizationByIDRepository(db, operations),
+		authorizationExpiredFinder: repository.NewFindExpiredAuthorizationsRepository(db, operations),
+		authorizationUpdater:       repository.NewUpdateAuthorizationRepository(db),
+
+		transferCreator:     repository.NewCreateTransferRepository(db),
+		cashInCreator:       repository.NewCreateCashInRepository(db),
+		operations:          operations,
+		ledgerBalanceFinder: repository.NewFindLedgerBalancesRepository(db),
+		ledgerLimitFinder:   repository.NewFindLedgerLimitRepository(db),
+
+		outboxCreator:   repository.NewCreateOutboxEventRepository(db),
+		outboxFinder:    repository.NewFindPendingOutboxEventsRepository(db),
+		outboxUpdater:   repository.NewMarkOutboxEventPublishedRepository(db),
+		outboxPostponer: repository.NewPostponeOutboxEventRepository(db),
+
+		webhooks:          repository.NewWebhookRepository(db),
+		webhookDeliveries: repository.NewWebhookDeliveryRepository(db),
+		apiKeys:           repository.NewAPIKeyRepository(db),
+
+		idempotencyKeyCreator:   repository.NewCreateIdempotencyKeyRepository(db),
+		idempotencyKeyFinder:    repository.NewFindIdempotencyKeyRepository(db),
+		idempotencyKeyUpdater:   repository.NewCompleteIdempotencyKeyRepository(db),
+		idempotencyKeyReclaimer: repository.NewReclaimIdempotencyKeyRepository(db),
+		idempotencyKeyDeleter:   repository.NewDeleteIdempotencyKeyRepository(db),
+	}
+}
+
+func newPostgresStorage(db *sql.DB) storage {
+	operations := memory.NewCachedOperationRepository(postgres.NewOperationRepository(db), operationCatalogTTL, operationMissTTL)
+
+	return storage{
+		db:  db,
+		uow: postgres.NewUnitOfWork(db, sql.TxOptions{Isolation: txIsolation}),
+
+		accountCreator: postgres.NewCreateAccountRepository(db),
+		accountFinder:  postgres.NewAccountByIDRepository(db),
+		accountLocker:  postgres.NewLockAccountByIDRepository(db),
+		accountUpdater: postgres.NewUpdateAccountCreditLimitRepository(db),
+
+		transactionCreator:        postgres.NewCreateTransactionRepository(db),
+		transactionFinder:         postgres.NewFindTransactionsByAccountIDRepository(db, operations),
+		transactionByIDFinder:     postgres.NewFindTransactionByIDRepository(db, operations),
+		transactionReversedFinder: postgres.NewFindReversedAmountRepository(db),
+		transactionBalanceFinder:  postgres.NewFindOpenTransactionsRepository(db, operations),
+		transactionBalanceUpdater: postgres.NewUpdateTransactionBalanceRepository(db),
+
+		authorizationCreator:       postgres.NewCreateAuthorizationRepository(db),
+		authorizationFinder:        postgres.NewFindAuthorizationByIDRepository(db, operations),
+		authorizationExpiredFinder: postgres.NewFindExpiredAuthorizationsRepository(db, operations),
+		authorizationUpdater:       postgres.NewUpdateAuthorizationRepository(db),
+
+		transferCreator:     postgres.NewCreateTransferRepository(db),
+		cashInCreator:       postgres.NewCreateCashInRepository(db),
+		operations:          operations,
+		ledgerBalanceFinder: postgres.NewFindLedgerBalancesRepository(db),
+		ledgerLimitFinder:   postgres.NewFindLedgerLimitRepository(db),
+
+		outboxCreator:   postgres.NewCreateOutboxEventRepository(db),
+		outboxFinder:    postgres.NewFindPendingOutboxEventsRepository(db),
+		outboxUpdater:   postgres.NewMarkOutboxEventPublishedRepository(db),
+		outboxPostponer: postgres.NewPostponeOutboxEventRepository(db),
+
+		webhooks:          postgres.NewWebhookRepository(db),
+		webhookDeliveries: postgres.NewWebhookDeliveryRepository(db),
+		apiKeys:           postgres.NewAPIKeyRepository(db),
+
+		idempotencyKeyCreator:   postgres.NewCreateIdempotencyKeyRepository(db),
+		idempotencyKeyFinder:    postgres.NewFindIdempotencyKeyRepository(db),
+		idempotencyKeyUpdater:   postgres.NewCompleteIdempotencyKeyRepository(db),
+		idempotencyKeyReclaimer: postgres.NewReclaimIdempotencyKeyRepository(db),
+		idempotencyKeyDeleter:   postgres.NewDeleteIdempotencyKeyRepository(db),
+	}
+}
+
+func newMemoryStorage() storage {
+	var (
+		store          = memory.NewStore()
+		accounts       = memory.NewAccountRepository(store)
+		transactions   = memory.NewTransactionRepository(store)
+		authorizations = memory.NewAuthorizationRepository(store)
+		idempotency    = memory.NewIdempotencyKeyRepository()
+		outbox         = memory.NewOutboxRepository(store)
+	)
+
+	return storage{
+		uow: store,
+
+		accountCreator: accounts,
+		accountFinder:  accounts,
+		accountLocker:  accounts,
+		accountUpdater: accounts,
+
+		transactionCreator:        transactions,
+		transactionFinder:         transactions,
+		transactionByIDFinder:     transactions,
+		transactionReversedFinder: transactions,
+		transactionBalanceFinder:  transactions,
+		transactionBalanceUpdater: transactions,
+
+		authorizationCreator:       authorizations,
+		authorizationFinder:        authorizations,
+		authorizationExpiredFinder: authorizations,
+		authorizationUpdater:       authorizations,
+
+		transferCreator:     memory.NewTransferRepository(store),
+		cashInCreator:       memory.NewCashInRepository(store),
+		operations:          memory.NewOperationRepository(),
+		ledgerBalanceFinder: memory.NewLedgerRepository(store),
+		ledgerLimitFinder:   memory.NewLedgerRepository(store),
+
+		outboxCreator:   outbox,
+		outboxFinder:    outbox,
+		outboxUpdater:   outbox,
+		outboxPostponer: outbox,
+
+		webhooks:          memory.NewWebhookRepository(store),
+		webhookDeliveries: memory.NewWebhookDeliveryRepository(store),
+		apiKeys:           memory.NewAPIKeyRepository(store),
+
+		idempotencyKeyCreator:   idempotency,
+		idempotencyKeyFinder:    idempotency,
+		idempotencyKeyUpdater:   idempotency,
+		idempotencyKeyReclaimer: idempotency,
+		idempotencyKeyDeleter:   idempotency,
+	}
+}
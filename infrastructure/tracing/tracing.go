@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// serviceName names the service in the spans unless OTEL_SERVICE_NAME is set
+const serviceName = "go-transactions"
+
+// Provider creates the spans of the application and exports them until it is shut down
+type Provider struct {
+	trace.TracerProvider
+	shutdown func(context.Context) error
+}
+
+// NewProvider creates new Provider exporting the spans through OTLP over gRPC, configured by the
+// standard OTEL_EXPORTER_OTLP_* and OTEL_TRACES_SAMPLER env vars. When neither OTEL_EXPORTER_OTLP_ENDPOINT
+// nor OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set, tracing is disabled and the spans are discarded.
+// The provider and the W3C Trace Context propagator are registered as the global ones
+func NewProvider(ctx context.Context) (*Provider, error) {
+	provider, err := newProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider, nil
+}
+
+func newProvider(ctx context.Context) (*Provider, error) {
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return &Provider{
+			TracerProvider: noop.NewTracerProvider(),
+			shutdown:       func(context.Context) error { return nil },
+		}, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(
+		ctx,
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+
+	return &Provider{
+		TracerProvider: provider,
+		shutdown:       provider.Shutdown,
+	}, nil
+}
+
+// Shutdown exports the spans still buffered and stops the exporter
+func (p Provider) Shutdown(ctx context.Context) error {
+	return p.shutdown(ctx)
+}
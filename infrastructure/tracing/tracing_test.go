@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func Test_newProvider(t *testing.T) {
+	tests := []struct {
+		name        string
+		endpoint    string
+		wantEnabled bool
+	}{
+		{
+			name:        "Spans exported to the OTLP endpoint",
+			endpoint:    "http://localhost:4317",
+			wantEnabled: true,
+		},
+		{
+			name: "Spans discarded without OTLP endpoint",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", tt.endpoint)
+			t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+			provider, err := newProvider(context.Background())
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+			defer provider.Shutdown(context.Background())
+
+			_, got := provider.TracerProvider.(*sdktrace.TracerProvider)
+			if got != tt.wantEnabled {
+				t.Errorf("[TestCase '%s'] Got enabled: '%v' | Want: '%v'", tt.name, got, tt.wantEnabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"github.com/GSabadini/go-transactions/domain"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// documentTags are the tags of the Brazilian documents, with the types of document each one accepts
+// and its messages in English and Portuguese
+var documentTags = []struct {
+	tag        string
+	types      []string
+	english    string
+	portuguese string
+}{
+	{
+		tag:        "cpf",
+		types:      []string{domain.CPF},
+		english:    "{0} must be a valid CPF",
+		portuguese: "{0} deve ser um CPF válido",
+	},
+	{
+		tag:        "cnpj",
+		types:      []string{domain.CNPJ},
+		english:    "{0} must be a valid CNPJ",
+		portuguese: "{0} deve ser um CNPJ válido",
+	},
+	{
+		tag:        "cpf_cnpj",
+		types:      []string{domain.CPF, domain.CNPJ},
+		english:    "{0} must be a valid CPF or CNPJ",
+		portuguese: "{0} deve ser um CPF ou CNPJ válido",
+	},
+}
+
+// registerDocuments registers the document tags, which accept the numbers with or without punctuation
+// as long as domain.NewDocument detects one of their types
+func registerDocuments(v *validator.Validate) error {
+	for _, d := range documentTags {
+		if err := v.RegisterValidation(d.tag, isDocument(d.types)); err != nil {
+			return err
+		}
+
+		for trans, text := range map[ut.Translator]string{translate: d.english, portuguese: d.portuguese} {
+			if err := v.RegisterTranslation(d.tag, trans, registerText(d.tag, text), translateField); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isDocument(types []string) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		document, err := domain.NewDocument(fl.Field().String())
+		if err != nil {
+			return false
+		}
+
+		for _, t := range types {
+			if document.Type() == t {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func registerText(tag string, text string) validator.RegisterTranslationsFunc {
+	return func(trans ut.Translator) error {
+		return trans.Add(tag, text, true)
+	}
+}
+
+func translateField(trans ut.Translator, fe validator.FieldError) string {
+	msg, err := trans.T(fe.Tag(), fe.Field())
+	if err != nil {
+		return fe.Error()
+	}
+
+	return msg
+}
@@ -6,29 +6,41 @@ import (
 	"strings"
 
 	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/pt_BR"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	en_translations "github.com/go-playground/validator/v10/translations/en"
+	pt_BR_translations "github.com/go-playground/validator/v10/translations/pt_BR"
 )
 
 // use a single instance , it caches struct info
 var (
-	uni       *ut.UniversalTranslator
-	validate  *validator.Validate
-	translate ut.Translator
+	uni        *ut.UniversalTranslator
+	validate   *validator.Validate
+	translate  ut.Translator
+	portuguese ut.Translator
 )
 
-// NewValidator create new validator.Validate
+// NewValidator create new validator.Validate, with the cpf, cnpj and cpf_cnpj tags of the Brazilian documents
 func NewValidator() *validator.Validate {
 	en := en.New()
-	uni = ut.New(en, en)
+	uni = ut.New(en, en, pt_BR.New())
 	translate, _ = uni.GetTranslator("en")
+	portuguese, _ = uni.GetTranslator("pt_BR")
 
 	validate = validator.New()
 	if err := en_translations.RegisterDefaultTranslations(validate, translate); err != nil {
 		log.Fatal(err)
 	}
 
+	if err := pt_BR_translations.RegisterDefaultTranslations(validate, portuguese); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := registerDocuments(validate); err != nil {
+		log.Fatal(err)
+	}
+
 	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
 		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
 		if name == "-" {
@@ -40,10 +52,43 @@ func NewValidator() *validator.Validate {
 	return validate
 }
 
-func ErrMessages(err error) []string {
+// ErrMessages translates the validation errors to the language of the Accept-Language header, when given,
+// or to English
+func ErrMessages(err error, acceptLanguage ...string) []string {
+	var trans = translate
+	if len(acceptLanguage) > 0 {
+		trans = translator(acceptLanguage[0])
+	}
+
 	var msgs []string
 	for _, e := range err.(validator.ValidationErrors) {
-		msgs = append(msgs, e.Translate(translate))
+		msgs = append(msgs, e.Translate(trans))
 	}
 	return msgs
 }
+
+// translator returns the translator of the first language of the Accept-Language header that has
+// translations, in the order of the header. Every Portuguese is answered in Brazilian Portuguese
+func translator(acceptLanguage string) ut.Translator {
+	for _, tag := range strings.Split(acceptLanguage, ",") {
+		lang, _, _ := strings.Cut(strings.TrimSpace(tag), ";")
+		primary, _, _ := strings.Cut(lang, "-")
+
+		switch strings.ToLower(primary) {
+		case "pt":
+			return portuguese
+		case "en":
+			return translate
+		}
+	}
+
+	return translate
+}
+
+// Errors defines the messages of an input that failed validation outside the validator, such as a query string
+type Errors []string
+
+// Error joins the messages
+func (e Errors) Error() string {
+	return strings.Join(e, "; ")
+}
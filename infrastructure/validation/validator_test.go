@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewValidator_Documents(t *testing.T) {
+	type input struct {
+		CPF     string `json:"cpf" validate:"omitempty,cpf"`
+		CNPJ    string `json:"cnpj" validate:"omitempty,cnpj"`
+		CPFCNPJ string `json:"document" validate:"omitempty,cpf_cnpj"`
+	}
+
+	v := NewValidator()
+
+	tests := []struct {
+		name           string
+		input          input
+		acceptLanguage string
+		want           []string
+	}{
+		{
+			name:  "Valid documents",
+			input: input{CPF: "123.456.789-09", CNPJ: "11.222.333/0001-81", CPFCNPJ: "11222333000181"},
+		},
+		{
+			name:  "Error document of the other type",
+			input: input{CPF: "11.222.333/0001-81", CNPJ: "123.456.789-09"},
+			want:  []string{"cpf must be a valid CPF", "cnpj must be a valid CNPJ"},
+		},
+		{
+			name:  "Error check digits",
+			input: input{CPFCNPJ: "123.456.789-00"},
+			want:  []string{"document must be a valid CPF or CNPJ"},
+		},
+		{
+			name:           "Error in Portuguese",
+			input:          input{CPF: "abc", CNPJ: "abc", CPFCNPJ: "abc"},
+			acceptLanguage: "pt-BR",
+			want: []string{
+				"cpf deve ser um CPF válido",
+				"cnpj deve ser um CNPJ válido",
+				"document deve ser um CPF ou CNPJ válido",
+			},
+		},
+		{
+			name:           "Error in English for other languages",
+			input:          input{CPF: "abc"},
+			acceptLanguage: "fr-FR, de;q=0.5",
+			want:           []string{"cpf must be a valid CPF"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			if err := v.Struct(tt.input); err != nil {
+				got = ErrMessages(err, tt.acceptLanguage)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
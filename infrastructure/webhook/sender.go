@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+const (
+	// HeaderSignature carries the HMAC-SHA256 of the timestamp and the body, keyed by the secret of the webhook
+	HeaderSignature = "X-Webhook-Signature"
+	// HeaderTimestamp carries the Unix time of the attempt, so receivers can reject replayed requests
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	// HeaderDelivery carries the id of the delivery, the same on every attempt
+	HeaderDelivery = "X-Webhook-Delivery"
+	// HeaderEvent carries the type of the event delivered
+	HeaderEvent = "X-Webhook-Event"
+
+	// signaturePrefix names the algorithm of the signature
+	signaturePrefix = "sha256="
+	// discardLimit defines how much of the response body is read so the connection can be reused
+	discardLimit = 64 << 10
+)
+
+// errAddressNotAllowed is returned when the host of a webhook resolves to an address deliveries may not reach
+var errAddressNotAllowed = errors.New("webhook address not allowed")
+
+// HTTPSender posts the deliveries to the URL of the webhooks
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewHTTPSender creates new HTTPSender giving up on a receiver after timeout. Redirects are not
+// followed, so a receiver that moved must have its webhook updated. The address is checked when the
+// connection is dialed, after the host is resolved, so a host that resolves to a private address, even
+// after the webhook was registered, is never reached
+func NewHTTPSender(timeout time.Duration) HTTPSender {
+	return newHTTPSender(timeout, domain.WebhookAddressAllowed)
+}
+
+func newHTTPSender(timeout time.Duration, allowed func(netip.Addr) bool) HTTPSender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !allowed(addrPort.Addr()) {
+				return errAddressNotAllowed
+			}
+
+			return nil
+		},
+	}
+
+	return HTTPSender{
+		client: &http.Client{
+			Timeout: timeout,
+			// Without a proxy, so that the address dialed is the one of the receiver
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				ForceAttemptHTTP2:   true,
+				TLSHandshakeTimeout: timeout,
+			},
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// Send posts the body of the delivery signed with the secret of the webhook. Any status other than 2xx fails
+func (h HTTPSender) Send(ctx context.Context, webhook domain.Webhook, delivery domain.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL(), bytes.NewReader(delivery.Body()))
+	if err != nil {
+		return err
+	}
+
+	timestamp := h.now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDelivery, delivery.ID())
+	req.Header.Set(HeaderEvent, delivery.EventType())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret(), timestamp, delivery.Body()))
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, discardLimit))
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// Sign returns the signature of the body sent at timestamp, computed over "<timestamp>.<body>"
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// receiver verifies the signature as a partner would, answering with status once it is valid
+func receiver(t *testing.T, secret string, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		timestamp := r.Header.Get(HeaderTimestamp)
+		if _, err := strconv.ParseInt(timestamp, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "." + string(body)))
+		if !hmac.Equal([]byte(r.Header.Get(HeaderSignature)), []byte("sha256="+hex.EncodeToString(mac.Sum(nil)))) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.Header.Get(HeaderDelivery) != "d1" || r.Header.Get(HeaderEvent) != domain.EventTransactionCreated {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(status)
+	}))
+}
+
+func TestHTTPSender_Send(t *testing.T) {
+	var delivery = domain.NewWebhookDelivery(
+		"d1",
+		"w1",
+		"e1",
+		domain.EventTransactionCreated,
+		[]byte(`{"id":"e1","type":"TransactionCreated"}`),
+		domain.WebhookDeliveryPending,
+		0,
+		"",
+		time.Time{},
+		time.Time{},
+		time.Time{},
+	)
+
+	tests := []struct {
+		name           string
+		receiverSecret string
+		receiverStatus int
+		wantErr        string
+	}{
+		{
+			name:           "Delivery accepted by the receiver",
+			receiverSecret: "0123456789abcdef",
+			receiverStatus: http.StatusNoContent,
+		},
+		{
+			name:           "Signature rejected by the receiver",
+			receiverSecret: "another secret",
+			receiverStatus: http.StatusOK,
+			wantErr:        "unexpected status code 401",
+		},
+		{
+			name:           "Receiver failing",
+			receiverSecret: "0123456789abcdef",
+			receiverStatus: http.StatusServiceUnavailable,
+			wantErr:        "unexpected status code 503",
+		},
+		{
+			name:           "Redirect not followed",
+			receiverSecret: "0123456789abcdef",
+			receiverStatus: http.StatusMovedPermanently,
+			wantErr:        "unexpected status code 301",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := receiver(t, tt.receiverSecret, tt.receiverStatus)
+			defer server.Close()
+
+			webhook := domain.NewWebhook("w1", "", server.URL, "0123456789abcdef", []string{domain.EventTransactionCreated}, true, time.Time{})
+
+			err := newHTTPSender(time.Second, allowAny).Send(context.Background(), webhook, delivery)
+			if (err == nil && tt.wantErr != "") || (err != nil && err.Error() != tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHTTPSender_Send_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	webhook := domain.NewWebhook("w1", "", server.URL, "secret", []string{domain.EventTransactionCreated}, true, time.Time{})
+	if err := newHTTPSender(time.Second, allowAny).Send(context.Background(), webhook, domain.WebhookDelivery{}); err == nil {
+		t.Errorf("[TestCase '%s'] Err: '%v' | Want an error", "Receiver unreachable", err)
+	}
+}
+
+func TestHTTPSender_Send_AddressNotAllowed(t *testing.T) {
+	var received bool
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		received = true
+	}))
+	defer server.Close()
+
+	// The host resolves to the loopback when dialed, as a host rebound after the webhook was registered
+	url := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	webhook := domain.NewWebhook("w1", "", url, "secret", []string{domain.EventTransactionCreated}, true, time.Time{})
+
+	err := NewHTTPSender(time.Second).Send(context.Background(), webhook, domain.WebhookDelivery{})
+	if !errors.Is(err, errAddressNotAllowed) || received {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Receiver on the loopback", err, errAddressNotAllowed)
+	}
+}
+
+// allowAny lets the tests deliver to the receivers they start on the loopback
+func allowAny(netip.Addr) bool {
+	return true
+}
+
+func TestSign(t *testing.T) {
+	// Reference signature computed with: printf '1700000000.{}' | openssl dgst -sha256 -hmac secret
+	const want = "sha256=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163"
+
+	if got := Sign("secret", 1700000000, []byte(`{}`)); got != want {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Reference signature", got, want)
+	}
+}
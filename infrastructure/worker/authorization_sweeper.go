@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// AuthorizationSweeper periodically expires the stale authorization holds
+type AuthorizationSweeper struct {
+	uc       usecase.ExpireAuthorizationsUseCase
+	log      logger.Logger
+	interval time.Duration
+}
+
+// NewAuthorizationSweeper creates new AuthorizationSweeper with its dependencies
+func NewAuthorizationSweeper(uc usecase.ExpireAuthorizationsUseCase, log logger.Logger, interval time.Duration) AuthorizationSweeper {
+	return AuthorizationSweeper{
+		uc:       uc,
+		log:      log,
+		interval: interval,
+	}
+}
+
+// Start sweeps on every interval until the context is canceled
+func (s AuthorizationSweeper) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := s.uc.Execute(ctx)
+			if err != nil {
+				s.log.Error(ctx, "failed to expiring authorizations", err)
+			}
+
+			if expired > 0 {
+				s.log.Info(ctx, "success to expiring authorizations", "expired", expired)
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+)
+
+type stubExpireAuthorizationsUseCase struct {
+	calls *int32
+	err   error
+}
+
+func (s stubExpireAuthorizationsUseCase) Execute(_ context.Context) (int, error) {
+	atomic.AddInt32(s.calls, 1)
+	return 1, s.err
+}
+
+func TestAuthorizationSweeper_Start(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "Sweep on every interval",
+			err:  nil,
+		},
+		{
+			name: "Keep sweeping after failures",
+			err:  errors.New("db_error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				calls       int32
+				ctx, cancel = context.WithCancel(context.Background())
+				done        = make(chan struct{})
+				sweeper     = NewAuthorizationSweeper(
+					stubExpireAuthorizationsUseCase{calls: &calls, err: tt.err},
+					logger.NewLogFake(),
+					time.Millisecond,
+				)
+			)
+
+			go func() {
+				sweeper.Start(ctx)
+				close(done)
+			}()
+
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+			<-done
+
+			if atomic.LoadInt32(&calls) < 2 {
+				t.Errorf("[TestCase '%s'] Got calls: '%v' | Want at least: '%v'", tt.name, calls, 2)
+			}
+		})
+	}
+}
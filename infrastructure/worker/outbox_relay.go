@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// OutboxRelay periodically publishes the events recorded in the outbox
+type OutboxRelay struct {
+	uc       usecase.RelayOutboxUseCase
+	log      logger.Logger
+	interval time.Duration
+}
+
+// NewOutboxRelay creates new OutboxRelay with its dependencies
+func NewOutboxRelay(uc usecase.RelayOutboxUseCase, log logger.Logger, interval time.Duration) OutboxRelay {
+	return OutboxRelay{
+		uc:       uc,
+		log:      log,
+		interval: interval,
+	}
+}
+
+// Start relays on every interval until the context is canceled
+func (o OutboxRelay) Start(ctx context.Context) {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			published, err := o.uc.Execute(ctx)
+			if err != nil {
+				o.log.Error(ctx, "failed to relaying events", err)
+			}
+
+			if published > 0 {
+				o.log.Info(ctx, "success to relaying events", "published", published)
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/infrastructure/logger"
+	"github.com/GSabadini/go-transactions/usecase"
+)
+
+// WebhookDispatcher periodically attempts the webhook deliveries that are due
+type WebhookDispatcher struct {
+	uc       usecase.DispatchWebhooksUseCase
+	log      logger.Logger
+	interval time.Duration
+}
+
+// NewWebhookDispatcher creates new WebhookDispatcher with its dependencies
+func NewWebhookDispatcher(uc usecase.DispatchWebhooksUseCase, log logger.Logger, interval time.Duration) WebhookDispatcher {
+	return WebhookDispatcher{
+		uc:       uc,
+		log:      log,
+		interval: interval,
+	}
+}
+
+// Start dispatches on every interval until the context is canceled
+func (d WebhookDispatcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			delivered, err := d.uc.Execute(ctx)
+			if err != nil {
+				d.log.Error(ctx, "failed to dispatching webhooks", err)
+			}
+
+			if delivered > 0 {
+				d.log.Info(ctx, "success to dispatching webhooks", "delivered", delivered)
+			}
+		}
+	}
+}
@@ -1,9 +1,21 @@
 package main
 
 import (
+	"os"
+
 	"github.com/GSabadini/go-transactions/infrastructure"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		infrastructure.Migrate(os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "api-key" {
+		infrastructure.APIKey(os.Args[2:])
+		return
+	}
+
 	infrastructure.NewHTTPServer().Start()
 }
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	AuthenticateAPIKeyUseCase interface {
+		Execute(context.Context, string) (domain.Principal, error)
+	}
+
+	authenticateAPIKeyInteractor struct {
+		repo       domain.APIKeyHashFinder
+		ctxTimeout time.Duration
+	}
+)
+
+// NewAuthenticateAPIKeyInteractor creates new authenticateAPIKeyInteractor with its dependencies
+func NewAuthenticateAPIKeyInteractor(repo domain.APIKeyHashFinder, ctxTimeout time.Duration) AuthenticateAPIKeyUseCase {
+	return authenticateAPIKeyInteractor{
+		repo:       repo,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case, returning the principal of the API key. Unknown and revoked
+// keys are refused alike, without telling which one it was
+func (a authenticateAPIKeyInteractor) Execute(ctx context.Context, key string) (domain.Principal, error) {
+	ctx, span := startSpan(ctx, "AuthenticateAPIKey", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	apiKey, err := a.repo.FindByHash(ctx, domain.HashAPIKey(key))
+	switch {
+	case err == domain.ErrAPIKeyNotFound:
+		return domain.Principal{}, domain.ErrCredentialsInvalid
+	case err != nil:
+		return domain.Principal{}, err
+	case apiKey.Revoked():
+		return domain.Principal{}, domain.ErrCredentialsInvalid
+	}
+
+	return apiKey.Principal(), nil
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubFindAPIKeyByHashRepo struct {
+	result domain.APIKey
+	err    error
+}
+
+func (s stubFindAPIKeyByHashRepo) FindByHash(_ context.Context, hash string) (domain.APIKey, error) {
+	if s.err != nil {
+		return domain.APIKey{}, s.err
+	}
+
+	if hash != s.result.Hash() {
+		return domain.APIKey{}, domain.ErrAPIKeyNotFound
+	}
+
+	return s.result, nil
+}
+
+func Test_authenticateAPIKeyInteractor_Execute(t *testing.T) {
+	const key = "gtk_0123456789abcdef"
+
+	var (
+		scopes  = []string{domain.ScopeAccountsRead}
+		active  = domain.NewAPIKey("1", "tenant-a", "backoffice", domain.HashAPIKey(key), scopes, time.Time{}, time.Time{})
+		revoked = domain.NewAPIKey("1", "tenant-a", "backoffice", domain.HashAPIKey(key), scopes, time.Time{}, time.Now())
+	)
+
+	tests := []struct {
+		name    string
+		repo    stubFindAPIKeyByHashRepo
+		key     string
+		wantID  string
+		wantErr error
+	}{
+		{
+			name:   "Authenticate API key",
+			repo:   stubFindAPIKeyByHashRepo{result: active},
+			key:    key,
+			wantID: "1",
+		},
+		{
+			name:    "Error authenticating unknown API key",
+			repo:    stubFindAPIKeyByHashRepo{result: active},
+			key:     "gtk_unknown",
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Error authenticating revoked API key",
+			repo:    stubFindAPIKeyByHashRepo{result: revoked},
+			key:     key,
+			wantErr: domain.ErrCredentialsInvalid,
+		},
+		{
+			name:    "Repository error when authenticate API key",
+			repo:    stubFindAPIKeyByHashRepo{err: errDB},
+			key:     key,
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAuthenticateAPIKeyInteractor(tt.repo, time.Second).Execute(context.Background(), tt.key)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if got.ID() != tt.wantID {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.ID(), tt.wantID)
+			}
+		})
+	}
+}
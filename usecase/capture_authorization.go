@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+type (
+	// Input port
+	CaptureAuthorizationUseCase interface {
+		Execute(context.Context, CaptureAuthorizationInput) (CaptureAuthorizationOutput, error)
+	}
+
+	// Input data
+	CaptureAuthorizationInput struct {
+		AuthorizationID string `json:"authorization_id" validate:"required"`
+		Amount          int64  `json:"amount" validate:"gte=0"`
+	}
+
+	// Output port
+	CaptureAuthorizationPresenter interface {
+		Output(domain.Authorization, domain.Transaction) CaptureAuthorizationOutput
+	}
+
+	// Output data
+	CaptureAuthorizationOutput struct {
+		ID             string                                `json:"id"`
+		AccountID      string                                `json:"account_id"`
+		Amount         int64                                 `json:"amount"`
+		CapturedAmount int64                                 `json:"captured_amount"`
+		Status         string                                `json:"status"`
+		Transaction    CaptureAuthorizationTransactionOutput `json:"transaction"`
+	}
+
+	// Output data
+	CaptureAuthorizationTransactionOutput struct {
+		ID        string                              `json:"id"`
+		Operation CaptureAuthorizationOperationOutput `json:"operation"`
+		Amount    int64                               `json:"amount"`
+		Balance   int64                               `json:"balance"`
+		CreatedAt string                              `json:"created_at"`
+	}
+
+	// Output data
+	CaptureAuthorizationOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+	}
+
+	captureAuthorizationInteractor struct {
+		uow                      domain.UnitOfWork
+		repoAuthorizationFinder  domain.AuthorizationFinder
+		repoAuthorizationUpdater domain.AuthorizationUpdater
+		repoTransactionCreator   domain.TransactionCreator
+		repoBalanceFinder        domain.TransactionBalanceFinder
+		repoBalanceUpdater       domain.TransactionBalanceUpdater
+		repoAccountLocker        domain.AccountLocker
+		repoAccountUpdater       domain.AccountUpdater
+		pre                      CaptureAuthorizationPresenter
+		metrics                  TransactionMetrics
+		clock                    Clock
+		ctxTimeout               time.Duration
+	}
+)
+
+// NewCaptureAuthorizationInteractor creates new captureAuthorizationInteractor with its dependencies
+func NewCaptureAuthorizationInteractor(
+	uow domain.UnitOfWork,
+	repoAuthorizationFinder domain.AuthorizationFinder,
+	repoAuthorizationUpdater domain.AuthorizationUpdater,
+	repoTransactionCreator domain.TransactionCreator,
+	repoBalanceFinder domain.TransactionBalanceFinder,
+	repoBalanceUpdater domain.TransactionBalanceUpdater,
+	repoAccountLocker domain.AccountLocker,
+	repoAccountUpdater domain.AccountUpdater,
+	pre CaptureAuthorizationPresenter,
+	metrics TransactionMetrics,
+	clock Clock,
+	ctxTimeout time.Duration,
+) CaptureAuthorizationUseCase {
+	return captureAuthorizationInteractor{
+		uow:                      uow,
+		repoAuthorizationFinder:  repoAuthorizationFinder,
+		repoAuthorizationUpdater: repoAuthorizationUpdater,
+		repoTransactionCreator:   repoTransactionCreator,
+		repoBalanceFinder:        repoBalanceFinder,
+		repoBalanceUpdater:       repoBalanceUpdater,
+		repoAccountLocker:        repoAccountLocker,
+		repoAccountUpdater:       repoAccountUpdater,
+		pre:                      pre,
+		metrics:                  metrics,
+		clock:                    clock,
+		ctxTimeout:               ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case, recording its outcome in the metrics
+func (c captureAuthorizationInteractor) Execute(ctx context.Context, i CaptureAuthorizationInput) (CaptureAuthorizationOutput, error) {
+	ctx, span := startSpan(ctx, "CaptureAuthorization", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	authorization, transaction, err := c.execute(ctx, i)
+	switch {
+	case err == nil:
+		c.metrics.TransactionCreated(transaction)
+		return c.pre.Output(authorization, transaction), nil
+	case transactionDeclined(err):
+		c.metrics.TransactionDeclined(authorization.Operation().ID(), i.Amount, err)
+	default:
+		c.metrics.TransactionFailed(authorization.Operation().ID(), err)
+	}
+
+	return c.pre.Output(domain.Authorization{}, domain.Transaction{}), err
+}
+
+// execute captures the authorization. Once it is found, the authorization is returned even when it is
+// not captured, so that the outcome is recorded with its operation
+func (c captureAuthorizationInteractor) execute(
+	ctx context.Context,
+	i CaptureAuthorizationInput,
+) (domain.Authorization, domain.Transaction, error) {
+	var (
+		authorization domain.Authorization
+		transaction   domain.Transaction
+		err           error
+	)
+
+	err = c.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		authorization, err = c.repoAuthorizationFinder.FindByID(ctxTx, i.AuthorizationID)
+		if err != nil {
+			return err
+		}
+
+		account, err := c.repoAccountLocker.LockByID(ctxTx, authorization.AccountID())
+		if err != nil {
+			return err
+		}
+
+		transaction, err = authorization.Capture(&account, uuid.New().String(), i.Amount, c.clock.Now())
+		if err != nil {
+			return err
+		}
+
+		if err = c.repoAccountUpdater.UpdateCreditLimit(ctxTx, account.ID(), account.AvailableCreditLimit()); err != nil {
+			return err
+		}
+
+		open, err := c.repoBalanceFinder.FindOpenByAccountID(ctxTx, account.ID())
+		if err != nil {
+			return err
+		}
+
+		for _, settled := range transaction.Settle(open) {
+			if err = c.repoBalanceUpdater.UpdateBalance(ctxTx, settled.ID(), settled.Balance()); err != nil {
+				return err
+			}
+		}
+
+		transaction, err = c.repoTransactionCreator.Create(ctxTx, transaction)
+		if err != nil {
+			return err
+		}
+
+		return c.repoAuthorizationUpdater.Update(ctxTx, authorization)
+	})
+	if err != nil {
+		return authorization, domain.Transaction{}, err
+	}
+
+	return authorization, transaction, nil
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubCaptureAuthorizationPresenter struct{}
+
+func (s stubCaptureAuthorizationPresenter) Output(authorization domain.Authorization, transaction domain.Transaction) CaptureAuthorizationOutput {
+	return CaptureAuthorizationOutput{
+		ID:             authorization.ID(),
+		Amount:         authorization.Amount(),
+		CapturedAmount: authorization.CapturedAmount(),
+		Status:         authorization.Status(),
+		Transaction: CaptureAuthorizationTransactionOutput{
+			Amount:  transaction.Amount(),
+			Balance: transaction.Balance(),
+		},
+	}
+}
+
+func Test_captureAuthorizationInteractor_Execute(t *testing.T) {
+	var (
+		opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		account           = domain.NewStoredAccount("fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "12345678900", 7500, time.Time{})
+		pending           = domain.NewAuthorization(
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+			opCompraAVista,
+			2500,
+			0,
+			domain.AuthorizationPending,
+			"",
+			authorizationNow.Add(time.Hour),
+			authorizationNow,
+		)
+	)
+
+	type fields struct {
+		repoAuthorizationFinder  domain.AuthorizationFinder
+		repoAuthorizationUpdater domain.AuthorizationUpdater
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		now         time.Time
+		amount      int64
+		want        CaptureAuthorizationOutput
+		wantMetrics []string
+		wantErr     error
+	}{
+		{
+			name: "Capture the full authorized amount",
+			fields: fields{
+				repoAuthorizationFinder:  stubFindAuthorizationByIDRepo{result: pending},
+				repoAuthorizationUpdater: stubUpdateAuthorizationRepo{},
+			},
+			now:    authorizationNow,
+			amount: 0,
+			want: CaptureAuthorizationOutput{
+				ID:             "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Amount:         2500,
+				CapturedAmount: 2500,
+				Status:         domain.AuthorizationCaptured,
+				Transaction: CaptureAuthorizationTransactionOutput{
+					Amount:  -2500,
+					Balance: -2500,
+				},
+			},
+			wantMetrics: []string{"created " + domain.CompraAVista},
+		},
+		{
+			name: "Capture a partial amount",
+			fields: fields{
+				repoAuthorizationFinder:  stubFindAuthorizationByIDRepo{result: pending},
+				repoAuthorizationUpdater: stubUpdateAuthorizationRepo{},
+			},
+			now:    authorizationNow,
+			amount: 1000,
+			want: CaptureAuthorizationOutput{
+				ID:             "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Amount:         2500,
+				CapturedAmount: 1000,
+				Status:         domain.AuthorizationCaptured,
+				Transaction: CaptureAuthorizationTransactionOutput{
+					Amount:  -1000,
+					Balance: -1000,
+				},
+			},
+			wantMetrics: []string{"created " + domain.CompraAVista},
+		},
+		{
+			name: "Error capturing more than the authorized amount",
+			fields: fields{
+				repoAuthorizationFinder:  stubFindAuthorizationByIDRepo{result: pending},
+				repoAuthorizationUpdater: stubUpdateAuthorizationRepo{},
+			},
+			now:         authorizationNow,
+			amount:      3000,
+			wantMetrics: []string{fmt.Sprintf("declined %s 3000 %v", domain.CompraAVista, domain.ErrAuthorizationCaptureExceedsAmount)},
+			wantErr:     domain.ErrAuthorizationCaptureExceedsAmount,
+		},
+		{
+			name: "Error capturing an expired authorization",
+			fields: fields{
+				repoAuthorizationFinder:  stubFindAuthorizationByIDRepo{result: pending},
+				repoAuthorizationUpdater: stubUpdateAuthorizationRepo{},
+			},
+			now:         authorizationNow.Add(time.Hour),
+			wantMetrics: []string{fmt.Sprintf("declined %s 0 %v", domain.CompraAVista, domain.ErrAuthorizationExpired)},
+			wantErr:     domain.ErrAuthorizationExpired,
+		},
+		{
+			name: "Error capturing a not found authorization",
+			fields: fields{
+				repoAuthorizationFinder:  stubFindAuthorizationByIDRepo{err: domain.ErrAuthorizationNotFound},
+				repoAuthorizationUpdater: stubUpdateAuthorizationRepo{},
+			},
+			now:         authorizationNow,
+			wantMetrics: []string{fmt.Sprintf("failed  %v", domain.ErrAuthorizationNotFound)},
+			wantErr:     domain.ErrAuthorizationNotFound,
+		},
+		{
+			name: "Error updating authorization in database",
+			fields: fields{
+				repoAuthorizationFinder:  stubFindAuthorizationByIDRepo{result: pending},
+				repoAuthorizationUpdater: stubUpdateAuthorizationRepo{err: errDB},
+			},
+			now:         authorizationNow,
+			wantMetrics: []string{fmt.Sprintf("failed %s %v", domain.CompraAVista, errDB)},
+			wantErr:     errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var metrics = make([]string, 0)
+			c := NewCaptureAuthorizationInteractor(
+				stubUnitOfWork{},
+				tt.fields.repoAuthorizationFinder,
+				tt.fields.repoAuthorizationUpdater,
+				spyCreateTransactionRepo{},
+				stubFindOpenTransactionsRepo{},
+				stubUpdateTransactionBalanceRepo{},
+				stubFindUserByRepo{result: account},
+				stubUpdateCreditLimitRepo{},
+				stubCaptureAuthorizationPresenter{},
+				spyTransactionMetrics{outcomes: &metrics},
+				stubClock{now: tt.now},
+				time.Second,
+			)
+
+			got, err := c.Execute(context.Background(), CaptureAuthorizationInput{
+				AuthorizationID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Amount:          tt.amount,
+			})
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(metrics, tt.wantMetrics) {
+				t.Errorf("[TestCase '%s'] Got metrics: '%v' | Want: '%v'", tt.name, metrics, tt.wantMetrics)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import "time"
+
+type (
+	// Clock defines the source of the current time, injectable to control time-dependent rules
+	Clock interface {
+		Now() time.Time
+	}
+
+	systemClock struct{}
+)
+
+// NewSystemClock creates new Clock backed by the system time
+func NewSystemClock() Clock {
+	return systemClock{}
+}
+
+// Now returns the current system time
+func (s systemClock) Now() time.Time {
+	return time.Now()
+}
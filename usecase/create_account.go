@@ -17,7 +17,7 @@ type (
 	// Input data
 	CreateAccountInput struct {
 		Document struct {
-			Number string `json:"number" validate:"required,max=30"`
+			Number string `json:"number" validate:"required,cpf_cnpj"`
 		}
 		AvailableCreditLimit int64 `json:"available_credit_limit" validate:"required,gt=0"`
 	}
@@ -38,9 +38,11 @@ type (
 	// Output data
 	CreateAccountDocumentOutput struct {
 		Number string `json:"number"`
+		Type   string `json:"type"`
 	}
 
 	createAccountInteractor struct {
+		uow        domain.UnitOfWork
 		repo       domain.AccountCreator
 		pre        CreateAccountPresenter
 		ctxTimeout time.Duration
@@ -49,11 +51,13 @@ type (
 
 // NewCreateAccountInteractor creates new createAccountInteractor with its dependencies
 func NewCreateAccountInteractor(
+	uow domain.UnitOfWork,
 	repo domain.AccountCreator,
 	pre CreateAccountPresenter,
 	ctxTimeout time.Duration,
 ) CreateAccountUseCase {
 	return createAccountInteractor{
+		uow:        uow,
 		repo:       repo,
 		pre:        pre,
 		ctxTimeout: ctxTimeout,
@@ -62,15 +66,35 @@ func NewCreateAccountInteractor(
 
 // Execute orchestrates the use case
 func (c createAccountInteractor) Execute(ctx context.Context, i CreateAccountInput) (CreateAccountOutput, error) {
+	ctx, span := startSpan(ctx, "CreateAccount", "")
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
 	defer cancel()
 
-	account, err := c.repo.Create(ctx, domain.NewAccount(
+	// The account belongs to the tenant of the request, so it is never created outside of one
+	tenantID := domain.TenantFrom(ctx)
+	if tenantID == "" {
+		return c.pre.Output(domain.Account{}), domain.ErrTenantMissing
+	}
+
+	account, err := domain.NewAccount(
 		uuid.New().String(),
 		i.Document.Number,
 		i.AvailableCreditLimit,
 		time.Now(),
-	))
+	)
+	if err != nil {
+		return c.pre.Output(domain.Account{}), err
+	}
+	account = account.WithTenant(tenantID)
+
+	// The account and the journal entry of its initial credit limit are created together
+	err = c.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		var err error
+		account, err = c.repo.Create(ctxTx, account)
+		return err
+	})
 	if err != nil {
 		return c.pre.Output(domain.Account{}), err
 	}
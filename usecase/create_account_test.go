@@ -26,6 +26,7 @@ func (s stubCreateAccountPresenter) Output(account domain.Account) CreateAccount
 		ID: account.ID(),
 		Document: CreateAccountDocumentOutput{
 			Number: account.Document().Number(),
+			Type:   account.Document().Type(),
 		},
 		CreatedAt: account.CreatedAt().String(),
 	}
@@ -52,9 +53,9 @@ func Test_createAccountInteractor_Execute(t *testing.T) {
 			name: "Create account successfully",
 			fields: fields{
 				repo: stubCreateAccountRepo{
-					result: domain.NewAccount(
+					result: domain.NewStoredAccount(
 						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
-						"12345678900",
+						"12345678909",
 						100,
 						time.Time{},
 					),
@@ -64,19 +65,20 @@ func Test_createAccountInteractor_Execute(t *testing.T) {
 				ctxTimeout: time.Second,
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: domain.WithTenant(context.Background(), "tenant-a"),
 				i: CreateAccountInput{
 					Document: struct {
-						Number string `json:"number" validate:"required,max=30"`
+						Number string `json:"number" validate:"required,cpf_cnpj"`
 					}{
-						Number: "12345678900",
+						Number: "123.456.789-09",
 					},
 				},
 			},
 			want: CreateAccountOutput{
 				ID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
 				Document: CreateAccountDocumentOutput{
-					Number: "12345678900",
+					Number: "12345678909",
+					Type:   domain.CPF,
 				},
 				CreatedAt: time.Time{}.String(),
 			},
@@ -93,12 +95,12 @@ func Test_createAccountInteractor_Execute(t *testing.T) {
 				ctxTimeout: time.Second,
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: domain.WithTenant(context.Background(), "tenant-a"),
 				i: CreateAccountInput{
 					Document: struct {
-						Number string `json:"number" validate:"required,max=30"`
+						Number string `json:"number" validate:"required,cpf_cnpj"`
 					}{
-						Number: "12345678900",
+						Number: "12345678909",
 					},
 				},
 			},
@@ -117,13 +119,57 @@ func Test_createAccountInteractor_Execute(t *testing.T) {
 				pre:        stubCreateAccountPresenter{},
 				ctxTimeout: time.Second,
 			},
+			args: args{
+				ctx: domain.WithTenant(context.Background(), "tenant-a"),
+				i: CreateAccountInput{
+					Document: struct {
+						Number string `json:"number" validate:"required,cpf_cnpj"`
+					}{
+						Number: "12345678909",
+					},
+				},
+			},
+			want: CreateAccountOutput{
+				CreatedAt: time.Time{}.String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error creating account with invalid document",
+			fields: fields{
+				repo:       stubCreateAccountRepo{},
+				pre:        stubCreateAccountPresenter{},
+				ctxTimeout: time.Second,
+			},
+			args: args{
+				ctx: domain.WithTenant(context.Background(), "tenant-a"),
+				i: CreateAccountInput{
+					Document: struct {
+						Number string `json:"number" validate:"required,cpf_cnpj"`
+					}{
+						Number: "abc",
+					},
+				},
+			},
+			want: CreateAccountOutput{
+				CreatedAt: time.Time{}.String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error creating account without tenant",
+			fields: fields{
+				repo:       stubCreateAccountRepo{},
+				pre:        stubCreateAccountPresenter{},
+				ctxTimeout: time.Second,
+			},
 			args: args{
 				ctx: context.Background(),
 				i: CreateAccountInput{
 					Document: struct {
-						Number string `json:"number" validate:"required,max=30"`
+						Number string `json:"number" validate:"required,cpf_cnpj"`
 					}{
-						Number: "12345678900",
+						Number: "12345678909",
 					},
 				},
 			},
@@ -135,7 +181,7 @@ func Test_createAccountInteractor_Execute(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			interactor := NewCreateAccountInteractor(tt.fields.repo, tt.fields.pre, tt.fields.ctxTimeout)
+			interactor := NewCreateAccountInteractor(stubUnitOfWork{}, tt.fields.repo, tt.fields.pre, tt.fields.ctxTimeout)
 
 			got, err := interactor.Execute(tt.args.ctx, tt.args.i)
 			if (err != nil) != tt.wantErr {
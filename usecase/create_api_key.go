@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// apiKeyPrefix identifies the API keys among other credentials, such as in secret scanners
+	apiKeyPrefix = "gtk_"
+	// apiKeySize defines how many random bytes make an API key
+	apiKeySize = 32
+)
+
+type (
+	// Input port
+	CreateAPIKeyUseCase interface {
+		Execute(context.Context, CreateAPIKeyInput) (CreateAPIKeyOutput, error)
+	}
+
+	// Input data
+	CreateAPIKeyInput struct {
+		Name   string   `json:"name" validate:"required,max=100"`
+		Scopes []string `json:"scopes" validate:"required,min=1"`
+	}
+
+	// Output port
+	CreateAPIKeyPresenter interface {
+		Output(domain.APIKey) CreateAPIKeyOutput
+	}
+
+	// Output data
+	CreateAPIKeyOutput struct {
+		ID        string   `json:"id"`
+		Name      string   `json:"name"`
+		Key       string   `json:"key"`
+		Scopes    []string `json:"scopes"`
+		CreatedAt string   `json:"created_at"`
+	}
+
+	createAPIKeyInteractor struct {
+		repo       domain.APIKeyCreator
+		pre        CreateAPIKeyPresenter
+		clock      Clock
+		ctxTimeout time.Duration
+	}
+)
+
+// NewCreateAPIKeyInteractor creates new createAPIKeyInteractor with its dependencies
+func NewCreateAPIKeyInteractor(
+	repo domain.APIKeyCreator,
+	pre CreateAPIKeyPresenter,
+	clock Clock,
+	ctxTimeout time.Duration,
+) CreateAPIKeyUseCase {
+	return createAPIKeyInteractor{
+		repo:       repo,
+		pre:        pre,
+		clock:      clock,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case. The API key belongs to the tenant of the request. The key is generated
+// at random and returned only in this response, the storage keeps its hash
+func (c createAPIKeyInteractor) Execute(ctx context.Context, i CreateAPIKeyInput) (CreateAPIKeyOutput, error) {
+	ctx, span := startSpan(ctx, "CreateAPIKey", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	key, err := newAPIKey()
+	if err != nil {
+		return c.pre.Output(domain.APIKey{}), err
+	}
+
+	apiKey, err := domain.IssueAPIKey(
+		uuid.New().String(),
+		domain.TenantFrom(ctx),
+		i.Name,
+		key,
+		i.Scopes,
+		c.clock.Now(),
+	)
+	if err != nil {
+		return c.pre.Output(domain.APIKey{}), err
+	}
+
+	if _, err = c.repo.Create(ctx, apiKey); err != nil {
+		return c.pre.Output(domain.APIKey{}), err
+	}
+
+	return c.pre.Output(apiKey), nil
+}
+
+func newAPIKey() (string, error) {
+	var b = make([]byte, apiKeySize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + hex.EncodeToString(b), nil
+}
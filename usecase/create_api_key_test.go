@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type spyCreateAPIKeyRepo struct {
+	created *domain.APIKey
+	err     error
+}
+
+func (s spyCreateAPIKeyRepo) Create(_ context.Context, apiKey domain.APIKey) (domain.APIKey, error) {
+	*s.created = apiKey
+	return apiKey, s.err
+}
+
+type stubCreateAPIKeyPresenter struct{}
+
+func (s stubCreateAPIKeyPresenter) Output(apiKey domain.APIKey) CreateAPIKeyOutput {
+	return CreateAPIKeyOutput{
+		ID:     apiKey.ID(),
+		Name:   apiKey.Name(),
+		Key:    apiKey.Key(),
+		Scopes: apiKey.Scopes(),
+	}
+}
+
+func Test_createAPIKeyInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenantID string
+		input    CreateAPIKeyInput
+		repoErr  error
+		wantErr  error
+	}{
+		{
+			name:     "Create API key",
+			tenantID: "tenant-a",
+			input: CreateAPIKeyInput{
+				Name:   "backoffice",
+				Scopes: []string{domain.ScopeAccountsRead, domain.ScopeTransactionsWrite},
+			},
+		},
+		{
+			name: "Error creating API key without tenant",
+			input: CreateAPIKeyInput{
+				Name:   "backoffice",
+				Scopes: []string{domain.ScopeAccountsRead},
+			},
+			wantErr: domain.ErrTenantMissing,
+		},
+		{
+			name:     "Error creating API key with unknown scope",
+			tenantID: "tenant-a",
+			input: CreateAPIKeyInput{
+				Name:   "backoffice",
+				Scopes: []string{"accounts:delete"},
+			},
+			wantErr: domain.ErrScopeInvalid,
+		},
+		{
+			name:     "Repository error when create API key",
+			tenantID: "tenant-a",
+			input: CreateAPIKeyInput{
+				Name:   "backoffice",
+				Scopes: []string{domain.ScopeAdmin},
+			},
+			repoErr: errDB,
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				created domain.APIKey
+				uc      = NewCreateAPIKeyInteractor(
+					spyCreateAPIKeyRepo{created: &created, err: tt.repoErr},
+					stubCreateAPIKeyPresenter{},
+					stubClock{},
+					time.Second,
+				)
+			)
+
+			got, err := uc.Execute(domain.WithTenant(context.Background(), tt.tenantID), tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if err != nil {
+				if !reflect.DeepEqual(got, CreateAPIKeyOutput{}) {
+					t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, CreateAPIKeyOutput{})
+				}
+				return
+			}
+
+			if !strings.HasPrefix(got.Key, apiKeyPrefix) || !reflect.DeepEqual(got.Scopes, tt.input.Scopes) {
+				t.Errorf("[TestCase '%s'] Got: '%+v'", tt.name, got)
+			}
+
+			if created.TenantID() != tt.tenantID {
+				t.Errorf("[TestCase '%s'] Got tenant: '%v' | Want: '%v'", tt.name, created.TenantID(), tt.tenantID)
+			}
+
+			if created.Hash() != domain.HashAPIKey(got.Key) {
+				t.Errorf("[TestCase '%s'] Got stored hash: '%v' | Want: '%v'", tt.name, created.Hash(), domain.HashAPIKey(got.Key))
+			}
+		})
+	}
+}
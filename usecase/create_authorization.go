@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+type (
+	// Input port
+	CreateAuthorizationUseCase interface {
+		Execute(context.Context, CreateAuthorizationInput) (CreateAuthorizationOutput, error)
+	}
+
+	// Input data
+	CreateAuthorizationInput struct {
+		AccountID   string `json:"account_id" validate:"required"`
+		OperationID string `json:"operation_id" validate:"required"`
+		Amount      int64  `json:"amount" validate:"required,gt=0"`
+	}
+
+	// Output port
+	CreateAuthorizationPresenter interface {
+		Output(domain.Authorization) CreateAuthorizationOutput
+	}
+
+	// Output data
+	CreateAuthorizationOutput struct {
+		ID             string                             `json:"id"`
+		AccountID      string                             `json:"account_id"`
+		Operation      CreateAuthorizationOperationOutput `json:"operation"`
+		Amount         int64                              `json:"amount"`
+		CapturedAmount int64                              `json:"captured_amount"`
+		Status         string                             `json:"status"`
+		ExpiresAt      string                             `json:"expires_at"`
+		CreatedAt      string                             `json:"created_at"`
+	}
+
+	// Output data
+	CreateAuthorizationOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+	}
+
+	createAuthorizationInteractor struct {
+		uow                      domain.UnitOfWork
+		repoAuthorizationCreator domain.AuthorizationCreator
+		repoAccountLocker        domain.AccountLocker
+		repoAccountUpdater       domain.AccountUpdater
+		repoOperationFinder      domain.OperationFinder
+		pre                      CreateAuthorizationPresenter
+		clock                    Clock
+		holdTTL                  time.Duration
+		ctxTimeout               time.Duration
+	}
+)
+
+// NewCreateAuthorizationInteractor creates new createAuthorizationInteractor with its dependencies
+func NewCreateAuthorizationInteractor(
+	uow domain.UnitOfWork,
+	repoAuthorizationCreator domain.AuthorizationCreator,
+	repoAccountLocker domain.AccountLocker,
+	repoAccountUpdater domain.AccountUpdater,
+	repoOperationFinder domain.OperationFinder,
+	pre CreateAuthorizationPresenter,
+	clock Clock,
+	holdTTL time.Duration,
+	ctxTimeout time.Duration,
+) CreateAuthorizationUseCase {
+	return createAuthorizationInteractor{
+		uow:                      uow,
+		repoAuthorizationCreator: repoAuthorizationCreator,
+		repoAccountLocker:        repoAccountLocker,
+		repoAccountUpdater:       repoAccountUpdater,
+		repoOperationFinder:      repoOperationFinder,
+		pre:                      pre,
+		clock:                    clock,
+		holdTTL:                  holdTTL,
+		ctxTimeout:               ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (c createAuthorizationInteractor) Execute(ctx context.Context, i CreateAuthorizationInput) (CreateAuthorizationOutput, error) {
+	ctx, span := startSpan(ctx, "CreateAuthorization", i.AccountID)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	var (
+		authorization domain.Authorization
+		err           error
+	)
+
+	op, err := findEnabledOperation(ctx, c.repoOperationFinder, i.OperationID)
+	if err != nil {
+		return c.pre.Output(domain.Authorization{}), err
+	}
+
+	err = c.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		account, err := c.repoAccountLocker.LockByID(ctxTx, i.AccountID)
+		if err != nil {
+			return err
+		}
+
+		now := c.clock.Now()
+		authorization, err = domain.PlaceAuthorization(
+			uuid.New().String(),
+			&account,
+			op,
+			i.Amount,
+			now.Add(c.holdTTL),
+			now,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = c.repoAccountUpdater.UpdateCreditLimit(ctxTx, account.ID(), account.AvailableCreditLimit()); err != nil {
+			return err
+		}
+
+		authorization, err = c.repoAuthorizationCreator.Create(ctxTx, authorization)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return c.pre.Output(domain.Authorization{}), err
+	}
+
+	return c.pre.Output(authorization), nil
+}
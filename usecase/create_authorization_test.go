@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+var authorizationNow = time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+
+type stubClock struct {
+	now time.Time
+}
+
+func (s stubClock) Now() time.Time {
+	return s.now
+}
+
+type stubCreateAuthorizationRepo struct {
+	err error
+}
+
+func (s stubCreateAuthorizationRepo) Create(_ context.Context, authorization domain.Authorization) (domain.Authorization, error) {
+	return authorization, s.err
+}
+
+type stubFindAuthorizationByIDRepo struct {
+	result domain.Authorization
+	err    error
+}
+
+func (s stubFindAuthorizationByIDRepo) FindByID(_ context.Context, _ string) (domain.Authorization, error) {
+	return s.result, s.err
+}
+
+type stubUpdateAuthorizationRepo struct {
+	err error
+}
+
+func (s stubUpdateAuthorizationRepo) Update(_ context.Context, _ domain.Authorization) error {
+	return s.err
+}
+
+type stubCreateAuthorizationPresenter struct{}
+
+func (s stubCreateAuthorizationPresenter) Output(authorization domain.Authorization) CreateAuthorizationOutput {
+	return CreateAuthorizationOutput{
+		AccountID:      authorization.AccountID(),
+		Amount:         authorization.Amount(),
+		CapturedAmount: authorization.CapturedAmount(),
+		Status:         authorization.Status(),
+		ExpiresAt:      authorization.ExpiresAt().String(),
+	}
+}
+
+func Test_createAuthorizationInteractor_Execute(t *testing.T) {
+	type fields struct {
+		repoAuthorizationCreator domain.AuthorizationCreator
+		repoAccountLocker        domain.AccountLocker
+		repoAccountUpdater       domain.AccountUpdater
+	}
+	type args struct {
+		i CreateAuthorizationInput
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    CreateAuthorizationOutput
+		wantErr error
+	}{
+		{
+			name: "Create successful authorization",
+			fields: fields{
+				repoAuthorizationCreator: stubCreateAuthorizationRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount("fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "12345678900", 10000, time.Time{}),
+				},
+				repoAccountUpdater: stubUpdateCreditLimitRepo{},
+			},
+			args: args{
+				i: CreateAuthorizationInput{
+					AccountID:   "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					OperationID: domain.CompraAVista,
+					Amount:      2500,
+				},
+			},
+			want: CreateAuthorizationOutput{
+				AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				Amount:    2500,
+				Status:    domain.AuthorizationPending,
+				ExpiresAt: authorizationNow.Add(time.Hour).String(),
+			},
+		},
+		{
+			name: "Error creating authorization with credit operation",
+			fields: fields{
+				repoAuthorizationCreator: stubCreateAuthorizationRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount("fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "12345678900", 10000, time.Time{}),
+				},
+				repoAccountUpdater: stubUpdateCreditLimitRepo{},
+			},
+			args: args{
+				i: CreateAuthorizationInput{
+					AccountID:   "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					OperationID: domain.Pagamento,
+					Amount:      2500,
+				},
+			},
+			want: CreateAuthorizationOutput{
+				ExpiresAt: time.Time{}.String(),
+			},
+			wantErr: domain.ErrOperationInvalid,
+		},
+		{
+			name: "Error creating authorization with insufficient credit limit",
+			fields: fields{
+				repoAuthorizationCreator: stubCreateAuthorizationRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount("fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "12345678900", 100, time.Time{}),
+				},
+				repoAccountUpdater: stubUpdateCreditLimitRepo{},
+			},
+			args: args{
+				i: CreateAuthorizationInput{
+					AccountID:   "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					OperationID: domain.CompraAVista,
+					Amount:      2500,
+				},
+			},
+			want: CreateAuthorizationOutput{
+				ExpiresAt: time.Time{}.String(),
+			},
+			wantErr: domain.ErrAccountInsufficientCreditLimit,
+		},
+		{
+			name: "Error creating authorization in database",
+			fields: fields{
+				repoAuthorizationCreator: stubCreateAuthorizationRepo{err: errDB},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount("fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "12345678900", 10000, time.Time{}),
+				},
+				repoAccountUpdater: stubUpdateCreditLimitRepo{},
+			},
+			args: args{
+				i: CreateAuthorizationInput{
+					AccountID:   "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					OperationID: domain.CompraAVista,
+					Amount:      2500,
+				},
+			},
+			want: CreateAuthorizationOutput{
+				ExpiresAt: time.Time{}.String(),
+			},
+			wantErr: errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCreateAuthorizationInteractor(
+				stubUnitOfWork{},
+				tt.fields.repoAuthorizationCreator,
+				tt.fields.repoAccountLocker,
+				tt.fields.repoAccountUpdater,
+				stubFindOperationRepo{},
+				stubCreateAuthorizationPresenter{},
+				stubClock{now: authorizationNow},
+				time.Hour,
+				time.Second,
+			)
+
+			got, err := c.Execute(context.Background(), tt.args.i)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
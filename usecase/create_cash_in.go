@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+type (
+	// Input port
+	CreateCashInUseCase interface {
+		Execute(context.Context, CreateCashInInput) (CreateCashInOutput, error)
+	}
+
+	// Input data
+	CreateCashInInput struct {
+		AccountID string                  `json:"account_id" validate:"required"`
+		Amount    int64                   `json:"amount" validate:"required,gt=0"`
+		Source    CreateCashInSourceInput `json:"source" validate:"required"`
+	}
+
+	// Input data
+	CreateCashInSourceInput struct {
+		Type      string `json:"type" validate:"required,oneof=BOLETO PIX"`
+		Reference string `json:"reference" validate:"required"`
+	}
+
+	// Output port
+	CreateCashInPresenter interface {
+		Output(domain.CashIn) CreateCashInOutput
+	}
+
+	// Output data
+	CreateCashInOutput struct {
+		ID            string                   `json:"id"`
+		AccountID     string                   `json:"account_id"`
+		Source        CreateCashInSourceOutput `json:"source"`
+		Amount        int64                    `json:"amount"`
+		TransactionID string                   `json:"transaction_id"`
+		CreatedAt     string                   `json:"created_at"`
+	}
+
+	// Output data
+	CreateCashInSourceOutput struct {
+		Type      string `json:"type"`
+		Reference string `json:"reference"`
+	}
+
+	createCashInInteractor struct {
+		uow                    domain.UnitOfWork
+		repoCashInCreator      domain.CashInCreator
+		repoTransactionCreator domain.TransactionCreator
+		repoBalanceFinder      domain.TransactionBalanceFinder
+		repoBalanceUpdater     domain.TransactionBalanceUpdater
+		repoAccountLocker      domain.AccountLocker
+		repoAccountUpdater     domain.AccountUpdater
+		pre                    CreateCashInPresenter
+		metrics                TransactionMetrics
+		ctxTimeout             time.Duration
+	}
+)
+
+// NewCreateCashInInteractor creates new createCashInInteractor with its dependencies
+func NewCreateCashInInteractor(
+	uow domain.UnitOfWork,
+	repoCashInCreator domain.CashInCreator,
+	repoTransactionCreator domain.TransactionCreator,
+	repoBalanceFinder domain.TransactionBalanceFinder,
+	repoBalanceUpdater domain.TransactionBalanceUpdater,
+	repoAccountLocker domain.AccountLocker,
+	repoAccountUpdater domain.AccountUpdater,
+	pre CreateCashInPresenter,
+	metrics TransactionMetrics,
+	ctxTimeout time.Duration,
+) CreateCashInUseCase {
+	return createCashInInteractor{
+		uow:                    uow,
+		repoCashInCreator:      repoCashInCreator,
+		repoTransactionCreator: repoTransactionCreator,
+		repoBalanceFinder:      repoBalanceFinder,
+		repoBalanceUpdater:     repoBalanceUpdater,
+		repoAccountLocker:      repoAccountLocker,
+		repoAccountUpdater:     repoAccountUpdater,
+		pre:                    pre,
+		metrics:                metrics,
+		ctxTimeout:             ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case, recording its outcome in the metrics
+func (c createCashInInteractor) Execute(ctx context.Context, i CreateCashInInput) (CreateCashInOutput, error) {
+	ctx, span := startSpan(ctx, "CreateCashIn", i.AccountID)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	cashIn, err := c.execute(ctx, i)
+	switch {
+	case err == nil:
+		c.metrics.TransactionCreated(cashIn.Transaction())
+		return c.pre.Output(cashIn), nil
+	case transactionDeclined(err):
+		c.metrics.TransactionDeclined(domain.Deposito, i.Amount, err)
+	default:
+		c.metrics.TransactionFailed(domain.Deposito, err)
+	}
+
+	return c.pre.Output(domain.CashIn{}), err
+}
+
+func (c createCashInInteractor) execute(ctx context.Context, i CreateCashInInput) (domain.CashIn, error) {
+	source, err := domain.NewCashInSource(i.Source.Type, i.Source.Reference)
+	if err != nil {
+		return domain.CashIn{}, err
+	}
+
+	var cashIn domain.CashIn
+
+	err = c.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		account, err := c.repoAccountLocker.LockByID(ctxTx, i.AccountID)
+		if err != nil {
+			return err
+		}
+
+		cashIn = domain.NewCashIn(
+			uuid.New().String(),
+			&account,
+			uuid.New().String(),
+			source,
+			i.Amount,
+			time.Now(),
+		)
+
+		if err = c.repoAccountUpdater.UpdateCreditLimit(ctxTx, account.ID(), account.AvailableCreditLimit()); err != nil {
+			return err
+		}
+
+		var (
+			transaction = cashIn.Transaction()
+			open        []domain.Transaction
+		)
+		open, err = c.repoBalanceFinder.FindOpenByAccountID(ctxTx, account.ID())
+		if err != nil {
+			return err
+		}
+
+		for _, settled := range transaction.Settle(open) {
+			if err = c.repoBalanceUpdater.UpdateBalance(ctxTx, settled.ID(), settled.Balance()); err != nil {
+				return err
+			}
+		}
+
+		transaction, err = c.repoTransactionCreator.Create(ctxTx, transaction)
+		if err != nil {
+			return err
+		}
+
+		cashIn, err = c.repoCashInCreator.Create(ctxTx, cashIn.WithTransaction(transaction))
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.CashIn{}, err
+	}
+
+	return cashIn, nil
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubCreateCashInRepo struct {
+	err error
+}
+
+func (s stubCreateCashInRepo) Create(_ context.Context, cashIn domain.CashIn) (domain.CashIn, error) {
+	return cashIn, s.err
+}
+
+type stubCreateCashInPresenter struct{}
+
+func (s stubCreateCashInPresenter) Output(cashIn domain.CashIn) CreateCashInOutput {
+	return CreateCashInOutput{
+		AccountID: cashIn.AccountID(),
+		Source: CreateCashInSourceOutput{
+			Type:      cashIn.Source().Type(),
+			Reference: cashIn.Source().Reference(),
+		},
+		Amount: cashIn.Amount(),
+	}
+}
+
+func Test_createCashInInteractor_Execute(t *testing.T) {
+	var (
+		accounts = map[string]domain.Account{
+			"a": domain.NewStoredAccount("a", "12345678900", 1000, time.Time{}),
+		}
+		pix = CreateCashInSourceInput{
+			Type:      domain.CashInSourcePix,
+			Reference: "E00038166202010161750abcdefghijk",
+		}
+	)
+
+	tests := []struct {
+		name        string
+		repo        domain.CashInCreator
+		input       CreateCashInInput
+		want        CreateCashInOutput
+		wantLimits  map[string]int64
+		wantMetrics []string
+		wantErr     error
+	}{
+		{
+			name:  "Create successful cash-in",
+			repo:  stubCreateCashInRepo{},
+			input: CreateCashInInput{AccountID: "a", Amount: 250, Source: pix},
+			want: CreateCashInOutput{
+				AccountID: "a",
+				Source: CreateCashInSourceOutput{
+					Type:      domain.CashInSourcePix,
+					Reference: "E00038166202010161750abcdefghijk",
+				},
+				Amount: 250,
+			},
+			wantLimits:  map[string]int64{"a": 1250},
+			wantMetrics: []string{"created " + domain.Deposito},
+		},
+		{
+			name: "Error invalid source reference",
+			repo: stubCreateCashInRepo{},
+			input: CreateCashInInput{
+				AccountID: "a",
+				Amount:    250,
+				Source:    CreateCashInSourceInput{Type: domain.CashInSourceBoleto, Reference: "123"},
+			},
+			wantLimits:  map[string]int64{},
+			wantMetrics: []string{fmt.Sprintf("declined %s 250 %v", domain.Deposito, domain.ErrCashInSourceInvalid)},
+			wantErr:     domain.ErrCashInSourceInvalid,
+		},
+		{
+			name:        "Error account not found",
+			repo:        stubCreateCashInRepo{},
+			input:       CreateCashInInput{AccountID: "b", Amount: 250, Source: pix},
+			wantLimits:  map[string]int64{},
+			wantMetrics: []string{fmt.Sprintf("failed %s %v", domain.Deposito, domain.ErrAccountNotFound)},
+			wantErr:     domain.ErrAccountNotFound,
+		},
+		{
+			name:        "Error cash-in already processed",
+			repo:        stubCreateCashInRepo{err: domain.ErrCashInAlreadyProcessed},
+			input:       CreateCashInInput{AccountID: "a", Amount: 250, Source: pix},
+			wantLimits:  map[string]int64{"a": 1250},
+			wantMetrics: []string{fmt.Sprintf("failed %s %v", domain.Deposito, domain.ErrCashInAlreadyProcessed)},
+			wantErr:     domain.ErrCashInAlreadyProcessed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				locked  []string
+				limits  = map[string]int64{}
+				metrics = make([]string, 0)
+				useCase = NewCreateCashInInteractor(
+					stubUnitOfWork{},
+					tt.repo,
+					spyCreateTransactionRepo{},
+					stubFindOpenTransactionsRepo{},
+					stubUpdateTransactionBalanceRepo{},
+					spyLockAccountRepo{accounts: accounts, locked: &locked},
+					spyUpdateCreditLimitRepo{limits: limits},
+					stubCreateCashInPresenter{},
+					spyTransactionMetrics{outcomes: &metrics},
+					time.Second,
+				)
+			)
+
+			got, err := useCase.Execute(context.Background(), tt.input)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(limits, tt.wantLimits) {
+				t.Errorf("[TestCase '%s'] Got limits: '%v' | Want: '%v'", tt.name, limits, tt.wantLimits)
+			}
+
+			if !reflect.DeepEqual(metrics, tt.wantMetrics) {
+				t.Errorf("[TestCase '%s'] Got metrics: '%v' | Want: '%v'", tt.name, metrics, tt.wantMetrics)
+			}
+		})
+	}
+}
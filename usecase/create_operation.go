@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	CreateOperationUseCase interface {
+		Execute(context.Context, CreateOperationInput) (CreateOperationOutput, error)
+	}
+
+	// Input data
+	CreateOperationInput struct {
+		ID          string `json:"id" validate:"required,max=36"`
+		Description string `json:"description" validate:"required,max=50"`
+		Type        string `json:"type" validate:"required,oneof=DEBIT CREDIT"`
+	}
+
+	// Output port
+	CreateOperationPresenter interface {
+		Output(domain.Operation) CreateOperationOutput
+	}
+
+	// Output data
+	CreateOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+		Enabled     bool   `json:"enabled"`
+	}
+
+	createOperationInteractor struct {
+		repo       domain.OperationCreator
+		pre        CreateOperationPresenter
+		ctxTimeout time.Duration
+	}
+)
+
+// NewCreateOperationInteractor creates new createOperationInteractor with its dependencies
+func NewCreateOperationInteractor(
+	repo domain.OperationCreator,
+	pre CreateOperationPresenter,
+	ctxTimeout time.Duration,
+) CreateOperationUseCase {
+	return createOperationInteractor{
+		repo:       repo,
+		pre:        pre,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (c createOperationInteractor) Execute(ctx context.Context, i CreateOperationInput) (CreateOperationOutput, error) {
+	ctx, span := startSpan(ctx, "CreateOperation", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	operation, err := domain.NewCatalogOperation(i.ID, i.Description, i.Type, true)
+	if err != nil {
+		return c.pre.Output(domain.Operation{}), err
+	}
+
+	operation, err = c.repo.Create(ctx, operation)
+	if err != nil {
+		return c.pre.Output(domain.Operation{}), err
+	}
+
+	return c.pre.Output(operation), nil
+}
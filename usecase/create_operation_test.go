@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubCreateOperationRepo struct {
+	err error
+}
+
+func (s stubCreateOperationRepo) Create(_ context.Context, operation domain.Operation) (domain.Operation, error) {
+	return operation, s.err
+}
+
+type stubCreateOperationPresenter struct{}
+
+func (s stubCreateOperationPresenter) Output(operation domain.Operation) CreateOperationOutput {
+	return CreateOperationOutput{
+		ID:          operation.ID(),
+		Description: operation.Description(),
+		Type:        operation.Type(),
+		Enabled:     operation.Enabled(),
+	}
+}
+
+func Test_createOperationInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    domain.OperationCreator
+		input   CreateOperationInput
+		want    CreateOperationOutput
+		wantErr error
+	}{
+		{
+			name:  "Create successful operation",
+			repo:  stubCreateOperationRepo{},
+			input: CreateOperationInput{ID: "9", Description: "ANUIDADE", Type: domain.Debit},
+			want:  CreateOperationOutput{ID: "9", Description: "ANUIDADE", Type: domain.Debit, Enabled: true},
+		},
+		{
+			name:    "Error invalid operation type",
+			repo:    stubCreateOperationRepo{},
+			input:   CreateOperationInput{ID: "9", Description: "ANUIDADE", Type: "REFUND"},
+			wantErr: domain.ErrOperationInvalid,
+		},
+		{
+			name:    "Error operation already exists",
+			repo:    stubCreateOperationRepo{err: domain.ErrOperationAlreadyExists},
+			input:   CreateOperationInput{ID: "1", Description: "COMPRA A VISTA", Type: domain.Debit},
+			wantErr: domain.ErrOperationAlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCreateOperationInteractor(tt.repo, stubCreateOperationPresenter{}, time.Second).
+				Execute(context.Background(), tt.input)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
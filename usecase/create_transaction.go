@@ -16,9 +16,10 @@ type (
 
 	// Input data
 	CreateTransactionInput struct {
-		AccountID   string `json:"account_id" validate:"required"`
-		OperationID string `json:"operation_id" validate:"required"`
-		Amount      int64  `json:"amount" validate:"required,gt=0"`
+		AccountID    string `json:"account_id" validate:"required"`
+		OperationID  string `json:"operation_id" validate:"required"`
+		Amount       int64  `json:"amount" validate:"required,gt=0"`
+		Installments int    `json:"installments" validate:"omitempty,min=2,max=24"`
 	}
 
 	// Output port
@@ -28,12 +29,20 @@ type (
 
 	// Output data
 	CreateTransactionOutput struct {
-		ID        string                           `json:"id"`
-		AccountID string                           `json:"account_id"`
-		Operation CreateTransactionOperationOutput `json:"operation"`
-		Amount    int64                            `json:"amount"`
-		Balance   int64                            `json:"balance"`
-		CreatedAt string                           `json:"created_at"`
+		ID           string                               `json:"id"`
+		AccountID    string                               `json:"account_id"`
+		Operation    CreateTransactionOperationOutput     `json:"operation"`
+		Amount       int64                                `json:"amount"`
+		Balance      int64                                `json:"balance"`
+		Installments []CreateTransactionInstallmentOutput `json:"installments,omitempty"`
+		CreatedAt    string                               `json:"created_at"`
+	}
+
+	// Output data
+	CreateTransactionInstallmentOutput struct {
+		Number  int    `json:"number"`
+		Amount  int64  `json:"amount"`
+		DueDate string `json:"due_date"`
 	}
 
 	// Output data
@@ -44,71 +53,145 @@ type (
 	}
 
 	createTransactionInteractor struct {
+		uow                    domain.UnitOfWork
 		repoTransactionCreator domain.TransactionCreator
-		repoAccountFinder      domain.AccountFinder
+		repoBalanceFinder      domain.TransactionBalanceFinder
+		repoBalanceUpdater     domain.TransactionBalanceUpdater
+		repoAccountLocker      domain.AccountLocker
 		repoAccountUpdater     domain.AccountUpdater
+		repoOperationFinder    domain.OperationFinder
+		repoOutboxCreator      domain.OutboxCreator
 		pre                    CreateTransactionPresenter
+		metrics                TransactionMetrics
 		ctxTimeout             time.Duration
 	}
 )
 
 // NewCreateTransactionInteractor creates new createTransactionInteractor with its dependencies
 func NewCreateTransactionInteractor(
+	uow domain.UnitOfWork,
 	repoTransactionCreator domain.TransactionCreator,
-	repoAccountFinder domain.AccountFinder,
+	repoBalanceFinder domain.TransactionBalanceFinder,
+	repoBalanceUpdater domain.TransactionBalanceUpdater,
+	repoAccountLocker domain.AccountLocker,
 	repoAccountUpdater domain.AccountUpdater,
+	repoOperationFinder domain.OperationFinder,
+	repoOutboxCreator domain.OutboxCreator,
 	pre CreateTransactionPresenter,
+	metrics TransactionMetrics,
 	ctxTimeout time.Duration,
 ) CreateTransactionUseCase {
 	return createTransactionInteractor{
+		uow:                    uow,
 		repoTransactionCreator: repoTransactionCreator,
-		repoAccountFinder:      repoAccountFinder,
+		repoBalanceFinder:      repoBalanceFinder,
+		repoBalanceUpdater:     repoBalanceUpdater,
+		repoAccountLocker:      repoAccountLocker,
 		repoAccountUpdater:     repoAccountUpdater,
+		repoOperationFinder:    repoOperationFinder,
+		repoOutboxCreator:      repoOutboxCreator,
 		pre:                    pre,
+		metrics:                metrics,
 		ctxTimeout:             ctxTimeout,
 	}
 }
 
-// Execute orchestrates the use case
+// Execute orchestrates the use case, recording its outcome in the metrics
 func (c createTransactionInteractor) Execute(ctx context.Context, i CreateTransactionInput) (CreateTransactionOutput, error) {
+	ctx, span := startSpan(ctx, "CreateTransaction", i.AccountID)
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
 	defer cancel()
 
+	transaction, err := c.execute(ctx, i)
+	switch {
+	case err == nil:
+		c.metrics.TransactionCreated(transaction)
+		return c.pre.Output(transaction), nil
+	case transactionDeclined(err):
+		c.metrics.TransactionDeclined(transaction.Operation().ID(), i.Amount, err)
+	default:
+		c.metrics.TransactionFailed(transaction.Operation().ID(), err)
+	}
+
+	return c.pre.Output(domain.Transaction{}), err
+}
+
+// execute creates the transaction. Once its operation is found, the transaction is returned even when
+// it is not created, so that the outcome is recorded with the operation
+func (c createTransactionInteractor) execute(ctx context.Context, i CreateTransactionInput) (domain.Transaction, error) {
 	var (
 		account     domain.Account
 		transaction domain.Transaction
+		created     domain.Transaction
+		rejected    error
 		err         error
 	)
 
-	op, err := domain.NewOperation(i.OperationID)
+	op, err := findEnabledOperation(ctx, c.repoOperationFinder, i.OperationID)
 	if err != nil {
-		return c.pre.Output(domain.Transaction{}), err
+		return domain.Transaction{}, err
+	}
+
+	transaction = domain.NewTransaction(
+		uuid.New().String(),
+		i.AccountID,
+		op,
+		i.Amount,
+		0,
+		time.Now(),
+	)
+
+	// Without installments, a COMPRA PARCELADA keeps being created as a single amount, as before the
+	// installment plans existed
+	if i.Installments > 0 {
+		if err = transaction.SplitInstallments(i.Installments, domain.RemainderOnFirst); err != nil {
+			return transaction, err
+		}
 	}
 
-	err = c.repoTransactionCreator.WithTransaction(ctx, func(ctxTx context.Context) error {
-		account, err = c.repoAccountFinder.FindByID(ctxTx, i.AccountID)
+	err = c.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		rejected = nil
+
+		account, err = c.repoAccountLocker.LockByID(ctxTx, i.AccountID)
 		if err != nil {
 			return err
 		}
+		transaction = transaction.WithTenant(account.TenantID())
 
 		if err = account.PaymentOperation(i.Amount, op.Type()); err != nil {
-			return err
+			if err != domain.ErrAccountInsufficientCreditLimit {
+				return err
+			}
+
+			// The rejection is recorded while the account is still locked, so that its event
+			// keeps the order of the other events of the account
+			rejected = err
+			return c.repoOutboxCreator.Create(
+				ctxTx,
+				domain.NewTransactionRejectedEvent(uuid.New().String(), account.ID(), op, i.Amount, err, time.Now()).
+					WithTenant(account.TenantID()),
+			)
 		}
 
 		if err = c.repoAccountUpdater.UpdateCreditLimit(ctxTx, account.ID(), account.AvailableCreditLimit()); err != nil {
 			return err
 		}
 
-		balance := i.Amount
+		var open []domain.Transaction
+		open, err = c.repoBalanceFinder.FindOpenByAccountID(ctxTx, account.ID())
+		if err != nil {
+			return err
+		}
+
+		for _, settled := range transaction.Settle(open) {
+			if err = c.repoBalanceUpdater.UpdateBalance(ctxTx, settled.ID(), settled.Balance()); err != nil {
+				return err
+			}
+		}
 
-		transaction, err = c.repoTransactionCreator.Create(ctxTx, domain.NewTransaction(
-			uuid.New().String(),
-			i.AccountID,
-			op,
-			i.Amount,
-			balance,
-			time.Now(),
-		))
+		created, err = c.repoTransactionCreator.Create(ctxTx, transaction)
 		if err != nil {
 			return err
 		}
@@ -116,8 +199,12 @@ func (c createTransactionInteractor) Execute(ctx context.Context, i CreateTransa
 		return nil
 	})
 	if err != nil {
-		return c.pre.Output(domain.Transaction{}), err
+		return transaction, err
+	}
+
+	if rejected != nil {
+		return transaction, rejected
 	}
 
-	return c.pre.Output(transaction), nil
+	return created, nil
 }
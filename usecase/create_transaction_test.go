@@ -3,7 +3,10 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,16 +18,18 @@ type stubCreateTransactionRepo struct {
 	err    error
 }
 
-func (s stubCreateTransactionRepo) WithTransaction(_ context.Context, fn func(context.Context) error) error {
-	if err := fn(context.Background()); err != nil {
-		return err
-	}
+func (s stubCreateTransactionRepo) Create(_ context.Context, _ domain.Transaction) (domain.Transaction, error) {
+	return s.result, s.err
+}
 
-	return nil
+// spyCreatedTransactionRepo records the transaction created
+type spyCreatedTransactionRepo struct {
+	created *domain.Transaction
 }
 
-func (s stubCreateTransactionRepo) Create(_ context.Context, _ domain.Transaction) (domain.Transaction, error) {
-	return s.result, s.err
+func (s spyCreatedTransactionRepo) Create(_ context.Context, transaction domain.Transaction) (domain.Transaction, error) {
+	*s.created = transaction
+	return transaction, nil
 }
 
 type stubCreateTransactionPresenter struct{}
@@ -53,6 +58,78 @@ func (s stubFindUserByRepo) FindByID(_ context.Context, _ string) (domain.Accoun
 	return s.result, s.err
 }
 
+func (s stubFindUserByRepo) LockByID(_ context.Context, _ string) (domain.Account, error) {
+	return s.result, s.err
+}
+
+type stubFindOpenTransactionsRepo struct {
+	result []domain.Transaction
+	err    error
+}
+
+func (s stubFindOpenTransactionsRepo) FindOpenByAccountID(_ context.Context, _ string) ([]domain.Transaction, error) {
+	return s.result, s.err
+}
+
+type stubUpdateTransactionBalanceRepo struct {
+	err error
+}
+
+func (s stubUpdateTransactionBalanceRepo) UpdateBalance(_ context.Context, _ string, _ int64) error {
+	return s.err
+}
+
+type stubFindOperationRepo struct {
+	disabled bool
+	err      error
+}
+
+func (s stubFindOperationRepo) FindByID(_ context.Context, ID string) (domain.Operation, error) {
+	if s.err != nil {
+		return domain.Operation{}, s.err
+	}
+
+	op, err := domain.NewOperation(ID)
+	if err != nil {
+		return domain.Operation{}, domain.ErrOperationNotFound
+	}
+
+	if s.disabled {
+		op.Disable()
+	}
+
+	return op, nil
+}
+
+type spyCreateOutboxEventRepo struct {
+	created *[]domain.Event
+	err     error
+}
+
+func (s spyCreateOutboxEventRepo) Create(_ context.Context, event domain.Event) error {
+	if s.created != nil {
+		*s.created = append(*s.created, event)
+	}
+
+	return s.err
+}
+
+type spyTransactionMetrics struct {
+	outcomes *[]string
+}
+
+func (s spyTransactionMetrics) TransactionCreated(transaction domain.Transaction) {
+	*s.outcomes = append(*s.outcomes, "created "+transaction.Operation().ID())
+}
+
+func (s spyTransactionMetrics) TransactionDeclined(operationID string, amount int64, reason error) {
+	*s.outcomes = append(*s.outcomes, fmt.Sprintf("declined %s %d %v", operationID, amount, reason))
+}
+
+func (s spyTransactionMetrics) TransactionFailed(operationID string, err error) {
+	*s.outcomes = append(*s.outcomes, fmt.Sprintf("failed %s %v", operationID, err))
+}
+
 type stubUpdateCreditLimitRepo struct {
 	err error
 }
@@ -66,7 +143,9 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 
 	type fields struct {
 		repo               domain.TransactionCreator
-		repoAccountFinder  domain.AccountFinder
+		repoBalanceFinder  domain.TransactionBalanceFinder
+		repoBalanceUpdater domain.TransactionBalanceUpdater
+		repoAccountLocker  domain.AccountLocker
 		repoAccountUpdater domain.AccountUpdater
 		pre                CreateTransactionPresenter
 		ctxTimeout         time.Duration
@@ -96,8 +175,10 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 					),
 					err: nil,
 				},
-				repoAccountFinder: stubFindUserByRepo{
-					result: domain.NewAccount(
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
 						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
 						"12345678900",
 						10025,
@@ -145,8 +226,10 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 					),
 					err: nil,
 				},
-				repoAccountFinder: stubFindUserByRepo{
-					result: domain.NewAccount(
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
 						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
 						"12345678900",
 						1,
@@ -178,8 +261,10 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 					result: domain.Transaction{},
 					err:    nil,
 				},
-				repoAccountFinder: stubFindUserByRepo{
-					result: domain.NewAccount(
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
 						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
 						"12345678900",
 						1,
@@ -211,8 +296,10 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 					result: domain.Transaction{},
 					err:    errors.New("db_error"),
 				},
-				repoAccountFinder: stubFindUserByRepo{
-					result: domain.NewAccount(
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
 						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
 						"12345678900",
 						10025,
@@ -244,7 +331,9 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 					result: domain.Transaction{},
 					err:    nil,
 				},
-				repoAccountFinder: stubFindUserByRepo{
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
 					result: domain.Account{},
 					err:    errors.New("db_error"),
 				},
@@ -272,8 +361,10 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 					result: domain.Transaction{},
 					err:    nil,
 				},
-				repoAccountFinder: stubFindUserByRepo{
-					result: domain.NewAccount(
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
 						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
 						"12345678900",
 						10025,
@@ -298,14 +389,170 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Create compra parcelada without installments",
+			fields: fields{
+				repo:               stubCreateTransactionRepo{},
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
+						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						"12345678900",
+						10025,
+						time.Time{},
+					),
+					err: nil,
+				},
+				repoAccountUpdater: stubUpdateCreditLimitRepo{err: nil},
+				pre:                stubCreateTransactionPresenter{},
+				ctxTimeout:         time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: CreateTransactionInput{
+					AccountID:   "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					OperationID: domain.CompraParcelada,
+					Amount:      10025,
+				},
+			},
+			want: CreateTransactionOutput{
+				CreatedAt: time.Time{}.String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error installments on compra a vista",
+			fields: fields{
+				repo:               stubCreateTransactionRepo{},
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
+						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						"12345678900",
+						10025,
+						time.Time{},
+					),
+					err: nil,
+				},
+				repoAccountUpdater: stubUpdateCreditLimitRepo{err: nil},
+				pre:                stubCreateTransactionPresenter{},
+				ctxTimeout:         time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: CreateTransactionInput{
+					AccountID:    "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					OperationID:  domain.CompraAVista,
+					Amount:       10025,
+					Installments: 3,
+				},
+			},
+			want: CreateTransactionOutput{
+				CreatedAt: time.Time{}.String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error find open transactions repository",
+			fields: fields{
+				repo: stubCreateTransactionRepo{
+					result: domain.Transaction{},
+					err:    nil,
+				},
+				repoBalanceFinder: stubFindOpenTransactionsRepo{
+					result: []domain.Transaction{},
+					err:    errors.New("db_error"),
+				},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
+						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						"12345678900",
+						10025,
+						time.Time{},
+					),
+					err: nil,
+				},
+				repoAccountUpdater: stubUpdateCreditLimitRepo{err: nil},
+				pre:                stubCreateTransactionPresenter{},
+				ctxTimeout:         time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: CreateTransactionInput{
+					AccountID:   "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					OperationID: domain.Pagamento,
+					Amount:      10025,
+				},
+			},
+			want: CreateTransactionOutput{
+				CreatedAt: time.Time{}.String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error update balance of settled transaction repository",
+			fields: fields{
+				repo: stubCreateTransactionRepo{
+					result: domain.Transaction{},
+					err:    nil,
+				},
+				repoBalanceFinder: stubFindOpenTransactionsRepo{
+					result: []domain.Transaction{
+						domain.NewTransaction(
+							"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+							"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+							opCompraAVista,
+							5000,
+							-5000,
+							time.Time{},
+						),
+					},
+					err: nil,
+				},
+				repoBalanceUpdater: stubUpdateTransactionBalanceRepo{err: errors.New("db_error")},
+				repoAccountLocker: stubFindUserByRepo{
+					result: domain.NewStoredAccount(
+						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						"12345678900",
+						10025,
+						time.Time{},
+					),
+					err: nil,
+				},
+				repoAccountUpdater: stubUpdateCreditLimitRepo{err: nil},
+				pre:                stubCreateTransactionPresenter{},
+				ctxTimeout:         time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: CreateTransactionInput{
+					AccountID:   "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					OperationID: domain.Pagamento,
+					Amount:      10025,
+				},
+			},
+			want: CreateTransactionOutput{
+				CreatedAt: time.Time{}.String(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			interactor := NewCreateTransactionInteractor(
+				stubUnitOfWork{},
 				tt.fields.repo,
-				tt.fields.repoAccountFinder,
+				tt.fields.repoBalanceFinder,
+				tt.fields.repoBalanceUpdater,
+				tt.fields.repoAccountLocker,
 				tt.fields.repoAccountUpdater,
+				stubFindOperationRepo{},
+				spyCreateOutboxEventRepo{},
 				tt.fields.pre,
+				NewNoopTransactionMetrics(),
 				tt.fields.ctxTimeout,
 			)
 
@@ -321,3 +568,299 @@ func Test_createTransactionInteractor_Execute(t *testing.T) {
 		})
 	}
 }
+
+func Test_createTransactionInteractor_Execute_Rejected(t *testing.T) {
+	tests := []struct {
+		name       string
+		outboxErr  error
+		wantErr    error
+		wantEvents []string
+	}{
+		{
+			name:       "Rejection recorded in the outbox",
+			wantErr:    domain.ErrAccountInsufficientCreditLimit,
+			wantEvents: []string{domain.EventTransactionRejected},
+		},
+		{
+			name:       "Error recording the rejection",
+			outboxErr:  errDB,
+			wantErr:    errDB,
+			wantEvents: []string{domain.EventTransactionRejected},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				created []domain.Event
+				uc      = NewCreateTransactionInteractor(
+					stubUnitOfWork{},
+					stubCreateTransactionRepo{},
+					stubFindOpenTransactionsRepo{},
+					stubUpdateTransactionBalanceRepo{},
+					stubFindUserByRepo{result: domain.NewStoredAccount("1", "12345678900", 100, time.Time{})},
+					stubUpdateCreditLimitRepo{},
+					stubFindOperationRepo{},
+					spyCreateOutboxEventRepo{created: &created, err: tt.outboxErr},
+					stubCreateTransactionPresenter{},
+					NewNoopTransactionMetrics(),
+					time.Second,
+				)
+			)
+
+			_, err := uc.Execute(context.Background(), CreateTransactionInput{
+				AccountID:   "1",
+				OperationID: domain.CompraAVista,
+				Amount:      101,
+			})
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			var got = make([]string, 0)
+			for _, event := range created {
+				got = append(got, event.Type())
+			}
+
+			if !reflect.DeepEqual(got, tt.wantEvents) {
+				t.Errorf("[TestCase '%s'] Got events: '%v' | Want: '%v'", tt.name, got, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func Test_createTransactionInteractor_Execute_Metrics(t *testing.T) {
+	pagamento, _ := domain.NewOperation(domain.Pagamento)
+
+	tests := []struct {
+		name        string
+		operationID string
+		amount      int64
+		opRepo      stubFindOperationRepo
+		repo        stubCreateTransactionRepo
+		want        []string
+	}{
+		{
+			name:        "Transaction created",
+			operationID: domain.Pagamento,
+			amount:      100,
+			repo: stubCreateTransactionRepo{
+				result: domain.NewTransaction("1", "1", pagamento, 100, 100, time.Time{}),
+			},
+			want: []string{"created " + domain.Pagamento},
+		},
+		{
+			name:        "Transaction declined for insufficient credit limit",
+			operationID: domain.CompraAVista,
+			amount:      101,
+			want:        []string{fmt.Sprintf("declined %s 101 %v", domain.CompraAVista, domain.ErrAccountInsufficientCreditLimit)},
+		},
+		{
+			name:        "Transaction declined for disabled operation",
+			operationID: domain.Pagamento,
+			amount:      100,
+			opRepo:      stubFindOperationRepo{disabled: true},
+			want:        []string{fmt.Sprintf("declined  100 %v", domain.ErrOperationDisabled)},
+		},
+		{
+			name:        "Transaction failed",
+			operationID: domain.Pagamento,
+			amount:      100,
+			repo:        stubCreateTransactionRepo{err: errDB},
+			want:        []string{fmt.Sprintf("failed %s %v", domain.Pagamento, errDB)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got = make([]string, 0)
+				uc  = NewCreateTransactionInteractor(
+					stubUnitOfWork{},
+					tt.repo,
+					stubFindOpenTransactionsRepo{},
+					stubUpdateTransactionBalanceRepo{},
+					stubFindUserByRepo{result: domain.NewStoredAccount("1", "12345678900", 100, time.Time{})},
+					stubUpdateCreditLimitRepo{},
+					tt.opRepo,
+					spyCreateOutboxEventRepo{},
+					stubCreateTransactionPresenter{},
+					spyTransactionMetrics{outcomes: &got},
+					time.Second,
+				)
+			)
+
+			_, _ = uc.Execute(context.Background(), CreateTransactionInput{
+				AccountID:   "1",
+				OperationID: tt.operationID,
+				Amount:      tt.amount,
+			})
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+type txLocksKey struct{}
+
+// lockingAccountStore emulates the row locks of SELECT ... FOR UPDATE, a locked
+// account stays locked until the database transaction that locked it ends
+type lockingAccountStore struct {
+	mu    *sync.Mutex
+	row   *sync.Mutex
+	limit *int64
+}
+
+func (l lockingAccountStore) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	var held []*sync.Mutex
+	defer func() {
+		for _, lock := range held {
+			lock.Unlock()
+		}
+	}()
+
+	return fn(context.WithValue(ctx, txLocksKey{}, &held))
+}
+
+func (l lockingAccountStore) Create(_ context.Context, transaction domain.Transaction) (domain.Transaction, error) {
+	return transaction, nil
+}
+
+func (l lockingAccountStore) LockByID(ctx context.Context, ID string) (domain.Account, error) {
+	l.row.Lock()
+	held := ctx.Value(txLocksKey{}).(*[]*sync.Mutex)
+	*held = append(*held, l.row)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return domain.NewStoredAccount(ID, "12345678900", *l.limit, time.Time{}), nil
+}
+
+func (l lockingAccountStore) UpdateCreditLimit(_ context.Context, _ string, limit int64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	*l.limit = limit
+	return nil
+}
+
+func Test_createTransactionInteractor_Execute_ConcurrentDebits(t *testing.T) {
+	const (
+		initialLimit = 1000
+		debits       = 50
+		amount       = 100
+	)
+
+	var (
+		limit = int64(initialLimit)
+		store = lockingAccountStore{mu: &sync.Mutex{}, row: &sync.Mutex{}, limit: &limit}
+		uc    = NewCreateTransactionInteractor(
+			store,
+			store,
+			stubFindOpenTransactionsRepo{},
+			stubUpdateTransactionBalanceRepo{},
+			store,
+			store,
+			stubFindOperationRepo{},
+			spyCreateOutboxEventRepo{},
+			stubCreateTransactionPresenter{},
+			NewNoopTransactionMetrics(),
+			time.Second,
+		)
+		wg        sync.WaitGroup
+		succeeded int64
+		rejected  int64
+	)
+
+	for i := 0; i < debits; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := uc.Execute(context.Background(), CreateTransactionInput{
+				AccountID:   "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				OperationID: domain.CompraAVista,
+				Amount:      amount,
+			})
+			switch err {
+			case nil:
+				atomic.AddInt64(&succeeded, 1)
+			case domain.ErrAccountInsufficientCreditLimit:
+				atomic.AddInt64(&rejected, 1)
+			default:
+				t.Errorf("[TestCase '%s'] Err: '%v'", "Concurrent debits", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if limit < 0 {
+		t.Errorf("[TestCase '%s'] Got limit: '%v' | Want at least: '%v'", "Concurrent debits", limit, 0)
+	}
+
+	if succeeded != initialLimit/amount || limit != initialLimit-succeeded*amount {
+		t.Errorf(
+			"[TestCase '%s'] Got succeeded: '%v' limit: '%v' | Want succeeded: '%v' limit: '%v'",
+			"Concurrent debits",
+			succeeded,
+			limit,
+			initialLimit/amount,
+			0,
+		)
+	}
+
+	if rejected != debits-initialLimit/amount {
+		t.Errorf("[TestCase '%s'] Got rejected: '%v' | Want: '%v'", "Concurrent debits", rejected, debits-initialLimit/amount)
+	}
+}
+
+func Test_createTransactionInteractor_Execute_Installments(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            CreateTransactionInput
+		wantInstallments int
+	}{
+		{
+			name:             "Compra parcelada without installments, as requested before the installment plans",
+			input:            CreateTransactionInput{AccountID: "1", OperationID: domain.CompraParcelada, Amount: 300},
+			wantInstallments: 0,
+		},
+		{
+			name:             "Compra parcelada split in installments",
+			input:            CreateTransactionInput{AccountID: "1", OperationID: domain.CompraParcelada, Amount: 300, Installments: 3},
+			wantInstallments: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				created domain.Transaction
+				uc      = NewCreateTransactionInteractor(
+					stubUnitOfWork{},
+					spyCreatedTransactionRepo{created: &created},
+					stubFindOpenTransactionsRepo{},
+					stubUpdateTransactionBalanceRepo{},
+					stubFindUserByRepo{result: domain.NewStoredAccount("1", "12345678909", 1000, time.Time{})},
+					stubUpdateCreditLimitRepo{},
+					stubFindOperationRepo{},
+					spyCreateOutboxEventRepo{},
+					stubCreateTransactionPresenter{},
+					NewNoopTransactionMetrics(),
+					time.Second,
+				)
+			)
+
+			if _, err := uc.Execute(context.Background(), tt.input); err != nil {
+				t.Fatalf("[TestCase '%s'] Err: '%v'", tt.name, err)
+			}
+
+			if created.Amount() != -300 {
+				t.Errorf("[TestCase '%s'] Got amount: '%v' | Want: '%v'", tt.name, created.Amount(), -300)
+			}
+
+			if got := len(created.InstallmentPlan().Installments()); got != tt.wantInstallments {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.wantInstallments)
+			}
+		})
+	}
+}
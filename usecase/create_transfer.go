@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+type (
+	// Input port
+	CreateTransferUseCase interface {
+		Execute(context.Context, CreateTransferInput) (CreateTransferOutput, error)
+	}
+
+	// Input data
+	CreateTransferInput struct {
+		PayerID string `json:"payer_id" validate:"required"`
+		PayeeID string `json:"payee_id" validate:"required"`
+		Amount  int64  `json:"amount" validate:"required,gt=0"`
+	}
+
+	// Output port
+	CreateTransferPresenter interface {
+		Output(domain.Transfer) CreateTransferOutput
+	}
+
+	// Output data
+	CreateTransferOutput struct {
+		ID                  string `json:"id"`
+		PayerID             string `json:"payer_id"`
+		PayeeID             string `json:"payee_id"`
+		Amount              int64  `json:"amount"`
+		DebitTransactionID  string `json:"debit_transaction_id"`
+		CreditTransactionID string `json:"credit_transaction_id"`
+		CreatedAt           string `json:"created_at"`
+	}
+
+	createTransferInteractor struct {
+		uow                    domain.UnitOfWork
+		repoTransferCreator    domain.TransferCreator
+		repoTransactionCreator domain.TransactionCreator
+		repoBalanceFinder      domain.TransactionBalanceFinder
+		repoBalanceUpdater     domain.TransactionBalanceUpdater
+		repoAccountLocker      domain.AccountLocker
+		repoAccountUpdater     domain.AccountUpdater
+		pre                    CreateTransferPresenter
+		metrics                TransactionMetrics
+		ctxTimeout             time.Duration
+	}
+)
+
+// NewCreateTransferInteractor creates new createTransferInteractor with its dependencies
+func NewCreateTransferInteractor(
+	uow domain.UnitOfWork,
+	repoTransferCreator domain.TransferCreator,
+	repoTransactionCreator domain.TransactionCreator,
+	repoBalanceFinder domain.TransactionBalanceFinder,
+	repoBalanceUpdater domain.TransactionBalanceUpdater,
+	repoAccountLocker domain.AccountLocker,
+	repoAccountUpdater domain.AccountUpdater,
+	pre CreateTransferPresenter,
+	metrics TransactionMetrics,
+	ctxTimeout time.Duration,
+) CreateTransferUseCase {
+	return createTransferInteractor{
+		uow:                    uow,
+		repoTransferCreator:    repoTransferCreator,
+		repoTransactionCreator: repoTransactionCreator,
+		repoBalanceFinder:      repoBalanceFinder,
+		repoBalanceUpdater:     repoBalanceUpdater,
+		repoAccountLocker:      repoAccountLocker,
+		repoAccountUpdater:     repoAccountUpdater,
+		pre:                    pre,
+		metrics:                metrics,
+		ctxTimeout:             ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case, recording its outcome in the metrics under the operation of the debit
+func (c createTransferInteractor) Execute(ctx context.Context, i CreateTransferInput) (CreateTransferOutput, error) {
+	ctx, span := startSpan(ctx, "CreateTransfer", i.PayerID)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	transfer, err := c.execute(ctx, i)
+	switch {
+	case err == nil:
+		c.metrics.TransactionCreated(transfer.Debit())
+		c.metrics.TransactionCreated(transfer.Credit())
+		return c.pre.Output(transfer), nil
+	case transactionDeclined(err):
+		c.metrics.TransactionDeclined(domain.TransferenciaEnviada, i.Amount, err)
+	default:
+		c.metrics.TransactionFailed(domain.TransferenciaEnviada, err)
+	}
+
+	return c.pre.Output(domain.Transfer{}), err
+}
+
+func (c createTransferInteractor) execute(ctx context.Context, i CreateTransferInput) (domain.Transfer, error) {
+	if i.PayerID == i.PayeeID {
+		return domain.Transfer{}, domain.ErrTransferSameAccount
+	}
+
+	var (
+		transfer domain.Transfer
+		err      error
+	)
+
+	err = c.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		accounts, err := c.lockAccounts(ctxTx, i.PayerID, i.PayeeID)
+		if err != nil {
+			return err
+		}
+
+		var payer, payee = accounts[i.PayerID], accounts[i.PayeeID]
+		transfer, err = domain.NewTransfer(
+			uuid.New().String(),
+			&payer,
+			&payee,
+			uuid.New().String(),
+			uuid.New().String(),
+			i.Amount,
+			time.Now(),
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range []domain.Account{payer, payee} {
+			if err = c.repoAccountUpdater.UpdateCreditLimit(ctxTx, account.ID(), account.AvailableCreditLimit()); err != nil {
+				return err
+			}
+		}
+
+		debit, err := c.settleAndCreate(ctxTx, transfer.Debit())
+		if err != nil {
+			return err
+		}
+
+		credit, err := c.settleAndCreate(ctxTx, transfer.Credit())
+		if err != nil {
+			return err
+		}
+
+		transfer, err = c.repoTransferCreator.Create(ctxTx, transfer.WithTransactions(debit, credit))
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// lockAccounts locks the accounts always in the same order, so that two opposite
+// transfers between the same accounts cannot deadlock each other
+func (c createTransferInteractor) lockAccounts(ctx context.Context, IDs ...string) (map[string]domain.Account, error) {
+	sort.Strings(IDs)
+
+	var accounts = make(map[string]domain.Account, len(IDs))
+	for _, ID := range IDs {
+		account, err := c.repoAccountLocker.LockByID(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		accounts[ID] = account
+	}
+
+	return accounts, nil
+}
+
+func (c createTransferInteractor) settleAndCreate(ctx context.Context, transaction domain.Transaction) (domain.Transaction, error) {
+	open, err := c.repoBalanceFinder.FindOpenByAccountID(ctx, transaction.AccountID())
+	if err != nil {
+		return domain.Transaction{}, err
+	}
+
+	for _, settled := range transaction.Settle(open) {
+		if err = c.repoBalanceUpdater.UpdateBalance(ctx, settled.ID(), settled.Balance()); err != nil {
+			return domain.Transaction{}, err
+		}
+	}
+
+	return c.repoTransactionCreator.Create(ctx, transaction)
+}
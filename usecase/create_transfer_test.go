@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type spyLockAccountRepo struct {
+	accounts map[string]domain.Account
+	locked   *[]string
+}
+
+func (s spyLockAccountRepo) LockByID(_ context.Context, ID string) (domain.Account, error) {
+	*s.locked = append(*s.locked, ID)
+
+	account, ok := s.accounts[ID]
+	if !ok {
+		return domain.Account{}, domain.ErrAccountNotFound
+	}
+
+	return account, nil
+}
+
+type spyUpdateCreditLimitRepo struct {
+	limits map[string]int64
+}
+
+func (s spyUpdateCreditLimitRepo) UpdateCreditLimit(_ context.Context, ID string, limit int64) error {
+	s.limits[ID] = limit
+	return nil
+}
+
+type stubCreateTransferRepo struct {
+	err error
+}
+
+func (s stubCreateTransferRepo) Create(_ context.Context, transfer domain.Transfer) (domain.Transfer, error) {
+	return transfer, s.err
+}
+
+type stubCreateTransferPresenter struct{}
+
+func (s stubCreateTransferPresenter) Output(transfer domain.Transfer) CreateTransferOutput {
+	return CreateTransferOutput{
+		PayerID: transfer.PayerID(),
+		PayeeID: transfer.PayeeID(),
+		Amount:  transfer.Amount(),
+	}
+}
+
+func Test_createTransferInteractor_Execute(t *testing.T) {
+	var accounts = map[string]domain.Account{
+		"a": domain.NewStoredAccount("a", "12345678900", 1000, time.Time{}),
+		"b": domain.NewStoredAccount("b", "98765432100", 500, time.Time{}),
+	}
+
+	tests := []struct {
+		name        string
+		repo        domain.TransferCreator
+		input       CreateTransferInput
+		want        CreateTransferOutput
+		wantLocked  []string
+		wantLimits  map[string]int64
+		wantMetrics []string
+		wantErr     error
+	}{
+		{
+			name:       "Transfer from the lower to the higher account id",
+			repo:       stubCreateTransferRepo{},
+			input:      CreateTransferInput{PayerID: "a", PayeeID: "b", Amount: 300},
+			want:       CreateTransferOutput{PayerID: "a", PayeeID: "b", Amount: 300},
+			wantLocked: []string{"a", "b"},
+			wantLimits: map[string]int64{"a": 700, "b": 800},
+			wantMetrics: []string{
+				"created " + domain.TransferenciaEnviada,
+				"created " + domain.TransferenciaRecebida,
+			},
+		},
+		{
+			name:       "Transfer from the higher to the lower account id locks in the same order",
+			repo:       stubCreateTransferRepo{},
+			input:      CreateTransferInput{PayerID: "b", PayeeID: "a", Amount: 500},
+			want:       CreateTransferOutput{PayerID: "b", PayeeID: "a", Amount: 500},
+			wantLocked: []string{"a", "b"},
+			wantLimits: map[string]int64{"a": 1500, "b": 0},
+			wantMetrics: []string{
+				"created " + domain.TransferenciaEnviada,
+				"created " + domain.TransferenciaRecebida,
+			},
+		},
+		{
+			name:       "Error transfer exceeds the credit limit of the payer",
+			repo:       stubCreateTransferRepo{},
+			input:      CreateTransferInput{PayerID: "b", PayeeID: "a", Amount: 501},
+			wantLocked: []string{"a", "b"},
+			wantLimits: map[string]int64{},
+			wantMetrics: []string{
+				fmt.Sprintf("declined %s 501 %v", domain.TransferenciaEnviada, domain.ErrAccountInsufficientCreditLimit),
+			},
+			wantErr: domain.ErrAccountInsufficientCreditLimit,
+		},
+		{
+			name:       "Error transfer to the same account",
+			repo:       stubCreateTransferRepo{},
+			input:      CreateTransferInput{PayerID: "a", PayeeID: "a", Amount: 100},
+			wantLimits: map[string]int64{},
+			wantMetrics: []string{
+				fmt.Sprintf("declined %s 100 %v", domain.TransferenciaEnviada, domain.ErrTransferSameAccount),
+			},
+			wantErr: domain.ErrTransferSameAccount,
+		},
+		{
+			name:        "Error payee not found",
+			repo:        stubCreateTransferRepo{},
+			input:       CreateTransferInput{PayerID: "a", PayeeID: "c", Amount: 100},
+			wantLocked:  []string{"a", "c"},
+			wantLimits:  map[string]int64{},
+			wantMetrics: []string{fmt.Sprintf("failed %s %v", domain.TransferenciaEnviada, domain.ErrAccountNotFound)},
+			wantErr:     domain.ErrAccountNotFound,
+		},
+		{
+			name:        "Error creating transfer in database",
+			repo:        stubCreateTransferRepo{err: errDB},
+			input:       CreateTransferInput{PayerID: "a", PayeeID: "b", Amount: 300},
+			wantLocked:  []string{"a", "b"},
+			wantLimits:  map[string]int64{"a": 700, "b": 800},
+			wantMetrics: []string{fmt.Sprintf("failed %s %v", domain.TransferenciaEnviada, errDB)},
+			wantErr:     errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				locked  []string
+				limits  = map[string]int64{}
+				metrics = make([]string, 0)
+				useCase = NewCreateTransferInteractor(
+					stubUnitOfWork{},
+					tt.repo,
+					spyCreateTransactionRepo{},
+					stubFindOpenTransactionsRepo{},
+					stubUpdateTransactionBalanceRepo{},
+					spyLockAccountRepo{accounts: accounts, locked: &locked},
+					spyUpdateCreditLimitRepo{limits: limits},
+					stubCreateTransferPresenter{},
+					spyTransactionMetrics{outcomes: &metrics},
+					time.Second,
+				)
+			)
+
+			got, err := useCase.Execute(context.Background(), tt.input)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(locked, tt.wantLocked) {
+				t.Errorf("[TestCase '%s'] Got locked: '%v' | Want: '%v'", tt.name, locked, tt.wantLocked)
+			}
+
+			if !reflect.DeepEqual(limits, tt.wantLimits) {
+				t.Errorf("[TestCase '%s'] Got limits: '%v' | Want: '%v'", tt.name, limits, tt.wantLimits)
+			}
+
+			if !reflect.DeepEqual(metrics, tt.wantMetrics) {
+				t.Errorf("[TestCase '%s'] Got metrics: '%v' | Want: '%v'", tt.name, metrics, tt.wantMetrics)
+			}
+		})
+	}
+}
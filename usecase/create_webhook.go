@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// webhookSecretSize defines how many random bytes make the secret generated for a webhook
+	webhookSecretSize = 32
+)
+
+type (
+	// Input port
+	CreateWebhookUseCase interface {
+		Execute(context.Context, CreateWebhookInput) (CreateWebhookOutput, error)
+	}
+
+	// Input data
+	CreateWebhookInput struct {
+		AccountID string   `json:"account_id" validate:"omitempty,max=36"`
+		URL       string   `json:"url" validate:"required,url,max=2048"`
+		Events    []string `json:"events" validate:"required,min=1"`
+		Secret    string   `json:"secret" validate:"omitempty,min=16,max=128"`
+	}
+
+	// Output port
+	CreateWebhookPresenter interface {
+		Output(domain.Webhook) CreateWebhookOutput
+	}
+
+	// Output data
+	CreateWebhookOutput struct {
+		ID        string   `json:"id"`
+		AccountID string   `json:"account_id,omitempty"`
+		URL       string   `json:"url"`
+		Secret    string   `json:"secret"`
+		Events    []string `json:"events"`
+		Active    bool     `json:"active"`
+		CreatedAt string   `json:"created_at"`
+	}
+
+	createWebhookInteractor struct {
+		repoWebhookCreator domain.WebhookCreator
+		repoAccountFinder  domain.AccountFinder
+		pre                CreateWebhookPresenter
+		clock              Clock
+		ctxTimeout         time.Duration
+	}
+)
+
+// NewCreateWebhookInteractor creates new createWebhookInteractor with its dependencies
+func NewCreateWebhookInteractor(
+	repoWebhookCreator domain.WebhookCreator,
+	repoAccountFinder domain.AccountFinder,
+	pre CreateWebhookPresenter,
+	clock Clock,
+	ctxTimeout time.Duration,
+) CreateWebhookUseCase {
+	return createWebhookInteractor{
+		repoWebhookCreator: repoWebhookCreator,
+		repoAccountFinder:  repoAccountFinder,
+		pre:                pre,
+		clock:              clock,
+		ctxTimeout:         ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case. Without an account the webhook receives the events of every
+// account of the tenant, and without a secret a random one is generated and returned only in this response
+func (c createWebhookInteractor) Execute(ctx context.Context, i CreateWebhookInput) (CreateWebhookOutput, error) {
+	ctx, span := startSpan(ctx, "CreateWebhook", i.AccountID)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	// The webhook belongs to the tenant of the request and receives only the events of its accounts
+	tenantID := domain.TenantFrom(ctx)
+	if tenantID == "" {
+		return c.pre.Output(domain.Webhook{}), domain.ErrTenantMissing
+	}
+
+	if i.AccountID != "" {
+		if _, err := c.repoAccountFinder.FindByID(ctx, i.AccountID); err != nil {
+			return c.pre.Output(domain.Webhook{}), err
+		}
+	}
+
+	secret := i.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newWebhookSecret(); err != nil {
+			return c.pre.Output(domain.Webhook{}), err
+		}
+	}
+
+	webhook, err := domain.SubscribeWebhook(
+		uuid.New().String(),
+		i.AccountID,
+		i.URL,
+		secret,
+		i.Events,
+		c.clock.Now(),
+	)
+	if err != nil {
+		return c.pre.Output(domain.Webhook{}), err
+	}
+
+	webhook, err = c.repoWebhookCreator.Create(ctx, webhook.WithTenant(tenantID))
+	if err != nil {
+		return c.pre.Output(domain.Webhook{}), err
+	}
+
+	return c.pre.Output(webhook), nil
+}
+
+func newWebhookSecret() (string, error) {
+	var b = make([]byte, webhookSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubCreateWebhookRepo struct {
+	err error
+}
+
+func (s stubCreateWebhookRepo) Create(_ context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	return webhook, s.err
+}
+
+type stubCreateWebhookPresenter struct{}
+
+func (s stubCreateWebhookPresenter) Output(webhook domain.Webhook) CreateWebhookOutput {
+	return CreateWebhookOutput{
+		AccountID: webhook.AccountID(),
+		URL:       webhook.URL(),
+		Secret:    webhook.Secret(),
+		Events:    webhook.Events(),
+		Active:    webhook.Active(),
+	}
+}
+
+func Test_createWebhookInteractor_Execute(t *testing.T) {
+	var account = domain.NewStoredAccount("1", "12345678900", 1000, time.Time{})
+
+	type fields struct {
+		repoWebhookCreator domain.WebhookCreator
+		repoAccountFinder  domain.AccountFinder
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		withoutTenant bool
+		input         CreateWebhookInput
+		want          CreateWebhookOutput
+		wantSecret    bool
+		wantErr       error
+	}{
+		{
+			name: "Create webhook of the account",
+			fields: fields{
+				repoWebhookCreator: stubCreateWebhookRepo{},
+				repoAccountFinder:  stubFindUserByRepo{result: account},
+			},
+			input: CreateWebhookInput{
+				AccountID: "1",
+				URL:       "https://partner.example.com/hooks",
+				Events:    []string{domain.EventTransactionCreated},
+				Secret:    "0123456789abcdef",
+			},
+			want: CreateWebhookOutput{
+				AccountID: "1",
+				URL:       "https://partner.example.com/hooks",
+				Secret:    "0123456789abcdef",
+				Events:    []string{domain.EventTransactionCreated},
+				Active:    true,
+			},
+		},
+		{
+			name: "Create global webhook with a generated secret",
+			fields: fields{
+				repoWebhookCreator: stubCreateWebhookRepo{},
+				repoAccountFinder:  stubFindUserByRepo{err: domain.ErrAccountNotFound},
+			},
+			input: CreateWebhookInput{
+				URL:    "https://partner.example.com/hooks",
+				Events: []string{domain.EventTransactionRejected},
+			},
+			want: CreateWebhookOutput{
+				URL:    "https://partner.example.com/hooks",
+				Events: []string{domain.EventTransactionRejected},
+				Active: true,
+			},
+			wantSecret: true,
+		},
+		{
+			name: "Error account not found",
+			fields: fields{
+				repoWebhookCreator: stubCreateWebhookRepo{},
+				repoAccountFinder:  stubFindUserByRepo{err: domain.ErrAccountNotFound},
+			},
+			input: CreateWebhookInput{
+				AccountID: "1",
+				URL:       "https://partner.example.com/hooks",
+				Events:    []string{domain.EventTransactionCreated},
+			},
+			wantErr: domain.ErrAccountNotFound,
+		},
+		{
+			name: "Error event not supported",
+			fields: fields{
+				repoWebhookCreator: stubCreateWebhookRepo{},
+				repoAccountFinder:  stubFindUserByRepo{result: account},
+			},
+			input: CreateWebhookInput{
+				URL:    "https://partner.example.com/hooks",
+				Events: []string{domain.EventAccountCreated},
+			},
+			wantErr: domain.ErrWebhookEventInvalid,
+		},
+		{
+			name: "Error creating webhook in database",
+			fields: fields{
+				repoWebhookCreator: stubCreateWebhookRepo{err: errDB},
+				repoAccountFinder:  stubFindUserByRepo{result: account},
+			},
+			input: CreateWebhookInput{
+				URL:    "https://partner.example.com/hooks",
+				Events: []string{domain.EventTransactionCreated},
+			},
+			wantErr: errDB,
+		},
+		{
+			name: "Error creating webhook without tenant",
+			fields: fields{
+				repoWebhookCreator: stubCreateWebhookRepo{},
+				repoAccountFinder:  stubFindUserByRepo{result: account},
+			},
+			withoutTenant: true,
+			input: CreateWebhookInput{
+				URL:    "https://partner.example.com/hooks",
+				Events: []string{domain.EventTransactionCreated},
+			},
+			wantErr: domain.ErrTenantMissing,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewCreateWebhookInteractor(
+				tt.fields.repoWebhookCreator,
+				tt.fields.repoAccountFinder,
+				stubCreateWebhookPresenter{},
+				stubClock{},
+				time.Second,
+			)
+
+			var ctx = domain.WithTenant(context.Background(), "tenant-a")
+			if tt.withoutTenant {
+				ctx = context.Background()
+			}
+
+			got, err := uc.Execute(ctx, tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if tt.wantSecret {
+				if len(got.Secret) != 2*webhookSecretSize {
+					t.Errorf("[TestCase '%s'] Got secret: '%v' | Want length: '%v'", tt.name, got.Secret, 2*webhookSecretSize)
+				}
+				got.Secret = ""
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
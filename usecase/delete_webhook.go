@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	DeleteWebhookUseCase interface {
+		Execute(context.Context, DeleteWebhookInput) error
+	}
+
+	// Input data
+	DeleteWebhookInput struct {
+		ID string
+	}
+
+	deleteWebhookInteractor struct {
+		repo       domain.WebhookDeleter
+		ctxTimeout time.Duration
+	}
+)
+
+// NewDeleteWebhookInteractor creates new deleteWebhookInteractor with its dependencies
+func NewDeleteWebhookInteractor(repo domain.WebhookDeleter, ctxTimeout time.Duration) DeleteWebhookUseCase {
+	return deleteWebhookInteractor{
+		repo:       repo,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case. The deliveries of the webhook are deleted with it
+func (d deleteWebhookInteractor) Execute(ctx context.Context, i DeleteWebhookInput) error {
+	ctx, span := startSpan(ctx, "DeleteWebhook", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	return d.repo.Delete(ctx, i.ID)
+}
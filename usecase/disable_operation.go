@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	DisableOperationUseCase interface {
+		Execute(context.Context, DisableOperationInput) (DisableOperationOutput, error)
+	}
+
+	// Input data
+	DisableOperationInput struct {
+		ID string
+	}
+
+	// Output port
+	DisableOperationPresenter interface {
+		Output(domain.Operation) DisableOperationOutput
+	}
+
+	// Output data
+	DisableOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+		Enabled     bool   `json:"enabled"`
+	}
+
+	disableOperationInteractor struct {
+		repoFinder  domain.OperationFinder
+		repoUpdater domain.OperationUpdater
+		pre         DisableOperationPresenter
+		ctxTimeout  time.Duration
+	}
+)
+
+// NewDisableOperationInteractor creates new disableOperationInteractor with its dependencies
+func NewDisableOperationInteractor(
+	repoFinder domain.OperationFinder,
+	repoUpdater domain.OperationUpdater,
+	pre DisableOperationPresenter,
+	ctxTimeout time.Duration,
+) DisableOperationUseCase {
+	return disableOperationInteractor{
+		repoFinder:  repoFinder,
+		repoUpdater: repoUpdater,
+		pre:         pre,
+		ctxTimeout:  ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (d disableOperationInteractor) Execute(ctx context.Context, i DisableOperationInput) (DisableOperationOutput, error) {
+	ctx, span := startSpan(ctx, "DisableOperation", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	operation, err := d.repoFinder.FindByID(ctx, i.ID)
+	if err != nil {
+		return d.pre.Output(domain.Operation{}), err
+	}
+
+	if err = operation.Disable(); err != nil {
+		return d.pre.Output(domain.Operation{}), err
+	}
+
+	if err = d.repoUpdater.Update(ctx, operation); err != nil {
+		return d.pre.Output(domain.Operation{}), err
+	}
+
+	return d.pre.Output(operation), nil
+}
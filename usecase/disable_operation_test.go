@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type spyUpdateOperationRepo struct {
+	updated *domain.Operation
+	err     error
+}
+
+func (s spyUpdateOperationRepo) Update(_ context.Context, operation domain.Operation) error {
+	*s.updated = operation
+	return s.err
+}
+
+type stubDisableOperationPresenter struct{}
+
+func (s stubDisableOperationPresenter) Output(operation domain.Operation) DisableOperationOutput {
+	return DisableOperationOutput{
+		ID:          operation.ID(),
+		Description: operation.Description(),
+		Type:        operation.Type(),
+		Enabled:     operation.Enabled(),
+	}
+}
+
+func Test_disableOperationInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		repoFinder domain.OperationFinder
+		updateErr  error
+		ID         string
+		want       DisableOperationOutput
+		wantErr    error
+	}{
+		{
+			name:       "Disable operation",
+			repoFinder: stubFindOperationRepo{},
+			ID:         domain.Saque,
+			want:       DisableOperationOutput{ID: domain.Saque, Description: "SAQUE", Type: domain.Debit, Enabled: false},
+		},
+		{
+			name:       "Error operation not found",
+			repoFinder: stubFindOperationRepo{},
+			ID:         "123",
+			wantErr:    domain.ErrOperationNotFound,
+		},
+		{
+			name:       "Error operation used by internal flows",
+			repoFinder: stubFindOperationRepo{},
+			ID:         domain.Estorno,
+			wantErr:    domain.ErrOperationInternal,
+		},
+		{
+			name:       "Error updating operation in database",
+			repoFinder: stubFindOperationRepo{},
+			updateErr:  errDB,
+			ID:         domain.Saque,
+			wantErr:    errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				updated domain.Operation
+				uc      = NewDisableOperationInteractor(
+					tt.repoFinder,
+					spyUpdateOperationRepo{updated: &updated, err: tt.updateErr},
+					stubDisableOperationPresenter{},
+					time.Second,
+				)
+			)
+
+			got, err := uc.Execute(context.Background(), DisableOperationInput{ID: tt.ID})
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if err == nil && updated.Enabled() {
+				t.Errorf("[TestCase '%s'] Got updated: '%+v' | Want disabled", tt.name, updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	DispatchWebhooksUseCase interface {
+		Execute(context.Context) (int, error)
+	}
+
+	dispatchWebhooksInteractor struct {
+		uow                 domain.UnitOfWork
+		repoDeliveryFinder  domain.WebhookDeliveryDueFinder
+		repoDeliveryUpdater domain.WebhookDeliveryUpdater
+		repoWebhookFinder   domain.WebhookFinder
+		sender              domain.WebhookSender
+		clock               Clock
+		batchSize           int
+		ctxTimeout          time.Duration
+	}
+)
+
+// NewDispatchWebhooksInteractor creates new dispatchWebhooksInteractor with its dependencies
+func NewDispatchWebhooksInteractor(
+	uow domain.UnitOfWork,
+	repoDeliveryFinder domain.WebhookDeliveryDueFinder,
+	repoDeliveryUpdater domain.WebhookDeliveryUpdater,
+	repoWebhookFinder domain.WebhookFinder,
+	sender domain.WebhookSender,
+	clock Clock,
+	batchSize int,
+	ctxTimeout time.Duration,
+) DispatchWebhooksUseCase {
+	return dispatchWebhooksInteractor{
+		uow:                 uow,
+		repoDeliveryFinder:  repoDeliveryFinder,
+		repoDeliveryUpdater: repoDeliveryUpdater,
+		repoWebhookFinder:   repoWebhookFinder,
+		sender:              sender,
+		clock:               clock,
+		batchSize:           batchSize,
+		ctxTimeout:          ctxTimeout,
+	}
+}
+
+// Execute attempts a batch of due deliveries and returns how many were delivered. The batch is claimed
+// in a short unit of work, leasing the deliveries for the timeout of the batch, and the receivers are
+// called outside of any transaction. Every attempt is recorded in the delivery log in its own unit of
+// work; a failed one is retried later with exponential backoff until the delivery is dead. The first
+// failure is returned after the other attempts are made
+func (d dispatchWebhooksInteractor) Execute(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "DispatchWebhooks", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	deliveries, err := d.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		delivered int
+		errFirst  error
+		webhooks  = make(map[string]domain.Webhook)
+	)
+
+	for _, delivery := range deliveries {
+		// The deliveries left are due again when their lease ends
+		if err = ctx.Err(); err != nil {
+			return delivered, err
+		}
+
+		webhook, ok := webhooks[delivery.WebhookID()]
+		if !ok {
+			if webhook, err = d.repoWebhookFinder.FindByID(ctx, delivery.WebhookID()); err != nil {
+				if errFirst == nil {
+					errFirst = err
+				}
+				continue
+			}
+			webhooks[webhook.ID()] = webhook
+		}
+
+		if err = d.sender.Send(ctx, webhook, delivery); err != nil {
+			delivery.Fail(err, d.clock.Now())
+			if errFirst == nil {
+				errFirst = err
+			}
+		} else {
+			delivery.Succeed(d.clock.Now())
+			delivered++
+		}
+
+		err = d.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+			return d.repoDeliveryUpdater.Update(ctxTx, delivery)
+		})
+		if err != nil && errFirst == nil {
+			errFirst = err
+		}
+	}
+
+	return delivered, errFirst
+}
+
+// claim locks the due deliveries and postpones their next attempt to the end of the batch, so that the
+// locks are released before the receivers are called
+func (d dispatchWebhooksInteractor) claim(ctx context.Context) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+
+	err := d.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		var err error
+		deliveries, err = d.repoDeliveryFinder.FindDue(ctxTx, d.clock.Now(), d.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range deliveries {
+			deliveries[i].Claim(d.clock.Now().Add(d.ctxTimeout))
+			if err = d.repoDeliveryUpdater.Update(ctxTx, deliveries[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return []domain.WebhookDelivery{}, err
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubWebhookDeliveriesRepo struct {
+	due     []domain.WebhookDelivery
+	updated *[]domain.WebhookDelivery
+	err     error
+}
+
+func (s stubWebhookDeliveriesRepo) FindDue(_ context.Context, _ time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	if len(s.due) > limit {
+		return s.due[:limit], s.err
+	}
+
+	return s.due, s.err
+}
+
+func (s stubWebhookDeliveriesRepo) Update(_ context.Context, delivery domain.WebhookDelivery) error {
+	*s.updated = append(*s.updated, delivery)
+	return nil
+}
+
+type stubWebhookSender struct {
+	failures      map[string]error
+	inTransaction *bool
+}
+
+func (s stubWebhookSender) Send(ctx context.Context, _ domain.Webhook, delivery domain.WebhookDelivery) error {
+	if ctx.Value(transactionKey{}) != nil {
+		*s.inTransaction = true
+	}
+
+	return s.failures[delivery.ID()]
+}
+
+type transactionKey struct{}
+
+// spyUnitOfWork marks the context of the transactions and counts them
+type spyUnitOfWork struct {
+	transactions *int
+}
+
+func (s spyUnitOfWork) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	*s.transactions++
+	return fn(context.WithValue(ctx, transactionKey{}, true))
+}
+
+func Test_dispatchWebhooksInteractor_Execute(t *testing.T) {
+	var (
+		now        = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		errRefused = errors.New("connection refused")
+		webhook    = domain.NewWebhook("w", "", "https://partner.example.com", "secret", []string{domain.EventTransactionCreated}, true, now)
+		pending    = func(ID string, attempts int) domain.WebhookDelivery {
+			return domain.NewWebhookDelivery(ID, "w", ID, domain.EventTransactionCreated, nil, domain.WebhookDeliveryPending, attempts, "", now, time.Time{}, now)
+		}
+	)
+
+	tests := []struct {
+		name         string
+		due          []domain.WebhookDelivery
+		repoErr      error
+		webhookRepo  stubWebhookRepo
+		failures     map[string]error
+		batchSize    int
+		want         int
+		wantStatuses []string
+		wantTx       int
+		wantErr      error
+	}{
+		{
+			name:         "Deliver every due delivery",
+			due:          []domain.WebhookDelivery{pending("1", 0), pending("2", 3)},
+			webhookRepo:  stubWebhookRepo{webhook: webhook},
+			batchSize:    10,
+			want:         2,
+			wantStatuses: []string{domain.WebhookDeliveryDelivered, domain.WebhookDeliveryDelivered},
+			wantTx:       3,
+		},
+		{
+			name:         "Deliver up to the batch size",
+			due:          []domain.WebhookDelivery{pending("1", 0), pending("2", 0)},
+			webhookRepo:  stubWebhookRepo{webhook: webhook},
+			batchSize:    1,
+			want:         1,
+			wantStatuses: []string{domain.WebhookDeliveryDelivered},
+			wantTx:       2,
+		},
+		{
+			name:         "Record failures and keep delivering",
+			due:          []domain.WebhookDelivery{pending("1", 0), pending("2", domain.WebhookMaxAttempts-1), pending("3", 0)},
+			webhookRepo:  stubWebhookRepo{webhook: webhook},
+			failures:     map[string]error{"1": errRefused, "2": errRefused},
+			batchSize:    10,
+			want:         1,
+			wantStatuses: []string{domain.WebhookDeliveryPending, domain.WebhookDeliveryDead, domain.WebhookDeliveryDelivered},
+			wantTx:       4,
+			wantErr:      errRefused,
+		},
+		{
+			name:         "Error finding due deliveries",
+			repoErr:      errDB,
+			webhookRepo:  stubWebhookRepo{webhook: webhook},
+			batchSize:    10,
+			wantStatuses: []string{},
+			wantTx:       1,
+			wantErr:      errDB,
+		},
+		{
+			name:         "Skip deliveries of a webhook not found",
+			due:          []domain.WebhookDelivery{pending("1", 0)},
+			webhookRepo:  stubWebhookRepo{},
+			batchSize:    10,
+			wantStatuses: []string{},
+			wantTx:       1,
+			wantErr:      domain.ErrWebhookNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				updated       []domain.WebhookDelivery
+				transactions  int
+				inTransaction bool
+				repo          = stubWebhookDeliveriesRepo{due: tt.due, updated: &updated, err: tt.repoErr}
+				uc            = NewDispatchWebhooksInteractor(
+					spyUnitOfWork{transactions: &transactions},
+					repo,
+					repo,
+					tt.webhookRepo,
+					stubWebhookSender{failures: tt.failures, inTransaction: &inTransaction},
+					stubClock{now: now},
+					tt.batchSize,
+					time.Minute,
+				)
+			)
+
+			got, err := uc.Execute(context.Background())
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+
+			if inTransaction {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, "sent in a transaction", "sent outside of transactions")
+			}
+
+			if transactions != tt.wantTx {
+				t.Errorf("[TestCase '%s'] Got transactions: '%v' | Want: '%v'", tt.name, transactions, tt.wantTx)
+			}
+
+			// The deliveries are claimed until the end of the batch before their attempts are recorded
+			var (
+				claimed  = len(updated) - len(tt.wantStatuses)
+				statuses = make([]string, 0)
+			)
+			for i, delivery := range updated {
+				if i < claimed {
+					if !delivery.NextAttemptAt().Equal(now.Add(time.Minute)) {
+						t.Errorf("[TestCase '%s'] Got claimed until: '%v' | Want: '%v'", tt.name, delivery.NextAttemptAt(), now.Add(time.Minute))
+					}
+					continue
+				}
+
+				statuses = append(statuses, delivery.Status())
+			}
+
+			if !reflect.DeepEqual(statuses, tt.wantStatuses) {
+				t.Errorf("[TestCase '%s'] Got statuses: '%v' | Want: '%v'", tt.name, statuses, tt.wantStatuses)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	ExpireAuthorizationsUseCase interface {
+		Execute(context.Context) (int, error)
+	}
+
+	expireAuthorizationsInteractor struct {
+		uow                      domain.UnitOfWork
+		repoExpiredFinder        domain.AuthorizationExpiredFinder
+		repoAuthorizationFinder  domain.AuthorizationFinder
+		repoAuthorizationUpdater domain.AuthorizationUpdater
+		repoAccountLocker        domain.AccountLocker
+		repoAccountUpdater       domain.AccountUpdater
+		clock                    Clock
+		batchSize                int
+		ctxTimeout               time.Duration
+	}
+)
+
+// NewExpireAuthorizationsInteractor creates new expireAuthorizationsInteractor with its dependencies
+func NewExpireAuthorizationsInteractor(
+	uow domain.UnitOfWork,
+	repoExpiredFinder domain.AuthorizationExpiredFinder,
+	repoAuthorizationFinder domain.AuthorizationFinder,
+	repoAuthorizationUpdater domain.AuthorizationUpdater,
+	repoAccountLocker domain.AccountLocker,
+	repoAccountUpdater domain.AccountUpdater,
+	clock Clock,
+	batchSize int,
+	ctxTimeout time.Duration,
+) ExpireAuthorizationsUseCase {
+	return expireAuthorizationsInteractor{
+		uow:                      uow,
+		repoExpiredFinder:        repoExpiredFinder,
+		repoAuthorizationFinder:  repoAuthorizationFinder,
+		repoAuthorizationUpdater: repoAuthorizationUpdater,
+		repoAccountLocker:        repoAccountLocker,
+		repoAccountUpdater:       repoAccountUpdater,
+		clock:                    clock,
+		batchSize:                batchSize,
+		ctxTimeout:               ctxTimeout,
+	}
+}
+
+// Execute releases a batch of stale holds and returns how many were expired. Each authorization is
+// expired in its own unit of work, so a failure is skipped and returned along with the others once
+// the rest of the batch is done
+func (e expireAuthorizationsInteractor) Execute(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "ExpireAuthorizations", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, e.ctxTimeout)
+	defer cancel()
+
+	authorizations, err := e.repoExpiredFinder.FindExpired(ctx, e.clock.Now(), e.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		expired int
+		errs    []error
+	)
+
+	for _, authorization := range authorizations {
+		// The authorizations left are found again on the next sweep
+		if err = ctx.Err(); err != nil {
+			return expired, errors.Join(append(errs, err)...)
+		}
+
+		ok, err := e.expire(ctx, authorization.ID())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("authorization %s: %w", authorization.ID(), err))
+			continue
+		}
+
+		if ok {
+			expired++
+		}
+	}
+
+	return expired, errors.Join(errs...)
+}
+
+// expire locks the authorization and its account and releases the hold. It reports false when the
+// authorization was captured or canceled since it was found
+func (e expireAuthorizationsInteractor) expire(ctx context.Context, id string) (bool, error) {
+	var expired bool
+
+	err := e.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		authorization, err := e.repoAuthorizationFinder.FindByID(ctxTx, id)
+		if err != nil {
+			return err
+		}
+
+		if authorization.Status() != domain.AuthorizationPending {
+			return nil
+		}
+
+		account, err := e.repoAccountLocker.LockByID(ctxTx, authorization.AccountID())
+		if err != nil {
+			return err
+		}
+
+		if err = authorization.Expire(&account, e.clock.Now()); err != nil {
+			return err
+		}
+
+		if err = e.repoAccountUpdater.UpdateCreditLimit(ctxTx, account.ID(), account.AvailableCreditLimit()); err != nil {
+			return err
+		}
+
+		if err = e.repoAuthorizationUpdater.Update(ctxTx, authorization); err != nil {
+			return err
+		}
+
+		expired = true
+		return nil
+	})
+
+	return expired, err
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubFindExpiredAuthorizationsRepo struct {
+	result []domain.Authorization
+	err    error
+}
+
+func (s stubFindExpiredAuthorizationsRepo) FindExpired(_ context.Context, _ time.Time, _ int) ([]domain.Authorization, error) {
+	return s.result, s.err
+}
+
+type stubFindAuthorizationsByIDRepo map[string]domain.Authorization
+
+func (s stubFindAuthorizationsByIDRepo) FindByID(_ context.Context, id string) (domain.Authorization, error) {
+	authorization, ok := s[id]
+	if !ok {
+		return domain.Authorization{}, domain.ErrAuthorizationNotFound
+	}
+
+	return authorization, nil
+}
+
+type spyUpdateAuthorizationsRepo struct {
+	updated *[]string
+	failID  string
+}
+
+func (s spyUpdateAuthorizationsRepo) Update(_ context.Context, authorization domain.Authorization) error {
+	if authorization.ID() == s.failID {
+		return errDB
+	}
+
+	*s.updated = append(*s.updated, authorization.ID())
+	return nil
+}
+
+func Test_expireAuthorizationsInteractor_Execute(t *testing.T) {
+	var (
+		opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		account           = domain.NewStoredAccount("fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "12345678909", 7500, time.Time{})
+		authorization     = func(id string, status string, expiresAt time.Time) domain.Authorization {
+			return domain.NewAuthorization(
+				id,
+				"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				opCompraAVista,
+				2500,
+				0,
+				status,
+				"",
+				expiresAt,
+				authorizationNow.Add(-time.Hour),
+			)
+		}
+		first  = authorization("3c096a40-ccba-4b58-93ed-57379ab04680", domain.AuthorizationPending, authorizationNow)
+		second = authorization("9d8ab2a1-4e0c-4d5f-9a5b-0f4b3e0a7c11", domain.AuthorizationPending, authorizationNow.Add(-time.Minute))
+		third  = authorization("5b1f6c7e-2a3d-4e8f-9b0c-1d2e3f4a5b6c", domain.AuthorizationPending, authorizationNow.Add(-time.Hour))
+		found  = func(authorizations ...domain.Authorization) stubFindAuthorizationsByIDRepo {
+			repo := stubFindAuthorizationsByIDRepo{}
+			for _, authorization := range authorizations {
+				repo[authorization.ID()] = authorization
+			}
+			return repo
+		}
+	)
+
+	tests := []struct {
+		name        string
+		repo        domain.AuthorizationExpiredFinder
+		repoFinder  domain.AuthorizationFinder
+		failID      string
+		want        int
+		wantUpdated []string
+		wantErr     error
+	}{
+		{
+			name:        "Expire the stale authorizations",
+			repo:        stubFindExpiredAuthorizationsRepo{result: []domain.Authorization{first, second}},
+			repoFinder:  found(first, second),
+			want:        2,
+			wantUpdated: []string{first.ID(), second.ID()},
+		},
+		{
+			name:       "No stale authorizations",
+			repo:       stubFindExpiredAuthorizationsRepo{},
+			repoFinder: found(),
+			want:       0,
+		},
+		{
+			name:       "Skip authorization captured since it was found",
+			repo:       stubFindExpiredAuthorizationsRepo{result: []domain.Authorization{first, second}},
+			repoFinder: found(authorization(first.ID(), domain.AuthorizationCaptured, authorizationNow), second),
+			want:       1,
+			wantUpdated: []string{
+				second.ID(),
+			},
+		},
+		{
+			name:        "Keep expiring after an authorization fails mid-batch",
+			repo:        stubFindExpiredAuthorizationsRepo{result: []domain.Authorization{first, second, third}},
+			repoFinder:  found(first, second, third),
+			failID:      second.ID(),
+			want:        2,
+			wantUpdated: []string{first.ID(), third.ID()},
+			wantErr:     errDB,
+		},
+		{
+			name: "Error expiring an authorization not expired yet",
+			repo: stubFindExpiredAuthorizationsRepo{
+				result: []domain.Authorization{authorization(first.ID(), domain.AuthorizationPending, authorizationNow.Add(time.Minute))},
+			},
+			repoFinder: found(authorization(first.ID(), domain.AuthorizationPending, authorizationNow.Add(time.Minute))),
+			wantErr:    domain.ErrAuthorizationNotExpired,
+		},
+		{
+			name:       "Error finding stale authorizations in database",
+			repo:       stubFindExpiredAuthorizationsRepo{err: errDB},
+			repoFinder: found(),
+			wantErr:    errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated []string
+
+			e := NewExpireAuthorizationsInteractor(
+				stubUnitOfWork{},
+				tt.repo,
+				tt.repoFinder,
+				spyUpdateAuthorizationsRepo{updated: &updated, failID: tt.failID},
+				stubFindUserByRepo{result: account},
+				stubUpdateCreditLimitRepo{},
+				stubClock{now: authorizationNow},
+				100,
+				time.Second,
+			)
+
+			got, err := e.Execute(context.Background())
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(updated, tt.wantUpdated) {
+				t.Errorf("[TestCase '%s'] Got updated: '%v' | Want: '%v'", tt.name, updated, tt.wantUpdated)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ type (
 	// Output data
 	FindAccountByIDDocumentOutput struct {
 		Number string `json:"number"`
+		Type   string `json:"type"`
 	}
 
 	findAccountByIDInteractor struct {
@@ -58,6 +59,9 @@ func NewFindAccountByIDInteractor(
 
 // Execute orchestrates the use case
 func (f findAccountByIDInteractor) Execute(ctx context.Context, i FindAccountByIDInput) (FindAccountByIDOutput, error) {
+	ctx, span := startSpan(ctx, "FindAccountByID", i.ID)
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, f.ctxTimeout)
 	defer cancel()
 
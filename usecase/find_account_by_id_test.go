@@ -26,6 +26,7 @@ func (s stubFindAccountByIDPresenter) Output(account domain.Account) FindAccount
 		ID: account.ID(),
 		Document: FindAccountByIDDocumentOutput{
 			Number: account.Document().Number(),
+			Type:   account.Document().Type(),
 		},
 		CreatedAt: account.CreatedAt().String(),
 	}
@@ -52,9 +53,9 @@ func Test_findAccountByIDInteractor_Execute(t *testing.T) {
 			name: "Find account by id success",
 			fields: fields{
 				repo: stubFindAccountByIDRepo{
-					result: domain.NewAccount(
+					result: domain.NewStoredAccount(
 						"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
-						"12345678900",
+						"12345678909",
 						100,
 						time.Time{},
 					),
@@ -71,10 +72,9 @@ func Test_findAccountByIDInteractor_Execute(t *testing.T) {
 			},
 			want: FindAccountByIDOutput{
 				ID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
-				Document: struct {
-					Number string `json:"number"`
-				}{
-					Number: "12345678900",
+				Document: FindAccountByIDDocumentOutput{
+					Number: "12345678909",
+					Type:   domain.CPF,
 				},
 				CreatedAt: time.Time{}.String(),
 			},
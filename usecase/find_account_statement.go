@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+const (
+	// statementPageSize defines how many transactions are fetched at a time to build a statement
+	statementPageSize = 100
+)
+
+type (
+	// Input port
+	FindAccountStatementUseCase interface {
+		Execute(context.Context, FindAccountStatementInput) (FindAccountStatementOutput, error)
+	}
+
+	// Input data
+	FindAccountStatementInput struct {
+		AccountID string    `json:"account_id" validate:"required"`
+		From      time.Time `json:"from" validate:"required"`
+		To        time.Time `json:"to"`
+	}
+
+	// Output port
+	FindAccountStatementPresenter interface {
+		Output(domain.Statement) FindAccountStatementOutput
+	}
+
+	// Output data
+	FindAccountStatementOutput struct {
+		AccountID    string                            `json:"account_id"`
+		From         string                            `json:"from"`
+		To           string                            `json:"to"`
+		OpeningLimit int64                             `json:"opening_limit"`
+		Entries      []FindAccountStatementEntryOutput `json:"entries"`
+		Totals       map[string]int64                  `json:"totals"`
+		ClosingLimit int64                             `json:"closing_limit"`
+	}
+
+	// Output data
+	FindAccountStatementEntryOutput struct {
+		TransactionID string                              `json:"transaction_id"`
+		Operation     FindAccountStatementOperationOutput `json:"operation"`
+		Amount        int64                               `json:"amount"`
+		Limit         int64                               `json:"limit"`
+		CreatedAt     string                              `json:"created_at"`
+	}
+
+	// Output data
+	FindAccountStatementOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+	}
+
+	findAccountStatementInteractor struct {
+		uow                   domain.UnitOfWork
+		repoAccountFinder     domain.AccountFinder
+		repoTransactionFinder domain.TransactionFinder
+		repoLedgerFinder      domain.LedgerLimitFinder
+		pre                   FindAccountStatementPresenter
+		clock                 Clock
+		ctxTimeout            time.Duration
+	}
+)
+
+// NewFindAccountStatementInteractor creates new findAccountStatementInteractor with its dependencies
+func NewFindAccountStatementInteractor(
+	uow domain.UnitOfWork,
+	repoAccountFinder domain.AccountFinder,
+	repoTransactionFinder domain.TransactionFinder,
+	repoLedgerFinder domain.LedgerLimitFinder,
+	pre FindAccountStatementPresenter,
+	clock Clock,
+	ctxTimeout time.Duration,
+) FindAccountStatementUseCase {
+	return findAccountStatementInteractor{
+		uow:                   uow,
+		repoAccountFinder:     repoAccountFinder,
+		repoTransactionFinder: repoTransactionFinder,
+		repoLedgerFinder:      repoLedgerFinder,
+		pre:                   pre,
+		clock:                 clock,
+		ctxTimeout:            ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findAccountStatementInteractor) Execute(
+	ctx context.Context,
+	i FindAccountStatementInput,
+) (FindAccountStatementOutput, error) {
+	ctx, span := startSpan(ctx, "FindAccountStatement", i.AccountID)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, f.ctxTimeout)
+	defer cancel()
+
+	to := i.To
+	if to.IsZero() {
+		to = f.clock.Now()
+	}
+
+	var statement domain.Statement
+
+	// Reads the ledger and the transactions in the same database transaction, so that the
+	// opening limit and the transactions of the period are of the same moment
+	err := f.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		account, err := f.repoAccountFinder.FindByID(ctxTx, i.AccountID)
+		if err != nil {
+			return err
+		}
+
+		openingLimit, err := f.repoLedgerFinder.FindLimitBefore(ctxTx, account.ID(), i.From)
+		if err != nil {
+			return err
+		}
+
+		transactions, err := f.transactionsBetween(ctxTx, account.ID(), i.From, to)
+		if err != nil {
+			return err
+		}
+
+		statement, err = domain.NewStatement(account, i.From, to, openingLimit, transactions)
+		return err
+	})
+	if err != nil {
+		return f.pre.Output(domain.Statement{}), err
+	}
+
+	return f.pre.Output(statement), nil
+}
+
+// transactionsBetween pages through every transaction of the account created between from and to, both inclusive
+func (f findAccountStatementInteractor) transactionsBetween(
+	ctx context.Context,
+	accountID string,
+	from time.Time,
+	to time.Time,
+) ([]domain.Transaction, error) {
+	var (
+		transactions = make([]domain.Transaction, 0)
+		after        domain.TransactionCursor
+	)
+
+	for {
+		page, err := f.repoTransactionFinder.FindByAccountID(ctx, domain.TransactionFilter{
+			AccountID: accountID,
+			From:      from,
+			To:        to,
+			After:     after,
+			Limit:     statementPageSize,
+		})
+		if err != nil {
+			return []domain.Transaction{}, err
+		}
+
+		transactions = append(transactions, page...)
+		if len(page) < statementPageSize {
+			return transactions, nil
+		}
+
+		after = domain.NewTransactionCursor(page[len(page)-1])
+	}
+}
@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// stubPagedTransactionsRepo returns the transactions, newest first, honoring the period, the cursor and the limit
+type stubPagedTransactionsRepo struct {
+	result []domain.Transaction
+	err    error
+}
+
+func (s stubPagedTransactionsRepo) FindByAccountID(_ context.Context, f domain.TransactionFilter) ([]domain.Transaction, error) {
+	var period = make([]domain.Transaction, 0)
+	for _, transaction := range s.result {
+		if transaction.CreatedAt().Before(f.From) || (!f.To.IsZero() && transaction.CreatedAt().After(f.To)) {
+			continue
+		}
+		period = append(period, transaction)
+	}
+
+	var start int
+	if !f.After.IsZero() {
+		for i, transaction := range period {
+			if transaction.ID() == f.After.ID() {
+				start = i + 1
+			}
+		}
+	}
+
+	var end = start + f.Limit
+	if end > len(period) {
+		end = len(period)
+	}
+
+	return period[start:end], s.err
+}
+
+// stubFindLedgerLimitRepo returns the limit recorded in the ledger before the moment it expects
+type stubFindLedgerLimitRepo struct {
+	before time.Time
+	result int64
+	err    error
+}
+
+func (s stubFindLedgerLimitRepo) FindLimitBefore(_ context.Context, _ string, before time.Time) (int64, error) {
+	if !before.Equal(s.before) {
+		return 0, fmt.Errorf("limit before %v, want before %v", before, s.before)
+	}
+
+	return s.result, s.err
+}
+
+type stubFindAccountStatementPresenter struct{}
+
+func (s stubFindAccountStatementPresenter) Output(statement domain.Statement) FindAccountStatementOutput {
+	var entries = make([]FindAccountStatementEntryOutput, 0)
+	for _, entry := range statement.Entries() {
+		entries = append(entries, FindAccountStatementEntryOutput{
+			TransactionID: entry.Transaction().ID(),
+			Amount:        entry.Transaction().Amount(),
+			Limit:         entry.Limit(),
+		})
+	}
+
+	return FindAccountStatementOutput{
+		AccountID:    statement.AccountID(),
+		OpeningLimit: statement.OpeningLimit(),
+		Entries:      entries,
+		Totals:       statement.Totals(),
+		ClosingLimit: statement.ClosingLimit(),
+	}
+}
+
+func Test_findAccountStatementInteractor_Execute(t *testing.T) {
+	var (
+		opSaque, _ = domain.NewOperation(domain.Saque)
+		now        = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		account    = domain.NewStoredAccount("1", "12345678900", 0, time.Time{})
+		// More transactions than a page, newest first, each withdrawing 10
+		history = make([]domain.Transaction, 0)
+	)
+
+	for i := 0; i < statementPageSize+50; i++ {
+		history = append(history, domain.NewTransaction(
+			fmt.Sprintf("%03d", statementPageSize+50-i),
+			"1",
+			opSaque,
+			10,
+			-10,
+			now.Add(-time.Duration(i)*time.Hour),
+		))
+	}
+
+	type fields struct {
+		repoAccountFinder     domain.AccountFinder
+		repoTransactionFinder domain.TransactionFinder
+		repoLedgerFinder      domain.LedgerLimitFinder
+	}
+	type args struct {
+		i FindAccountStatementInput
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		wantOpening int64
+		wantClosing int64
+		wantEntries int
+		wantErr     error
+	}{
+		{
+			name: "Statement spanning several pages",
+			fields: fields{
+				repoAccountFinder:     stubFindUserByRepo{result: account},
+				repoTransactionFinder: stubPagedTransactionsRepo{result: history},
+				repoLedgerFinder: stubFindLedgerLimitRepo{
+					before: now.Add(-time.Duration(statementPageSize+49) * time.Hour),
+					result: 1500,
+				},
+			},
+			args: args{
+				i: FindAccountStatementInput{
+					AccountID: "1",
+					From:      now.Add(-time.Duration(statementPageSize+49) * time.Hour),
+					To:        now.Add(-10 * time.Hour),
+				},
+			},
+			wantOpening: 1500,
+			wantClosing: 100,
+			wantEntries: statementPageSize + 40,
+		},
+		{
+			name: "Statement until now by default",
+			fields: fields{
+				repoAccountFinder:     stubFindUserByRepo{result: account},
+				repoTransactionFinder: stubPagedTransactionsRepo{result: history},
+				repoLedgerFinder:      stubFindLedgerLimitRepo{before: now.Add(-2 * time.Hour), result: 30},
+			},
+			args: args{
+				i: FindAccountStatementInput{
+					AccountID: "1",
+					From:      now.Add(-2 * time.Hour),
+				},
+			},
+			wantOpening: 30,
+			wantClosing: 0,
+			wantEntries: 3,
+		},
+		{
+			name: "Error period ends before it starts",
+			fields: fields{
+				repoAccountFinder:     stubFindUserByRepo{result: account},
+				repoTransactionFinder: stubPagedTransactionsRepo{},
+				repoLedgerFinder:      stubFindLedgerLimitRepo{before: now.Add(time.Hour)},
+			},
+			args: args{
+				i: FindAccountStatementInput{
+					AccountID: "1",
+					From:      now.Add(time.Hour),
+				},
+			},
+			wantErr: domain.ErrStatementPeriodInvalid,
+		},
+		{
+			name: "Error account not found",
+			fields: fields{
+				repoAccountFinder:     stubFindUserByRepo{err: domain.ErrAccountNotFound},
+				repoTransactionFinder: stubPagedTransactionsRepo{},
+				repoLedgerFinder:      stubFindLedgerLimitRepo{before: now},
+			},
+			args: args{
+				i: FindAccountStatementInput{
+					AccountID: "1",
+					From:      now,
+				},
+			},
+			wantErr: domain.ErrAccountNotFound,
+		},
+		{
+			name: "Error finding transactions",
+			fields: fields{
+				repoAccountFinder:     stubFindUserByRepo{result: account},
+				repoTransactionFinder: stubPagedTransactionsRepo{err: errDB},
+				repoLedgerFinder:      stubFindLedgerLimitRepo{before: now},
+			},
+			args: args{
+				i: FindAccountStatementInput{
+					AccountID: "1",
+					From:      now,
+				},
+			},
+			wantErr: errDB,
+		},
+		{
+			name: "Error finding the limit in the ledger",
+			fields: fields{
+				repoAccountFinder:     stubFindUserByRepo{result: account},
+				repoTransactionFinder: stubPagedTransactionsRepo{},
+				repoLedgerFinder:      stubFindLedgerLimitRepo{before: now, err: errDB},
+			},
+			args: args{
+				i: FindAccountStatementInput{
+					AccountID: "1",
+					From:      now,
+				},
+			},
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewFindAccountStatementInteractor(
+				stubUnitOfWork{},
+				tt.fields.repoAccountFinder,
+				tt.fields.repoTransactionFinder,
+				tt.fields.repoLedgerFinder,
+				stubFindAccountStatementPresenter{},
+				stubClock{now: now},
+				time.Second,
+			)
+
+			got, err := uc.Execute(context.Background(), tt.args.i)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			var want = []int64{tt.wantOpening, tt.wantClosing, int64(tt.wantEntries)}
+			if result := []int64{got.OpeningLimit, got.ClosingLimit, int64(len(got.Entries))}; !reflect.DeepEqual(result, want) {
+				t.Errorf("[TestCase '%s'] Got opening, closing, entries: '%v' | Want: '%v'", tt.name, result, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	FindAllOperationsUseCase interface {
+		Execute(context.Context) (FindAllOperationsOutput, error)
+	}
+
+	// Output port
+	FindAllOperationsPresenter interface {
+		Output([]domain.Operation) FindAllOperationsOutput
+	}
+
+	// Output data
+	FindAllOperationsOutput struct {
+		Operations []FindAllOperationsOperationOutput `json:"operations"`
+	}
+
+	// Output data
+	FindAllOperationsOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+		Enabled     bool   `json:"enabled"`
+	}
+
+	findAllOperationsInteractor struct {
+		repo       domain.OperationLister
+		pre        FindAllOperationsPresenter
+		ctxTimeout time.Duration
+	}
+)
+
+// NewFindAllOperationsInteractor creates new findAllOperationsInteractor with its dependencies
+func NewFindAllOperationsInteractor(
+	repo domain.OperationLister,
+	pre FindAllOperationsPresenter,
+	ctxTimeout time.Duration,
+) FindAllOperationsUseCase {
+	return findAllOperationsInteractor{
+		repo:       repo,
+		pre:        pre,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findAllOperationsInteractor) Execute(ctx context.Context) (FindAllOperationsOutput, error) {
+	ctx, span := startSpan(ctx, "FindAllOperations", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, f.ctxTimeout)
+	defer cancel()
+
+	operations, err := f.repo.FindAll(ctx)
+	if err != nil {
+		return f.pre.Output([]domain.Operation{}), err
+	}
+
+	return f.pre.Output(operations), nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	FindAllWebhooksUseCase interface {
+		Execute(context.Context, FindAllWebhooksInput) (FindAllWebhooksOutput, error)
+	}
+
+	// Input data
+	FindAllWebhooksInput struct {
+		AccountID string `json:"account_id"`
+	}
+
+	// Output port
+	FindAllWebhooksPresenter interface {
+		Output([]domain.Webhook) FindAllWebhooksOutput
+	}
+
+	// Output data
+	FindAllWebhooksOutput struct {
+		Webhooks []FindAllWebhooksWebhookOutput `json:"webhooks"`
+	}
+
+	// Output data
+	FindAllWebhooksWebhookOutput struct {
+		ID        string   `json:"id"`
+		AccountID string   `json:"account_id,omitempty"`
+		URL       string   `json:"url"`
+		Events    []string `json:"events"`
+		Active    bool     `json:"active"`
+		CreatedAt string   `json:"created_at"`
+	}
+
+	findAllWebhooksInteractor struct {
+		repo       domain.WebhookLister
+		pre        FindAllWebhooksPresenter
+		ctxTimeout time.Duration
+	}
+)
+
+// NewFindAllWebhooksInteractor creates new findAllWebhooksInteractor with its dependencies
+func NewFindAllWebhooksInteractor(
+	repo domain.WebhookLister,
+	pre FindAllWebhooksPresenter,
+	ctxTimeout time.Duration,
+) FindAllWebhooksUseCase {
+	return findAllWebhooksInteractor{
+		repo:       repo,
+		pre:        pre,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findAllWebhooksInteractor) Execute(ctx context.Context, i FindAllWebhooksInput) (FindAllWebhooksOutput, error) {
+	ctx, span := startSpan(ctx, "FindAllWebhooks", i.AccountID)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, f.ctxTimeout)
+	defer cancel()
+
+	webhooks, err := f.repo.FindAll(ctx, i.AccountID)
+	if err != nil {
+		return f.pre.Output([]domain.Webhook{}), err
+	}
+
+	return f.pre.Output(webhooks), nil
+}
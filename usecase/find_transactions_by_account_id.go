@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+const (
+	defaultTransactionsLimit = 20
+)
+
+type (
+	// Input port
+	FindTransactionsByAccountIDUseCase interface {
+		Execute(context.Context, FindTransactionsByAccountIDInput) (FindTransactionsByAccountIDOutput, error)
+	}
+
+	// Input data
+	FindTransactionsByAccountIDInput struct {
+		AccountID   string    `json:"account_id" validate:"required"`
+		OperationID string    `json:"operation_id"`
+		Type        string    `json:"type" validate:"omitempty,oneof=DEBIT CREDIT"`
+		MinAmount   int64     `json:"min_amount" validate:"gte=0"`
+		MaxAmount   int64     `json:"max_amount" validate:"omitempty,gtefield=MinAmount"`
+		From        time.Time `json:"from"`
+		To          time.Time `json:"to"`
+		Cursor      string    `json:"cursor"`
+		Limit       int       `json:"limit" validate:"omitempty,min=1,max=100"`
+	}
+
+	// Output port
+	FindTransactionsByAccountIDPresenter interface {
+		Output([]domain.Transaction, domain.TransactionCursor) FindTransactionsByAccountIDOutput
+	}
+
+	// Output data
+	FindTransactionsByAccountIDOutput struct {
+		Transactions []FindTransactionsByAccountIDTransactionOutput `json:"transactions"`
+		NextCursor   string                                         `json:"next_cursor,omitempty"`
+	}
+
+	// Output data
+	FindTransactionsByAccountIDTransactionOutput struct {
+		ID           string                                         `json:"id"`
+		AccountID    string                                         `json:"account_id"`
+		Operation    FindTransactionsByAccountIDOperationOutput     `json:"operation"`
+		Amount       int64                                          `json:"amount"`
+		Balance      int64                                          `json:"balance"`
+		Installments []FindTransactionsByAccountIDInstallmentOutput `json:"installments,omitempty"`
+		CreatedAt    string                                         `json:"created_at"`
+	}
+
+	// Output data
+	FindTransactionsByAccountIDInstallmentOutput struct {
+		Number  int    `json:"number"`
+		Amount  int64  `json:"amount"`
+		DueDate string `json:"due_date"`
+	}
+
+	// Output data
+	FindTransactionsByAccountIDOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+	}
+
+	findTransactionsByAccountIDInteractor struct {
+		repoTransactionFinder domain.TransactionFinder
+		repoAccountFinder     domain.AccountFinder
+		pre                   FindTransactionsByAccountIDPresenter
+		ctxTimeout            time.Duration
+	}
+)
+
+// NewFindTransactionsByAccountIDInteractor creates new findTransactionsByAccountIDInteractor with its dependencies
+func NewFindTransactionsByAccountIDInteractor(
+	repoTransactionFinder domain.TransactionFinder,
+	repoAccountFinder domain.AccountFinder,
+	pre FindTransactionsByAccountIDPresenter,
+	ctxTimeout time.Duration,
+) FindTransactionsByAccountIDUseCase {
+	return findTransactionsByAccountIDInteractor{
+		repoTransactionFinder: repoTransactionFinder,
+		repoAccountFinder:     repoAccountFinder,
+		pre:                   pre,
+		ctxTimeout:            ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findTransactionsByAccountIDInteractor) Execute(
+	ctx context.Context,
+	i FindTransactionsByAccountIDInput,
+) (FindTransactionsByAccountIDOutput, error) {
+	ctx, span := startSpan(ctx, "FindTransactionsByAccountID", i.AccountID)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, f.ctxTimeout)
+	defer cancel()
+
+	after, err := domain.ParseTransactionCursor(i.Cursor)
+	if err != nil {
+		return f.pre.Output([]domain.Transaction{}, domain.TransactionCursor{}), err
+	}
+
+	if _, err = f.repoAccountFinder.FindByID(ctx, i.AccountID); err != nil {
+		return f.pre.Output([]domain.Transaction{}, domain.TransactionCursor{}), err
+	}
+
+	limit := i.Limit
+	if limit == 0 {
+		limit = defaultTransactionsLimit
+	}
+
+	// Fetches one extra transaction to know whether there is a next page
+	transactions, err := f.repoTransactionFinder.FindByAccountID(ctx, domain.TransactionFilter{
+		AccountID:   i.AccountID,
+		OperationID: i.OperationID,
+		Type:        i.Type,
+		MinAmount:   i.MinAmount,
+		MaxAmount:   i.MaxAmount,
+		From:        i.From,
+		To:          i.To,
+		After:       after,
+		Limit:       limit + 1,
+	})
+	if err != nil {
+		return f.pre.Output([]domain.Transaction{}, domain.TransactionCursor{}), err
+	}
+
+	var next domain.TransactionCursor
+	if len(transactions) > limit {
+		transactions = transactions[:limit]
+		next = domain.NewTransactionCursor(transactions[limit-1])
+	}
+
+	return f.pre.Output(transactions, next), nil
+}
@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubFindTransactionsByAccountIDRepo struct {
+	result []domain.Transaction
+	err    error
+}
+
+func (s stubFindTransactionsByAccountIDRepo) FindByAccountID(_ context.Context, f domain.TransactionFilter) ([]domain.Transaction, error) {
+	if len(s.result) > f.Limit {
+		return s.result[:f.Limit], s.err
+	}
+
+	return s.result, s.err
+}
+
+type stubFindTransactionsByAccountIDPresenter struct{}
+
+func (s stubFindTransactionsByAccountIDPresenter) Output(
+	transactions []domain.Transaction,
+	next domain.TransactionCursor,
+) FindTransactionsByAccountIDOutput {
+	var o = make([]FindTransactionsByAccountIDTransactionOutput, 0)
+	for _, transaction := range transactions {
+		o = append(o, FindTransactionsByAccountIDTransactionOutput{
+			ID:        transaction.ID(),
+			AccountID: transaction.AccountID(),
+			Amount:    transaction.Amount(),
+			Balance:   transaction.Balance(),
+			CreatedAt: transaction.CreatedAt().String(),
+		})
+	}
+
+	return FindTransactionsByAccountIDOutput{
+		Transactions: o,
+		NextCursor:   next.String(),
+	}
+}
+
+func Test_findTransactionsByAccountIDInteractor_Execute(t *testing.T) {
+	var (
+		opSaque, _ = domain.NewOperation(domain.Saque)
+		account    = domain.NewStoredAccount(
+			"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+			"12345678900",
+			100,
+			time.Time{},
+		)
+		first = domain.NewTransaction(
+			"3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+			"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+			opSaque,
+			100,
+			-100,
+			time.Time{},
+		)
+		second = domain.NewTransaction(
+			"2a2fa5d6-7a1a-4d31-9b2a-0f1a1c8e5f3b",
+			"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+			opSaque,
+			50,
+			-50,
+			time.Time{},
+		)
+	)
+
+	type fields struct {
+		repoTransactionFinder domain.TransactionFinder
+		repoAccountFinder     domain.AccountFinder
+		pre                   FindTransactionsByAccountIDPresenter
+		ctxTimeout            time.Duration
+	}
+	type args struct {
+		ctx context.Context
+		i   FindTransactionsByAccountIDInput
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    FindTransactionsByAccountIDOutput
+		wantErr bool
+	}{
+		{
+			name: "Find transactions of account successfully",
+			fields: fields{
+				repoTransactionFinder: stubFindTransactionsByAccountIDRepo{
+					result: []domain.Transaction{first, second},
+					err:    nil,
+				},
+				repoAccountFinder: stubFindUserByRepo{result: account, err: nil},
+				pre:               stubFindTransactionsByAccountIDPresenter{},
+				ctxTimeout:        time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: FindTransactionsByAccountIDInput{
+					AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				},
+			},
+			want: FindTransactionsByAccountIDOutput{
+				Transactions: []FindTransactionsByAccountIDTransactionOutput{
+					{
+						ID:        "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+						AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						Amount:    -100,
+						Balance:   -100,
+						CreatedAt: time.Time{}.String(),
+					},
+					{
+						ID:        "2a2fa5d6-7a1a-4d31-9b2a-0f1a1c8e5f3b",
+						AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						Amount:    -50,
+						Balance:   -50,
+						CreatedAt: time.Time{}.String(),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Find transactions of account with next page",
+			fields: fields{
+				repoTransactionFinder: stubFindTransactionsByAccountIDRepo{
+					result: []domain.Transaction{first, second},
+					err:    nil,
+				},
+				repoAccountFinder: stubFindUserByRepo{result: account, err: nil},
+				pre:               stubFindTransactionsByAccountIDPresenter{},
+				ctxTimeout:        time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: FindTransactionsByAccountIDInput{
+					AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					Limit:     1,
+				},
+			},
+			want: FindTransactionsByAccountIDOutput{
+				Transactions: []FindTransactionsByAccountIDTransactionOutput{
+					{
+						ID:        "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+						AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+						Amount:    -100,
+						Balance:   -100,
+						CreatedAt: time.Time{}.String(),
+					},
+				},
+				NextCursor: domain.NewTransactionCursor(first).String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error invalid cursor",
+			fields: fields{
+				repoTransactionFinder: stubFindTransactionsByAccountIDRepo{},
+				repoAccountFinder:     stubFindUserByRepo{result: account, err: nil},
+				pre:                   stubFindTransactionsByAccountIDPresenter{},
+				ctxTimeout:            time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: FindTransactionsByAccountIDInput{
+					AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+					Cursor:    "invalid",
+				},
+			},
+			want: FindTransactionsByAccountIDOutput{
+				Transactions: []FindTransactionsByAccountIDTransactionOutput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Account not found when find transactions",
+			fields: fields{
+				repoTransactionFinder: stubFindTransactionsByAccountIDRepo{},
+				repoAccountFinder:     stubFindUserByRepo{result: domain.Account{}, err: domain.ErrAccountNotFound},
+				pre:                   stubFindTransactionsByAccountIDPresenter{},
+				ctxTimeout:            time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: FindTransactionsByAccountIDInput{
+					AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				},
+			},
+			want: FindTransactionsByAccountIDOutput{
+				Transactions: []FindTransactionsByAccountIDTransactionOutput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Repository error when find transactions",
+			fields: fields{
+				repoTransactionFinder: stubFindTransactionsByAccountIDRepo{
+					result: []domain.Transaction{},
+					err:    errors.New("db_error"),
+				},
+				repoAccountFinder: stubFindUserByRepo{result: account, err: nil},
+				pre:               stubFindTransactionsByAccountIDPresenter{},
+				ctxTimeout:        time.Second,
+			},
+			args: args{
+				ctx: context.Background(),
+				i: FindTransactionsByAccountIDInput{
+					AccountID: "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				},
+			},
+			want: FindTransactionsByAccountIDOutput{
+				Transactions: []FindTransactionsByAccountIDTransactionOutput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor := NewFindTransactionsByAccountIDInteractor(
+				tt.fields.repoTransactionFinder,
+				tt.fields.repoAccountFinder,
+				tt.fields.pre,
+				tt.fields.ctxTimeout,
+			)
+
+			got, err := interactor.Execute(tt.args.ctx, tt.args.i)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	FindWebhookByIDUseCase interface {
+		Execute(context.Context, FindWebhookByIDInput) (FindWebhookByIDOutput, error)
+	}
+
+	// Input data
+	FindWebhookByIDInput struct {
+		ID string
+	}
+
+	// Output port
+	FindWebhookByIDPresenter interface {
+		Output(domain.Webhook) FindWebhookByIDOutput
+	}
+
+	// Output data
+	FindWebhookByIDOutput struct {
+		ID        string   `json:"id"`
+		AccountID string   `json:"account_id,omitempty"`
+		URL       string   `json:"url"`
+		Events    []string `json:"events"`
+		Active    bool     `json:"active"`
+		CreatedAt string   `json:"created_at"`
+	}
+
+	findWebhookByIDInteractor struct {
+		repo       domain.WebhookFinder
+		pre        FindWebhookByIDPresenter
+		ctxTimeout time.Duration
+	}
+)
+
+// NewFindWebhookByIDInteractor creates new findWebhookByIDInteractor with its dependencies
+func NewFindWebhookByIDInteractor(
+	repo domain.WebhookFinder,
+	pre FindWebhookByIDPresenter,
+	ctxTimeout time.Duration,
+) FindWebhookByIDUseCase {
+	return findWebhookByIDInteractor{
+		repo:       repo,
+		pre:        pre,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findWebhookByIDInteractor) Execute(ctx context.Context, i FindWebhookByIDInput) (FindWebhookByIDOutput, error) {
+	ctx, span := startSpan(ctx, "FindWebhookByID", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, f.ctxTimeout)
+	defer cancel()
+
+	webhook, err := f.repo.FindByID(ctx, i.ID)
+	if err != nil {
+		return f.pre.Output(domain.Webhook{}), err
+	}
+
+	return f.pre.Output(webhook), nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+const (
+	// webhookDeliveriesLimit defines how many of the latest deliveries of a webhook are listed
+	webhookDeliveriesLimit = 100
+)
+
+type (
+	// Input port
+	FindWebhookDeliveriesUseCase interface {
+		Execute(context.Context, FindWebhookDeliveriesInput) (FindWebhookDeliveriesOutput, error)
+	}
+
+	// Input data
+	FindWebhookDeliveriesInput struct {
+		WebhookID string
+	}
+
+	// Output port
+	FindWebhookDeliveriesPresenter interface {
+		Output([]domain.WebhookDelivery) FindWebhookDeliveriesOutput
+	}
+
+	// Output data
+	FindWebhookDeliveriesOutput struct {
+		Deliveries []FindWebhookDeliveriesDeliveryOutput `json:"deliveries"`
+	}
+
+	// Output data
+	FindWebhookDeliveriesDeliveryOutput struct {
+		ID            string `json:"id"`
+		EventID       string `json:"event_id"`
+		EventType     string `json:"event_type"`
+		Status        string `json:"status"`
+		Attempts      int    `json:"attempts"`
+		LastError     string `json:"last_error,omitempty"`
+		NextAttemptAt string `json:"next_attempt_at,omitempty"`
+		DeliveredAt   string `json:"delivered_at,omitempty"`
+		CreatedAt     string `json:"created_at"`
+	}
+
+	findWebhookDeliveriesInteractor struct {
+		repoWebhookFinder  domain.WebhookFinder
+		repoDeliveryLister domain.WebhookDeliveryLister
+		pre                FindWebhookDeliveriesPresenter
+		ctxTimeout         time.Duration
+	}
+)
+
+// NewFindWebhookDeliveriesInteractor creates new findWebhookDeliveriesInteractor with its dependencies
+func NewFindWebhookDeliveriesInteractor(
+	repoWebhookFinder domain.WebhookFinder,
+	repoDeliveryLister domain.WebhookDeliveryLister,
+	pre FindWebhookDeliveriesPresenter,
+	ctxTimeout time.Duration,
+) FindWebhookDeliveriesUseCase {
+	return findWebhookDeliveriesInteractor{
+		repoWebhookFinder:  repoWebhookFinder,
+		repoDeliveryLister: repoDeliveryLister,
+		pre:                pre,
+		ctxTimeout:         ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findWebhookDeliveriesInteractor) Execute(
+	ctx context.Context,
+	i FindWebhookDeliveriesInput,
+) (FindWebhookDeliveriesOutput, error) {
+	ctx, span := startSpan(ctx, "FindWebhookDeliveries", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, f.ctxTimeout)
+	defer cancel()
+
+	if _, err := f.repoWebhookFinder.FindByID(ctx, i.WebhookID); err != nil {
+		return f.pre.Output([]domain.WebhookDelivery{}), err
+	}
+
+	deliveries, err := f.repoDeliveryLister.FindByWebhookID(ctx, i.WebhookID, webhookDeliveriesLimit)
+	if err != nil {
+		return f.pre.Output([]domain.WebhookDelivery{}), err
+	}
+
+	return f.pre.Output(deliveries), nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// TransactionMetrics defines the output port of the business metrics of the transactions
+	TransactionMetrics interface {
+		// TransactionCreated records the transaction created
+		TransactionCreated(domain.Transaction)
+		// TransactionDeclined records the amount of the operation refused by a business rule, with the rule broken.
+		// The operation is empty when it does not exist
+		TransactionDeclined(operationID string, amount int64, reason error)
+		// TransactionFailed records the operation that could not be processed, empty when it does not exist
+		TransactionFailed(operationID string, err error)
+	}
+
+	noopTransactionMetrics struct{}
+)
+
+// NewNoopTransactionMetrics creates new TransactionMetrics that records nothing
+func NewNoopTransactionMetrics() TransactionMetrics {
+	return noopTransactionMetrics{}
+}
+
+func (noopTransactionMetrics) TransactionCreated(domain.Transaction) {}
+
+func (noopTransactionMetrics) TransactionDeclined(string, int64, error) {}
+
+func (noopTransactionMetrics) TransactionFailed(string, error) {}
+
+// transactionDeclined reports whether the error is a business rule refusing the transaction, rather than a failure
+func transactionDeclined(err error) bool {
+	return errors.Is(err, domain.ErrAccountInsufficientCreditLimit) ||
+		errors.Is(err, domain.ErrOperationInvalid) ||
+		errors.Is(err, domain.ErrOperationDisabled) ||
+		errors.Is(err, domain.ErrInstallmentsInvalid) ||
+		errors.Is(err, domain.ErrTransferSameAccount) ||
+		errors.Is(err, domain.ErrCashInSourceInvalid) ||
+		errors.Is(err, domain.ErrAuthorizationAccountMismatch) ||
+		errors.Is(err, domain.ErrAuthorizationCaptureExceedsAmount) ||
+		errors.Is(err, domain.ErrAuthorizationExpired) ||
+		errors.Is(err, domain.ErrAuthorizationNotPending) ||
+		errors.Is(err, domain.ErrTransactionNotReversible) ||
+		errors.Is(err, domain.ErrTransactionReversalExceedsAmount)
+}
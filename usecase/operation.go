@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+// findEnabledOperation resolves the operation on the catalog, rejecting unknown and disabled operations
+func findEnabledOperation(ctx context.Context, repo domain.OperationFinder, ID string) (domain.Operation, error) {
+	op, err := repo.FindByID(ctx, ID)
+	switch {
+	case err == domain.ErrOperationNotFound:
+		return domain.Operation{}, domain.ErrOperationInvalid
+	case err != nil:
+		return domain.Operation{}, err
+	case !op.Enabled():
+		return domain.Operation{}, domain.ErrOperationDisabled
+	}
+
+	return op, nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+func Test_findEnabledOperation(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    domain.OperationFinder
+		ID      string
+		wantErr error
+	}{
+		{
+			name: "Enabled operation",
+			repo: stubFindOperationRepo{},
+			ID:   domain.CompraAVista,
+		},
+		{
+			name:    "Error unknown operation",
+			repo:    stubFindOperationRepo{},
+			ID:      "123",
+			wantErr: domain.ErrOperationInvalid,
+		},
+		{
+			name:    "Error disabled operation",
+			repo:    stubFindOperationRepo{disabled: true},
+			ID:      domain.CompraAVista,
+			wantErr: domain.ErrOperationDisabled,
+		},
+		{
+			name:    "Error finding operation in database",
+			repo:    stubFindOperationRepo{err: errDB},
+			ID:      domain.CompraAVista,
+			wantErr: errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findEnabledOperation(context.Background(), tt.repo, tt.ID)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err == nil && got.ID() != tt.ID {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want id: '%v'", tt.name, got, tt.ID)
+			}
+		})
+	}
+}
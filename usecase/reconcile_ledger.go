@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	ReconcileLedgerUseCase interface {
+		Execute(context.Context) (ReconcileLedgerOutput, error)
+	}
+
+	// Output port
+	ReconcileLedgerPresenter interface {
+		Output(int, []domain.AccountLedgerBalance) ReconcileLedgerOutput
+	}
+
+	// Output data
+	ReconcileLedgerOutput struct {
+		Checked int                          `json:"checked"`
+		Drifts  []ReconcileLedgerDriftOutput `json:"drifts"`
+	}
+
+	// Output data
+	ReconcileLedgerDriftOutput struct {
+		AccountID            string `json:"account_id"`
+		AvailableCreditLimit int64  `json:"available_credit_limit"`
+		LedgerLimit          int64  `json:"ledger_limit"`
+		Drift                int64  `json:"drift"`
+	}
+
+	reconcileLedgerInteractor struct {
+		repo       domain.LedgerBalanceFinder
+		pre        ReconcileLedgerPresenter
+		ctxTimeout time.Duration
+	}
+)
+
+// NewReconcileLedgerInteractor creates new reconcileLedgerInteractor with its dependencies
+func NewReconcileLedgerInteractor(
+	repo domain.LedgerBalanceFinder,
+	pre ReconcileLedgerPresenter,
+	ctxTimeout time.Duration,
+) ReconcileLedgerUseCase {
+	return reconcileLedgerInteractor{
+		repo:       repo,
+		pre:        pre,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// Execute recomputes the credit limit of every account from its postings and reports the accounts that drifted
+func (r reconcileLedgerInteractor) Execute(ctx context.Context) (ReconcileLedgerOutput, error) {
+	ctx, span := startSpan(ctx, "ReconcileLedger", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	balances, err := r.repo.FindAccountBalances(ctx)
+	if err != nil {
+		return r.pre.Output(0, []domain.AccountLedgerBalance{}), err
+	}
+
+	var drifts = make([]domain.AccountLedgerBalance, 0)
+	for _, balance := range balances {
+		if balance.Drift() != 0 {
+			drifts = append(drifts, balance)
+		}
+	}
+
+	return r.pre.Output(len(balances), drifts), nil
+}
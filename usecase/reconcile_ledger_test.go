@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubFindLedgerBalancesRepo struct {
+	result []domain.AccountLedgerBalance
+	err    error
+}
+
+func (s stubFindLedgerBalancesRepo) FindAccountBalances(_ context.Context) ([]domain.AccountLedgerBalance, error) {
+	return s.result, s.err
+}
+
+type stubReconcileLedgerPresenter struct{}
+
+func (s stubReconcileLedgerPresenter) Output(checked int, drifts []domain.AccountLedgerBalance) ReconcileLedgerOutput {
+	var o = make([]ReconcileLedgerDriftOutput, 0)
+	for _, drift := range drifts {
+		o = append(o, ReconcileLedgerDriftOutput{AccountID: drift.AccountID(), Drift: drift.Drift()})
+	}
+
+	return ReconcileLedgerOutput{Checked: checked, Drifts: o}
+}
+
+func Test_reconcileLedgerInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    domain.LedgerBalanceFinder
+		want    ReconcileLedgerOutput
+		wantErr error
+	}{
+		{
+			name: "Report only the accounts that drifted",
+			repo: stubFindLedgerBalancesRepo{
+				result: []domain.AccountLedgerBalance{
+					domain.NewAccountLedgerBalance("1", 500, 700, 200),
+					domain.NewAccountLedgerBalance("2", 900, 1000, 0),
+				},
+			},
+			want: ReconcileLedgerOutput{
+				Checked: 2,
+				Drifts:  []ReconcileLedgerDriftOutput{{AccountID: "2", Drift: -100}},
+			},
+		},
+		{
+			name: "Error finding balances",
+			repo: stubFindLedgerBalancesRepo{err: errDB},
+			want: ReconcileLedgerOutput{
+				Drifts: []ReconcileLedgerDriftOutput{},
+			},
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewReconcileLedgerInteractor(tt.repo, stubReconcileLedgerPresenter{}, time.Second).Execute(context.Background())
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	RelayOutboxUseCase interface {
+		Execute(context.Context) (int, error)
+	}
+
+	relayOutboxInteractor struct {
+		uow                 domain.UnitOfWork
+		repoOutboxFinder    domain.OutboxFinder
+		repoOutboxUpdater   domain.OutboxUpdater
+		repoOutboxPostponer domain.OutboxPostponer
+		publisher           domain.EventPublisher
+		clock               Clock
+		batchSize           int
+		retryDelay          time.Duration
+		ctxTimeout          time.Duration
+	}
+)
+
+// NewRelayOutboxInteractor creates new relayOutboxInteractor with its dependencies
+func NewRelayOutboxInteractor(
+	uow domain.UnitOfWork,
+	repoOutboxFinder domain.OutboxFinder,
+	repoOutboxUpdater domain.OutboxUpdater,
+	repoOutboxPostponer domain.OutboxPostponer,
+	publisher domain.EventPublisher,
+	clock Clock,
+	batchSize int,
+	retryDelay time.Duration,
+	ctxTimeout time.Duration,
+) RelayOutboxUseCase {
+	return relayOutboxInteractor{
+		uow:                 uow,
+		repoOutboxFinder:    repoOutboxFinder,
+		repoOutboxUpdater:   repoOutboxUpdater,
+		repoOutboxPostponer: repoOutboxPostponer,
+		publisher:           publisher,
+		clock:               clock,
+		batchSize:           batchSize,
+		retryDelay:          retryDelay,
+		ctxTimeout:          ctxTimeout,
+	}
+}
+
+// Execute publishes a batch of pending events, oldest first, and returns how many were published.
+// An event is marked as published only after the publisher accepts it, so it may be delivered
+// again if the relay stops in between. When an event fails, it is postponed by retryDelay and the
+// following events of the same account are held back with it, keeping the order per account while
+// the other accounts go on
+func (r relayOutboxInteractor) Execute(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "RelayOutbox", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	var (
+		published int
+		errPub    error
+	)
+
+	err := r.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		published, errPub = 0, nil
+
+		events, err := r.repoOutboxFinder.FindPending(ctxTx, r.clock.Now(), r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		var blocked = make(map[string]bool)
+		for _, event := range events {
+			if blocked[event.AccountID()] {
+				continue
+			}
+
+			if err = r.publisher.Publish(ctxTx, event); err != nil {
+				blocked[event.AccountID()] = true
+				if errPub == nil {
+					errPub = err
+				}
+
+				if err = r.repoOutboxPostponer.Postpone(ctxTx, event.ID(), r.clock.Now().Add(r.retryDelay)); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err = r.repoOutboxUpdater.MarkPublished(ctxTx, event.ID(), r.clock.Now()); err != nil {
+				return err
+			}
+
+			published++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, errPub
+}
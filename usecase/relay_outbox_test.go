@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubOutboxRepo struct {
+	events    []domain.Event
+	published *[]string
+	postponed map[string]time.Time
+	err       error
+}
+
+func (s stubOutboxRepo) FindPending(_ context.Context, now time.Time, limit int) ([]domain.Event, error) {
+	if s.err != nil {
+		return []domain.Event{}, s.err
+	}
+
+	var blocked = make(map[string]bool)
+	for _, event := range s.events {
+		if s.postponed[event.ID()].After(now) {
+			blocked[event.AccountID()] = true
+		}
+	}
+
+	var pending = make([]domain.Event, 0)
+	for _, event := range s.events {
+		if len(pending) == limit {
+			break
+		}
+
+		if !blocked[event.AccountID()] && !slices.Contains(*s.published, event.ID()) {
+			pending = append(pending, event)
+		}
+	}
+
+	return pending, nil
+}
+
+func (s stubOutboxRepo) Postpone(_ context.Context, ID string, until time.Time) error {
+	s.postponed[ID] = until
+	return nil
+}
+
+func (s stubOutboxRepo) MarkPublished(_ context.Context, ID string, _ time.Time) error {
+	*s.published = append(*s.published, ID)
+	return nil
+}
+
+type stubEventPublisher struct {
+	failures map[string]error
+}
+
+func (s stubEventPublisher) Publish(_ context.Context, event domain.Event) error {
+	return s.failures[event.ID()]
+}
+
+func Test_relayOutboxInteractor_Execute(t *testing.T) {
+	var (
+		errBroker = errors.New("broker unavailable")
+		events    = []domain.Event{
+			domain.NewEvent("1", domain.EventAccountCreated, "a", nil, time.Time{}),
+			domain.NewEvent("2", domain.EventAccountCreated, "b", nil, time.Time{}),
+			domain.NewEvent("3", domain.EventTransactionCreated, "a", nil, time.Time{}),
+			domain.NewEvent("4", domain.EventTransactionCreated, "b", nil, time.Time{}),
+		}
+	)
+
+	tests := []struct {
+		name          string
+		events        []domain.Event
+		failures      map[string]error
+		repoErr       error
+		batchSize     int
+		runs          int
+		want          int
+		wantPublished []string
+		wantPostponed []string
+		wantErr       error
+	}{
+		{
+			name:          "Publish every pending event in order",
+			events:        events,
+			batchSize:     10,
+			want:          4,
+			wantPublished: []string{"1", "2", "3", "4"},
+		},
+		{
+			name:          "Publish up to the batch size",
+			events:        events,
+			batchSize:     2,
+			want:          2,
+			wantPublished: []string{"1", "2"},
+		},
+		{
+			name:          "Hold back the events of the account that failed",
+			events:        events,
+			failures:      map[string]error{"1": errBroker},
+			batchSize:     10,
+			want:          2,
+			wantPublished: []string{"2", "4"},
+			wantPostponed: []string{"1"},
+			wantErr:       errBroker,
+		},
+		{
+			name: "Publish the other accounts when the held back events fill the batch",
+			events: []domain.Event{
+				domain.NewEvent("1", domain.EventTransactionCreated, "a", nil, time.Time{}),
+				domain.NewEvent("2", domain.EventTransactionCreated, "a", nil, time.Time{}),
+				domain.NewEvent("3", domain.EventTransactionCreated, "a", nil, time.Time{}),
+				domain.NewEvent("4", domain.EventTransactionCreated, "b", nil, time.Time{}),
+				domain.NewEvent("5", domain.EventTransactionCreated, "c", nil, time.Time{}),
+			},
+			failures:      map[string]error{"1": errBroker},
+			batchSize:     2,
+			runs:          2,
+			want:          2,
+			wantPublished: []string{"4", "5"},
+			wantPostponed: []string{"1"},
+		},
+		{
+			name:          "Error finding pending events",
+			repoErr:       errDB,
+			batchSize:     10,
+			want:          0,
+			wantPublished: []string{},
+			wantErr:       errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				published = make([]string, 0)
+				repo      = stubOutboxRepo{
+					events:    tt.events,
+					published: &published,
+					postponed: make(map[string]time.Time),
+					err:       tt.repoErr,
+				}
+				uc = NewRelayOutboxInteractor(
+					stubUnitOfWork{},
+					repo,
+					repo,
+					repo,
+					stubEventPublisher{failures: tt.failures},
+					stubClock{},
+					tt.batchSize,
+					time.Minute,
+					time.Second,
+				)
+				got int
+				err error
+			)
+
+			for run := 0; run < max(tt.runs, 1); run++ {
+				var n int
+				n, err = uc.Execute(context.Background())
+				got += n
+			}
+
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(published, tt.wantPublished) {
+				t.Errorf("[TestCase '%s'] Got published: '%v' | Want: '%v'", tt.name, published, tt.wantPublished)
+			}
+
+			var postponed []string
+			for ID := range repo.postponed {
+				postponed = append(postponed, ID)
+			}
+			slices.Sort(postponed)
+
+			if !reflect.DeepEqual(postponed, tt.wantPostponed) {
+				t.Errorf("[TestCase '%s'] Got postponed: '%v' | Want: '%v'", tt.name, postponed, tt.wantPostponed)
+			}
+		})
+	}
+}
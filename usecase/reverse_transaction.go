@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+type (
+	// Input port
+	ReverseTransactionUseCase interface {
+		Execute(context.Context, ReverseTransactionInput) (ReverseTransactionOutput, error)
+	}
+
+	// Input data
+	ReverseTransactionInput struct {
+		TransactionID string `json:"transaction_id" validate:"required"`
+		Amount        int64  `json:"amount" validate:"gte=0"`
+	}
+
+	// Output port
+	ReverseTransactionPresenter interface {
+		Output(domain.Transaction) ReverseTransactionOutput
+	}
+
+	// Output data
+	ReverseTransactionOutput struct {
+		ID                    string                            `json:"id"`
+		AccountID             string                            `json:"account_id"`
+		OriginalTransactionID string                            `json:"original_transaction_id"`
+		Operation             ReverseTransactionOperationOutput `json:"operation"`
+		Amount                int64                             `json:"amount"`
+		Balance               int64                             `json:"balance"`
+		CreatedAt             string                            `json:"created_at"`
+	}
+
+	// Output data
+	ReverseTransactionOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+	}
+
+	reverseTransactionInteractor struct {
+		uow                    domain.UnitOfWork
+		repoTransactionCreator domain.TransactionCreator
+		repoTransactionFinder  domain.TransactionByIDFinder
+		repoReversedFinder     domain.TransactionReversedAmountFinder
+		repoBalanceFinder      domain.TransactionBalanceFinder
+		repoBalanceUpdater     domain.TransactionBalanceUpdater
+		repoAccountLocker      domain.AccountLocker
+		repoAccountUpdater     domain.AccountUpdater
+		pre                    ReverseTransactionPresenter
+		metrics                TransactionMetrics
+		ctxTimeout             time.Duration
+	}
+)
+
+// NewReverseTransactionInteractor creates new reverseTransactionInteractor with its dependencies
+func NewReverseTransactionInteractor(
+	uow domain.UnitOfWork,
+	repoTransactionCreator domain.TransactionCreator,
+	repoTransactionFinder domain.TransactionByIDFinder,
+	repoReversedFinder domain.TransactionReversedAmountFinder,
+	repoBalanceFinder domain.TransactionBalanceFinder,
+	repoBalanceUpdater domain.TransactionBalanceUpdater,
+	repoAccountLocker domain.AccountLocker,
+	repoAccountUpdater domain.AccountUpdater,
+	pre ReverseTransactionPresenter,
+	metrics TransactionMetrics,
+	ctxTimeout time.Duration,
+) ReverseTransactionUseCase {
+	return reverseTransactionInteractor{
+		uow:                    uow,
+		repoTransactionCreator: repoTransactionCreator,
+		repoTransactionFinder:  repoTransactionFinder,
+		repoReversedFinder:     repoReversedFinder,
+		repoBalanceFinder:      repoBalanceFinder,
+		repoBalanceUpdater:     repoBalanceUpdater,
+		repoAccountLocker:      repoAccountLocker,
+		repoAccountUpdater:     repoAccountUpdater,
+		pre:                    pre,
+		metrics:                metrics,
+		ctxTimeout:             ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case, recording its outcome in the metrics
+func (r reverseTransactionInteractor) Execute(ctx context.Context, i ReverseTransactionInput) (ReverseTransactionOutput, error) {
+	ctx, span := startSpan(ctx, "ReverseTransaction", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	reversal, err := r.execute(ctx, i)
+	switch {
+	case err == nil:
+		r.metrics.TransactionCreated(reversal)
+		return r.pre.Output(reversal), nil
+	case transactionDeclined(err):
+		r.metrics.TransactionDeclined(domain.Estorno, i.Amount, err)
+	default:
+		r.metrics.TransactionFailed(domain.Estorno, err)
+	}
+
+	return r.pre.Output(domain.Transaction{}), err
+}
+
+func (r reverseTransactionInteractor) execute(ctx context.Context, i ReverseTransactionInput) (domain.Transaction, error) {
+	var (
+		reversal domain.Transaction
+		err      error
+	)
+
+	err = r.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		original, err := r.repoTransactionFinder.FindByID(ctxTx, i.TransactionID)
+		if err != nil {
+			return err
+		}
+
+		reversed, err := r.repoReversedFinder.FindReversedAmount(ctxTx, original.ID())
+		if err != nil {
+			return err
+		}
+
+		reversal, err = original.Reverse(uuid.New().String(), i.Amount, reversed, time.Now())
+		if err != nil {
+			return err
+		}
+
+		account, err := r.repoAccountLocker.LockByID(ctxTx, original.AccountID())
+		if err != nil {
+			return err
+		}
+
+		account.Deposit(reversal.Amount())
+		if err = r.repoAccountUpdater.UpdateCreditLimit(ctxTx, account.ID(), account.AvailableCreditLimit()); err != nil {
+			return err
+		}
+
+		open, err := r.repoBalanceFinder.FindOpenByAccountID(ctxTx, account.ID())
+		if err != nil {
+			return err
+		}
+
+		for _, settled := range reversal.Settle(originalFirst(open, original.ID())) {
+			if err = r.repoBalanceUpdater.UpdateBalance(ctxTx, settled.ID(), settled.Balance()); err != nil {
+				return err
+			}
+		}
+
+		reversal, err = r.repoTransactionCreator.Create(ctxTx, reversal)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.Transaction{}, err
+	}
+
+	return reversal, nil
+}
+
+// originalFirst moves the reversed transaction to the head of the open transactions,
+// so that the reversal discharges its balance before any other
+func originalFirst(open []domain.Transaction, originalID string) []domain.Transaction {
+	var sorted = make([]domain.Transaction, 0, len(open))
+	for _, o := range open {
+		if o.ID() == originalID {
+			sorted = append([]domain.Transaction{o}, sorted...)
+			continue
+		}
+		sorted = append(sorted, o)
+	}
+
+	return sorted
+}
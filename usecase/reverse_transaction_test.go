@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+var errDB = errors.New("db_error")
+
+type stubFindTransactionByIDRepo struct {
+	result domain.Transaction
+	err    error
+}
+
+func (s stubFindTransactionByIDRepo) FindByID(_ context.Context, _ string) (domain.Transaction, error) {
+	return s.result, s.err
+}
+
+type stubFindReversedAmountRepo struct {
+	result int64
+	err    error
+}
+
+func (s stubFindReversedAmountRepo) FindReversedAmount(_ context.Context, _ string) (int64, error) {
+	return s.result, s.err
+}
+
+type stubUnitOfWork struct{}
+
+func (s stubUnitOfWork) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type spyCreateTransactionRepo struct{}
+
+func (s spyCreateTransactionRepo) Create(_ context.Context, transaction domain.Transaction) (domain.Transaction, error) {
+	return transaction, nil
+}
+
+type stubReverseTransactionPresenter struct{}
+
+func (s stubReverseTransactionPresenter) Output(transaction domain.Transaction) ReverseTransactionOutput {
+	return ReverseTransactionOutput{
+		AccountID:             transaction.AccountID(),
+		OriginalTransactionID: transaction.OriginalTransactionID(),
+		Operation: ReverseTransactionOperationOutput{
+			ID:          transaction.Operation().ID(),
+			Description: transaction.Operation().Description(),
+			Type:        transaction.Operation().Type(),
+		},
+		Amount:  transaction.Amount(),
+		Balance: transaction.Balance(),
+	}
+}
+
+func Test_reverseTransactionInteractor_Execute(t *testing.T) {
+	var (
+		opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		opPagamento, _    = domain.NewOperation(domain.Pagamento)
+		purchase          = domain.NewTransaction(
+			"3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+			"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+			opCompraAVista,
+			5000,
+			-5000,
+			time.Time{},
+		)
+		account = domain.NewStoredAccount(
+			"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+			"12345678900",
+			100,
+			time.Time{},
+		)
+	)
+
+	type fields struct {
+		repoTransactionCreator domain.TransactionCreator
+		repoTransactionFinder  domain.TransactionByIDFinder
+		repoReversedFinder     domain.TransactionReversedAmountFinder
+		repoBalanceFinder      domain.TransactionBalanceFinder
+		repoAccountLocker      domain.AccountLocker
+	}
+	type args struct {
+		i ReverseTransactionInput
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		want        ReverseTransactionOutput
+		wantMetrics []string
+		wantErr     error
+	}{
+		{
+			name: "Full reversal of purchase",
+			fields: fields{
+				repoTransactionCreator: spyCreateTransactionRepo{},
+				repoTransactionFinder:  stubFindTransactionByIDRepo{result: purchase},
+				repoReversedFinder:     stubFindReversedAmountRepo{result: 0},
+				repoBalanceFinder:      stubFindOpenTransactionsRepo{result: []domain.Transaction{purchase}},
+				repoAccountLocker:      stubFindUserByRepo{result: account},
+			},
+			args: args{
+				i: ReverseTransactionInput{TransactionID: purchase.ID()},
+			},
+			want: ReverseTransactionOutput{
+				AccountID:             "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				OriginalTransactionID: "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+				Operation: ReverseTransactionOperationOutput{
+					ID:          domain.Estorno,
+					Description: "ESTORNO",
+					Type:        domain.Credit,
+				},
+				Amount:  5000,
+				Balance: 0,
+			},
+			wantMetrics: []string{"created " + domain.Estorno},
+		},
+		{
+			name: "Partial reversal of purchase",
+			fields: fields{
+				repoTransactionCreator: spyCreateTransactionRepo{},
+				repoTransactionFinder:  stubFindTransactionByIDRepo{result: purchase},
+				repoReversedFinder:     stubFindReversedAmountRepo{result: 1000},
+				repoBalanceFinder:      stubFindOpenTransactionsRepo{result: []domain.Transaction{}},
+				repoAccountLocker:      stubFindUserByRepo{result: account},
+			},
+			args: args{
+				i: ReverseTransactionInput{TransactionID: purchase.ID(), Amount: 1500},
+			},
+			want: ReverseTransactionOutput{
+				AccountID:             "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				OriginalTransactionID: "3c6a1b47-23c1-4c5c-a7c2-97b0ab0e1fa1",
+				Operation: ReverseTransactionOperationOutput{
+					ID:          domain.Estorno,
+					Description: "ESTORNO",
+					Type:        domain.Credit,
+				},
+				Amount:  1500,
+				Balance: 1500,
+			},
+			wantMetrics: []string{"created " + domain.Estorno},
+		},
+		{
+			name: "Error reversal of payment",
+			fields: fields{
+				repoTransactionCreator: spyCreateTransactionRepo{},
+				repoTransactionFinder: stubFindTransactionByIDRepo{
+					result: domain.NewTransaction("1", "1", opPagamento, 100, 100, time.Time{}),
+				},
+				repoReversedFinder: stubFindReversedAmountRepo{result: 0},
+				repoBalanceFinder:  stubFindOpenTransactionsRepo{},
+				repoAccountLocker:  stubFindUserByRepo{result: account},
+			},
+			args: args{
+				i: ReverseTransactionInput{TransactionID: "1"},
+			},
+			wantMetrics: []string{fmt.Sprintf("declined %s 0 %v", domain.Estorno, domain.ErrTransactionNotReversible)},
+			wantErr:     domain.ErrTransactionNotReversible,
+		},
+		{
+			name: "Error purchase already reversed",
+			fields: fields{
+				repoTransactionCreator: spyCreateTransactionRepo{},
+				repoTransactionFinder:  stubFindTransactionByIDRepo{result: purchase},
+				repoReversedFinder:     stubFindReversedAmountRepo{result: 5000},
+				repoBalanceFinder:      stubFindOpenTransactionsRepo{},
+				repoAccountLocker:      stubFindUserByRepo{result: account},
+			},
+			args: args{
+				i: ReverseTransactionInput{TransactionID: purchase.ID()},
+			},
+			wantMetrics: []string{fmt.Sprintf("failed %s %v", domain.Estorno, domain.ErrTransactionAlreadyReversed)},
+			wantErr:     domain.ErrTransactionAlreadyReversed,
+		},
+		{
+			name: "Error transaction not found",
+			fields: fields{
+				repoTransactionCreator: spyCreateTransactionRepo{},
+				repoTransactionFinder:  stubFindTransactionByIDRepo{err: domain.ErrTransactionNotFound},
+				repoReversedFinder:     stubFindReversedAmountRepo{},
+				repoBalanceFinder:      stubFindOpenTransactionsRepo{},
+				repoAccountLocker:      stubFindUserByRepo{result: account},
+			},
+			args: args{
+				i: ReverseTransactionInput{TransactionID: purchase.ID()},
+			},
+			wantMetrics: []string{fmt.Sprintf("failed %s %v", domain.Estorno, domain.ErrTransactionNotFound)},
+			wantErr:     domain.ErrTransactionNotFound,
+		},
+		{
+			name: "Repository error when find reversed amount",
+			fields: fields{
+				repoTransactionCreator: spyCreateTransactionRepo{},
+				repoTransactionFinder:  stubFindTransactionByIDRepo{result: purchase},
+				repoReversedFinder:     stubFindReversedAmountRepo{err: errDB},
+				repoBalanceFinder:      stubFindOpenTransactionsRepo{},
+				repoAccountLocker:      stubFindUserByRepo{result: account},
+			},
+			args: args{
+				i: ReverseTransactionInput{TransactionID: purchase.ID()},
+			},
+			wantMetrics: []string{fmt.Sprintf("failed %s %v", domain.Estorno, errDB)},
+			wantErr:     errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var metrics = make([]string, 0)
+			interactor := NewReverseTransactionInteractor(
+				stubUnitOfWork{},
+				tt.fields.repoTransactionCreator,
+				tt.fields.repoTransactionFinder,
+				tt.fields.repoReversedFinder,
+				tt.fields.repoBalanceFinder,
+				stubUpdateTransactionBalanceRepo{},
+				tt.fields.repoAccountLocker,
+				stubUpdateCreditLimitRepo{},
+				stubReverseTransactionPresenter{},
+				spyTransactionMetrics{outcomes: &metrics},
+				time.Second,
+			)
+
+			got, err := interactor.Execute(context.Background(), tt.args.i)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(metrics, tt.wantMetrics) {
+				t.Errorf("[TestCase '%s'] Got metrics: '%v' | Want: '%v'", tt.name, metrics, tt.wantMetrics)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	RevokeAPIKeyUseCase interface {
+		Execute(context.Context, RevokeAPIKeyInput) error
+	}
+
+	// Input data
+	RevokeAPIKeyInput struct {
+		ID string
+	}
+
+	revokeAPIKeyInteractor struct {
+		repoAPIKeyFinder  domain.APIKeyFinder
+		repoAPIKeyUpdater domain.APIKeyUpdater
+		clock             Clock
+		ctxTimeout        time.Duration
+	}
+)
+
+// NewRevokeAPIKeyInteractor creates new revokeAPIKeyInteractor with its dependencies
+func NewRevokeAPIKeyInteractor(
+	repoAPIKeyFinder domain.APIKeyFinder,
+	repoAPIKeyUpdater domain.APIKeyUpdater,
+	clock Clock,
+	ctxTimeout time.Duration,
+) RevokeAPIKeyUseCase {
+	return revokeAPIKeyInteractor{
+		repoAPIKeyFinder:  repoAPIKeyFinder,
+		repoAPIKeyUpdater: repoAPIKeyUpdater,
+		clock:             clock,
+		ctxTimeout:        ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case. Revoking an API key already revoked keeps the first revocation
+func (r revokeAPIKeyInteractor) Execute(ctx context.Context, i RevokeAPIKeyInput) error {
+	ctx, span := startSpan(ctx, "RevokeAPIKey", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	apiKey, err := r.repoAPIKeyFinder.FindByID(ctx, i.ID)
+	if err != nil {
+		return err
+	}
+
+	apiKey.Revoke(r.clock.Now())
+
+	return r.repoAPIKeyUpdater.Update(ctx, apiKey)
+}
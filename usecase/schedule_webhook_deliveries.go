@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/GSabadini/go-transactions/domain"
+	"github.com/google/uuid"
+)
+
+type scheduleWebhookDeliveriesInteractor struct {
+	repoSubscriberFinder domain.WebhookSubscriberFinder
+	repoDeliveryCreator  domain.WebhookDeliveryCreator
+	clock                Clock
+}
+
+// NewScheduleWebhookDeliveriesInteractor creates new scheduleWebhookDeliveriesInteractor with its dependencies.
+// It publishes the events of the outbox by scheduling a delivery to every webhook subscribed to them
+func NewScheduleWebhookDeliveriesInteractor(
+	repoSubscriberFinder domain.WebhookSubscriberFinder,
+	repoDeliveryCreator domain.WebhookDeliveryCreator,
+	clock Clock,
+) domain.EventPublisher {
+	return scheduleWebhookDeliveriesInteractor{
+		repoSubscriberFinder: repoSubscriberFinder,
+		repoDeliveryCreator:  repoDeliveryCreator,
+		clock:                clock,
+	}
+}
+
+// Publish schedules the event to the subscribed webhooks. It runs in the unit of work of the relay,
+// and an event relayed again is not scheduled twice to the same webhook
+func (s scheduleWebhookDeliveriesInteractor) Publish(ctx context.Context, event domain.Event) error {
+	webhooks, err := s.repoSubscriberFinder.FindSubscribers(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		delivery := domain.ScheduleWebhookDelivery(uuid.New().String(), webhook, event, s.clock.Now())
+		if err = s.repoDeliveryCreator.Create(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubWebhookSubscribersRepo struct {
+	webhooks []domain.Webhook
+	err      error
+}
+
+func (s stubWebhookSubscribersRepo) FindSubscribers(_ context.Context, event domain.Event) ([]domain.Webhook, error) {
+	var webhooks = make([]domain.Webhook, 0)
+	for _, webhook := range s.webhooks {
+		if webhook.Subscribes(event) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	return webhooks, s.err
+}
+
+type spyCreateWebhookDeliveryRepo struct {
+	created *[]domain.WebhookDelivery
+	err     error
+}
+
+func (s spyCreateWebhookDeliveryRepo) Create(_ context.Context, delivery domain.WebhookDelivery) error {
+	*s.created = append(*s.created, delivery)
+	return s.err
+}
+
+func Test_scheduleWebhookDeliveriesInteractor_Publish(t *testing.T) {
+	var (
+		now      = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		created  = []string{domain.EventTransactionCreated}
+		webhooks = []domain.Webhook{
+			domain.NewWebhook("global", "", "https://a.example.com", "secret", created, true, now).WithTenant("tenant-a"),
+			domain.NewWebhook("account", "1", "https://b.example.com", "secret", created, true, now).WithTenant("tenant-a"),
+			domain.NewWebhook("other", "2", "https://c.example.com", "secret", created, true, now).WithTenant("tenant-a"),
+			domain.NewWebhook("tenant", "", "https://d.example.com", "secret", created, true, now).WithTenant("tenant-b"),
+		}
+		event = domain.NewEvent("e1", domain.EventTransactionCreated, "1", []byte(`{"id":"t1"}`), now).WithTenant("tenant-a")
+	)
+
+	tests := []struct {
+		name         string
+		repoFinder   domain.WebhookSubscriberFinder
+		createErr    error
+		wantWebhooks []string
+		wantErr      error
+	}{
+		{
+			name:         "Schedule to the webhooks of the account and the global ones of the tenant",
+			repoFinder:   stubWebhookSubscribersRepo{webhooks: webhooks},
+			wantWebhooks: []string{"global", "account"},
+		},
+		{
+			name:         "Without subscribers",
+			repoFinder:   stubWebhookSubscribersRepo{},
+			wantWebhooks: []string{},
+		},
+		{
+			name:         "Error finding subscribers",
+			repoFinder:   stubWebhookSubscribersRepo{err: errDB},
+			wantWebhooks: []string{},
+			wantErr:      errDB,
+		},
+		{
+			name:         "Error scheduling delivery",
+			repoFinder:   stubWebhookSubscribersRepo{webhooks: webhooks},
+			createErr:    errDB,
+			wantWebhooks: []string{"global"},
+			wantErr:      errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				deliveries []domain.WebhookDelivery
+				publisher  = NewScheduleWebhookDeliveriesInteractor(
+					tt.repoFinder,
+					spyCreateWebhookDeliveryRepo{created: &deliveries, err: tt.createErr},
+					stubClock{now: now},
+				)
+			)
+
+			if err := publisher.Publish(context.Background(), event); err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			var got = make([]string, 0)
+			for _, delivery := range deliveries {
+				got = append(got, delivery.WebhookID())
+
+				if delivery.EventID() != event.ID() || delivery.TenantID() != "tenant-a" || delivery.Status() != domain.WebhookDeliveryPending || !delivery.NextAttemptAt().Equal(now) {
+					t.Errorf("[TestCase '%s'] Got delivery: '%+v'", tt.name, delivery)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.wantWebhooks) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.wantWebhooks)
+			}
+		})
+	}
+}
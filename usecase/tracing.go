@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName names the tracer of the use cases
+	instrumentationName = "github.com/GSabadini/go-transactions/usecase"
+	// accountIDKey is the span attribute identifying the account the use case concerns
+	accountIDKey = attribute.Key("account.id")
+)
+
+// startSpan starts the span of the use case, carrying the account when the input identifies it
+func startSpan(ctx context.Context, name string, accountID string) (context.Context, trace.Span) {
+	var opts []trace.SpanStartOption
+	if accountID != "" {
+		opts = append(opts, trace.WithAttributes(accountIDKey.String(accountID)))
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, "usecase."+name, opts...)
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordSpans records the spans started through the global TracerProvider until the test ends
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	var (
+		recorder = tracetest.NewSpanRecorder()
+		previous = otel.GetTracerProvider()
+	)
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return recorder
+}
+
+func Test_startSpan(t *testing.T) {
+	tests := []struct {
+		name          string
+		accountID     string
+		wantAccountID bool
+	}{
+		{
+			name:          "Span of the use case with the account",
+			accountID:     "fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+			wantAccountID: true,
+		},
+		{
+			name: "Span of the use case without account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := recordSpans(t)
+
+			uc := NewCreateTransactionInteractor(
+				stubUnitOfWork{},
+				stubCreateTransactionRepo{},
+				stubFindOpenTransactionsRepo{},
+				stubUpdateTransactionBalanceRepo{},
+				stubFindUserByRepo{result: domain.NewStoredAccount(tt.accountID, "12345678900", 100, time.Time{})},
+				stubUpdateCreditLimitRepo{},
+				stubFindOperationRepo{},
+				spyCreateOutboxEventRepo{},
+				stubCreateTransactionPresenter{},
+				NewNoopTransactionMetrics(),
+				time.Second,
+			)
+
+			_, _ = uc.Execute(context.Background(), CreateTransactionInput{
+				AccountID:   tt.accountID,
+				OperationID: domain.Pagamento,
+				Amount:      100,
+			})
+
+			spans := recorder.Ended()
+			if len(spans) != 1 || spans[0].Name() != "usecase.CreateTransaction" {
+				t.Fatalf("[TestCase '%s'] Got spans: '%v' | Want: '%v'", tt.name, spans, "usecase.CreateTransaction")
+			}
+
+			var (
+				gotAccountID string
+				found        bool
+			)
+			for _, attr := range spans[0].Attributes() {
+				if attr.Key == accountIDKey {
+					gotAccountID, found = attr.Value.AsString(), true
+				}
+			}
+
+			if found != tt.wantAccountID || gotAccountID != tt.accountID {
+				t.Errorf("[TestCase '%s'] Got account id: '%v' | Want: '%v'", tt.name, gotAccountID, tt.accountID)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	UpdateWebhookUseCase interface {
+		Execute(context.Context, UpdateWebhookInput) (UpdateWebhookOutput, error)
+	}
+
+	// Input data
+	UpdateWebhookInput struct {
+		ID     string   `json:"-"`
+		URL    string   `json:"url" validate:"required,url,max=2048"`
+		Events []string `json:"events" validate:"required,min=1"`
+		Active *bool    `json:"active" validate:"required"`
+	}
+
+	// Output port
+	UpdateWebhookPresenter interface {
+		Output(domain.Webhook) UpdateWebhookOutput
+	}
+
+	// Output data
+	UpdateWebhookOutput struct {
+		ID        string   `json:"id"`
+		AccountID string   `json:"account_id,omitempty"`
+		URL       string   `json:"url"`
+		Events    []string `json:"events"`
+		Active    bool     `json:"active"`
+		CreatedAt string   `json:"created_at"`
+	}
+
+	updateWebhookInteractor struct {
+		repoFinder  domain.WebhookFinder
+		repoUpdater domain.WebhookUpdater
+		pre         UpdateWebhookPresenter
+		ctxTimeout  time.Duration
+	}
+)
+
+// NewUpdateWebhookInteractor creates new updateWebhookInteractor with its dependencies
+func NewUpdateWebhookInteractor(
+	repoFinder domain.WebhookFinder,
+	repoUpdater domain.WebhookUpdater,
+	pre UpdateWebhookPresenter,
+	ctxTimeout time.Duration,
+) UpdateWebhookUseCase {
+	return updateWebhookInteractor{
+		repoFinder:  repoFinder,
+		repoUpdater: repoUpdater,
+		pre:         pre,
+		ctxTimeout:  ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case. The account and the secret of a webhook never change
+func (u updateWebhookInteractor) Execute(ctx context.Context, i UpdateWebhookInput) (UpdateWebhookOutput, error) {
+	ctx, span := startSpan(ctx, "UpdateWebhook", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	webhook, err := u.repoFinder.FindByID(ctx, i.ID)
+	if err != nil {
+		return u.pre.Output(domain.Webhook{}), err
+	}
+
+	if err = webhook.Change(i.URL, i.Events, *i.Active); err != nil {
+		return u.pre.Output(domain.Webhook{}), err
+	}
+
+	if err = u.repoUpdater.Update(ctx, webhook); err != nil {
+		return u.pre.Output(domain.Webhook{}), err
+	}
+
+	return u.pre.Output(webhook), nil
+}
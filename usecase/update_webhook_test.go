@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubWebhookRepo struct {
+	webhook domain.Webhook
+	err     error
+}
+
+func (s stubWebhookRepo) FindByID(_ context.Context, ID string) (domain.Webhook, error) {
+	if ID != s.webhook.ID() {
+		return domain.Webhook{}, domain.ErrWebhookNotFound
+	}
+
+	return s.webhook, nil
+}
+
+func (s stubWebhookRepo) Update(_ context.Context, _ domain.Webhook) error {
+	return s.err
+}
+
+type stubUpdateWebhookPresenter struct{}
+
+func (s stubUpdateWebhookPresenter) Output(webhook domain.Webhook) UpdateWebhookOutput {
+	return UpdateWebhookOutput{
+		ID:        webhook.ID(),
+		AccountID: webhook.AccountID(),
+		URL:       webhook.URL(),
+		Events:    webhook.Events(),
+		Active:    webhook.Active(),
+	}
+}
+
+func Test_updateWebhookInteractor_Execute(t *testing.T) {
+	var (
+		inactive = false
+		webhook  = domain.NewWebhook(
+			"1",
+			"1",
+			"https://partner.example.com/hooks",
+			"0123456789abcdef",
+			[]string{domain.EventTransactionCreated},
+			true,
+			time.Time{},
+		)
+	)
+
+	tests := []struct {
+		name    string
+		repo    stubWebhookRepo
+		input   UpdateWebhookInput
+		want    UpdateWebhookOutput
+		wantErr error
+	}{
+		{
+			name: "Update webhook",
+			repo: stubWebhookRepo{webhook: webhook},
+			input: UpdateWebhookInput{
+				ID:     "1",
+				URL:    "https://partner.example.com/v2/hooks",
+				Events: []string{domain.EventTransactionRejected, domain.EventTransactionCreated},
+				Active: &inactive,
+			},
+			want: UpdateWebhookOutput{
+				ID:        "1",
+				AccountID: "1",
+				URL:       "https://partner.example.com/v2/hooks",
+				Events:    []string{domain.EventTransactionCreated, domain.EventTransactionRejected},
+				Active:    false,
+			},
+		},
+		{
+			name:    "Error webhook not found",
+			repo:    stubWebhookRepo{webhook: webhook},
+			input:   UpdateWebhookInput{ID: "2", Active: &inactive},
+			wantErr: domain.ErrWebhookNotFound,
+		},
+		{
+			name: "Error url not supported",
+			repo: stubWebhookRepo{webhook: webhook},
+			input: UpdateWebhookInput{
+				ID:     "1",
+				URL:    "mailto:partner@example.com",
+				Events: []string{domain.EventTransactionCreated},
+				Active: &inactive,
+			},
+			wantErr: domain.ErrWebhookURLInvalid,
+		},
+		{
+			name: "Error updating webhook in database",
+			repo: stubWebhookRepo{webhook: webhook, err: errDB},
+			input: UpdateWebhookInput{
+				ID:     "1",
+				URL:    "https://partner.example.com/hooks",
+				Events: []string{domain.EventTransactionCreated},
+				Active: &inactive,
+			},
+			wantErr: errDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewUpdateWebhookInteractor(tt.repo, tt.repo, stubUpdateWebhookPresenter{}, time.Second)
+
+			got, err := uc.Execute(context.Background(), tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
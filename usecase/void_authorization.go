@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type (
+	// Input port
+	VoidAuthorizationUseCase interface {
+		Execute(context.Context, VoidAuthorizationInput) (VoidAuthorizationOutput, error)
+	}
+
+	// Input data
+	VoidAuthorizationInput struct {
+		AuthorizationID string `json:"authorization_id" validate:"required"`
+	}
+
+	// Output port
+	VoidAuthorizationPresenter interface {
+		Output(domain.Authorization) VoidAuthorizationOutput
+	}
+
+	// Output data
+	VoidAuthorizationOutput struct {
+		ID             string                           `json:"id"`
+		AccountID      string                           `json:"account_id"`
+		Operation      VoidAuthorizationOperationOutput `json:"operation"`
+		Amount         int64                            `json:"amount"`
+		CapturedAmount int64                            `json:"captured_amount"`
+		Status         string                           `json:"status"`
+		ExpiresAt      string                           `json:"expires_at"`
+		CreatedAt      string                           `json:"created_at"`
+	}
+
+	// Output data
+	VoidAuthorizationOperationOutput struct {
+		ID          string `json:"id"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+	}
+
+	voidAuthorizationInteractor struct {
+		uow                      domain.UnitOfWork
+		repoAuthorizationFinder  domain.AuthorizationFinder
+		repoAuthorizationUpdater domain.AuthorizationUpdater
+		repoAccountLocker        domain.AccountLocker
+		repoAccountUpdater       domain.AccountUpdater
+		pre                      VoidAuthorizationPresenter
+		clock                    Clock
+		ctxTimeout               time.Duration
+	}
+)
+
+// NewVoidAuthorizationInteractor creates new voidAuthorizationInteractor with its dependencies
+func NewVoidAuthorizationInteractor(
+	uow domain.UnitOfWork,
+	repoAuthorizationFinder domain.AuthorizationFinder,
+	repoAuthorizationUpdater domain.AuthorizationUpdater,
+	repoAccountLocker domain.AccountLocker,
+	repoAccountUpdater domain.AccountUpdater,
+	pre VoidAuthorizationPresenter,
+	clock Clock,
+	ctxTimeout time.Duration,
+) VoidAuthorizationUseCase {
+	return voidAuthorizationInteractor{
+		uow:                      uow,
+		repoAuthorizationFinder:  repoAuthorizationFinder,
+		repoAuthorizationUpdater: repoAuthorizationUpdater,
+		repoAccountLocker:        repoAccountLocker,
+		repoAccountUpdater:       repoAccountUpdater,
+		pre:                      pre,
+		clock:                    clock,
+		ctxTimeout:               ctxTimeout,
+	}
+}
+
+// Execute orchestrates the use case
+func (v voidAuthorizationInteractor) Execute(ctx context.Context, i VoidAuthorizationInput) (VoidAuthorizationOutput, error) {
+	ctx, span := startSpan(ctx, "VoidAuthorization", "")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, v.ctxTimeout)
+	defer cancel()
+
+	var (
+		authorization domain.Authorization
+		err           error
+	)
+
+	err = v.uow.WithTransaction(ctx, func(ctxTx context.Context) error {
+		authorization, err = v.repoAuthorizationFinder.FindByID(ctxTx, i.AuthorizationID)
+		if err != nil {
+			return err
+		}
+
+		account, err := v.repoAccountLocker.LockByID(ctxTx, authorization.AccountID())
+		if err != nil {
+			return err
+		}
+
+		if err = authorization.Void(&account, v.clock.Now()); err != nil {
+			return err
+		}
+
+		if err = v.repoAccountUpdater.UpdateCreditLimit(ctxTx, account.ID(), account.AvailableCreditLimit()); err != nil {
+			return err
+		}
+
+		return v.repoAuthorizationUpdater.Update(ctxTx, authorization)
+	})
+	if err != nil {
+		return v.pre.Output(domain.Authorization{}), err
+	}
+
+	return v.pre.Output(authorization), nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/go-transactions/domain"
+)
+
+type stubVoidAuthorizationPresenter struct{}
+
+func (s stubVoidAuthorizationPresenter) Output(authorization domain.Authorization) VoidAuthorizationOutput {
+	return VoidAuthorizationOutput{
+		ID:     authorization.ID(),
+		Status: authorization.Status(),
+	}
+}
+
+func Test_voidAuthorizationInteractor_Execute(t *testing.T) {
+	var (
+		opCompraAVista, _ = domain.NewOperation(domain.CompraAVista)
+		account           = domain.NewStoredAccount("fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8", "12345678900", 7500, time.Time{})
+		authorization     = func(status string) domain.Authorization {
+			return domain.NewAuthorization(
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"fc95e907-e0eb-4ef8-927e-3eaad3a4d9a8",
+				opCompraAVista,
+				2500,
+				0,
+				status,
+				"",
+				authorizationNow.Add(time.Hour),
+				authorizationNow,
+			)
+		}
+	)
+
+	tests := []struct {
+		name    string
+		repo    domain.AuthorizationFinder
+		want    VoidAuthorizationOutput
+		wantErr error
+	}{
+		{
+			name: "Void a pending authorization",
+			repo: stubFindAuthorizationByIDRepo{result: authorization(domain.AuthorizationPending)},
+			want: VoidAuthorizationOutput{
+				ID:     "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Status: domain.AuthorizationVoided,
+			},
+		},
+		{
+			name:    "Error voiding a captured authorization",
+			repo:    stubFindAuthorizationByIDRepo{result: authorization(domain.AuthorizationCaptured)},
+			wantErr: domain.ErrAuthorizationNotPending,
+		},
+		{
+			name:    "Error voiding a not found authorization",
+			repo:    stubFindAuthorizationByIDRepo{err: domain.ErrAuthorizationNotFound},
+			wantErr: domain.ErrAuthorizationNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewVoidAuthorizationInteractor(
+				stubUnitOfWork{},
+				tt.repo,
+				stubUpdateAuthorizationRepo{},
+				stubFindUserByRepo{result: account},
+				stubUpdateCreditLimitRepo{},
+				stubVoidAuthorizationPresenter{},
+				stubClock{now: authorizationNow},
+				time.Second,
+			)
+
+			got, err := v.Execute(context.Background(), VoidAuthorizationInput{
+				AuthorizationID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			})
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}